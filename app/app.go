@@ -13,7 +13,9 @@ import (
 	okexchaincodec "github.com/okex/okexchain/app/codec"
 	okexchain "github.com/okex/okexchain/app/types"
 	"github.com/okex/okexchain/x/ammswap"
+	ammswapclient "github.com/okex/okexchain/x/ammswap/client"
 	"github.com/okex/okexchain/x/backend"
+	"github.com/okex/okexchain/x/common/proto"
 	commonversion "github.com/okex/okexchain/x/common/version"
 	"github.com/okex/okexchain/x/debug"
 	"github.com/okex/okexchain/x/dex"
@@ -84,7 +86,9 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler,
-			dexclient.DelistProposalHandler, farmclient.ManageWhiteListProposalHandler,
+			dexclient.DelistProposalHandler, dexclient.TradingRulesProposalHandler,
+			farmclient.ManageWhiteListProposalHandler, farmclient.PausePoolProposalHandler,
+			ammswapclient.WithdrawProtocolFeeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -119,6 +123,7 @@ var (
 		farm.ModuleName:           nil,
 		farm.YieldFarmingAccount:  nil,
 		farm.MintFarmingAccount:   {supply.Burner},
+		farm.VaultAccount:         nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -166,6 +171,7 @@ type OKExChainApp struct {
 	FarmKeeper     farm.Keeper
 	BackendKeeper  backend.Keeper
 	StreamKeeper   stream.Keeper
+	ProtocolKeeper proto.ProtocolKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -239,9 +245,10 @@ func NewOKExChainApp(
 	app.AccountKeeper = auth.NewAccountKeeper(
 		cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], okexchain.ProtoAccount,
 	)
-	app.BankKeeper = bank.NewBaseKeeper(
+	// the frozen tokens are kept from leaving their accounts whichever module moves them
+	app.BankKeeper = token.NewFreezeBankKeeper(bank.NewBaseKeeper(
 		app.AccountKeeper, app.subspaces[bank.ModuleName], app.BlacklistedAccAddrs(),
-	)
+	), keys[token.StoreKey], app.ModuleAccountAddrs())
 	app.ParamsKeeper.SetBankKeeper(app.BankKeeper)
 	app.SupplyKeeper = supply.NewKeeper(
 		cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms,
@@ -281,6 +288,7 @@ func NewOKExChainApp(
 	)
 
 	app.SwapKeeper = ammswap.NewKeeper(app.SupplyKeeper, app.TokenKeeper, app.cdc, app.keys[ammswap.StoreKey], app.subspaces[ammswap.ModuleName])
+	app.SwapKeeper.SetDistrKeeper(app.DistrKeeper)
 
 	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, app.subspaces[farm.StoreKey],
 		app.keys[farm.StoreKey], app.cdc)
@@ -298,18 +306,24 @@ func NewOKExChainApp(
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
+	// the upgrade plans of the passed software upgrade proposals are kept in the main store
+	app.ProtocolKeeper = proto.NewProtocolKeeper(keys[bam.MainStoreKey])
+	app.registerUpgradeMigrations()
+
 	// register the proposal types
 	// 3.register the proposal types
 	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+	govRouter.AddRoute(gov.RouterKey, gov.NewProposalHandler(app.ProtocolKeeper)).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(&app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
-		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper))
+		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
+		AddRoute(ammswap.RouterKey, ammswap.NewProposalHandler(&app.SwapKeeper))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
-		AddRoute(farm.RouterKey, &app.FarmKeeper)
+		AddRoute(farm.RouterKey, &app.FarmKeeper).
+		AddRoute(ammswap.RouterKey, &app.SwapKeeper)
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultParamspace, govRouter,
@@ -318,6 +332,7 @@ func NewOKExChainApp(
 	app.ParamsKeeper.SetGovKeeper(app.GovKeeper)
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
+	app.SwapKeeper.SetGovKeeper(app.GovKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -371,6 +386,7 @@ func NewOKExChainApp(
 		gov.ModuleName,
 		dex.ModuleName,
 		order.ModuleName,
+		ammswap.ModuleName,
 		staking.ModuleName,
 		backend.ModuleName,
 		stream.ModuleName,
@@ -414,7 +430,8 @@ func NewOKExChainApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper)))
+	app.SetAnteHandler(app.gateUpgradeMsgs(
+		ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper))))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -431,6 +448,8 @@ func (app *OKExChainApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker updates every begin block
 func (app *OKExChainApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// halt or run the store migration if the height of the upgrade plan is reached
+	app.ProtocolKeeper.ApplyUpgradeConfig(ctx)
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -471,6 +490,8 @@ func (app *OKExChainApp) syncTx(txBytes []byte) {
 func (app *OKExChainApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState simapp.GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	// the state initialized from the genesis needs none of the upgrade migrations
+	app.ProtocolKeeper.SetMigrationsApplied(ctx)
 	return app.mm.InitGenesis(ctx, genesisState)
 }
 
@@ -582,6 +603,21 @@ func validateMsgHook(orderKeeper order.Keeper) ante.ValidateMsgHandler {
 					return wrongMsgErr
 				}
 				err = order.ValidateMsgCancelOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgNewStopOrders:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgNewStopOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgCancelStopOrders:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgCancelStopOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgAmendOrders:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgAmendOrders(newCtx, orderKeeper, assertedMsg)
 			case evmtypes.MsgEthereumTx:
 				if len(msgs) > 1 {
 					return wrongMsgErr
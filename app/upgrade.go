@@ -0,0 +1,143 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/ammswap"
+	swaptypes "github.com/okex/okexchain/x/ammswap/types"
+	distr "github.com/okex/okexchain/x/distribution"
+	"github.com/okex/okexchain/x/farm"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/order"
+	"github.com/okex/okexchain/x/params"
+	"github.com/okex/okexchain/x/staking"
+	"github.com/okex/okexchain/x/token"
+)
+
+// UpgradeMigrationName is the migration hook of the software upgrade proposal, whose store migration is run
+// in-process at the height of the upgrade
+const UpgradeMigrationName = "v1-migration"
+
+// the in-process store migrations of the features, each of them is applied once and recorded by the protocol keeper
+const (
+	OrderFeeScheduleMigrationName = "order-fee-schedule"
+	SwapLimitOrderMigrationName   = "ammswap-limit-order"
+	FarmLockBoostMigrationName    = "farm-lock-boost"
+	FarmVaultMigrationName        = "farm-vault"
+	TokenVestingMigrationName     = "token-vesting-queue"
+	DelegatorRewardsMigrationName = "distr-delegator-rewards"
+	StakingRebalanceMigrationName = "staking-rebalance"
+)
+
+// registerUpgradeMigrations registers the in-process store migrations of the app-upgrades
+func (app *OKExChainApp) registerUpgradeMigrations() {
+	app.ProtocolKeeper.SetMigrationHandler(OrderFeeScheduleMigrationName, app.migrateOrderFeeSchedule)
+	app.ProtocolKeeper.SetMigrationHandler(SwapLimitOrderMigrationName, app.migrateSwapLimitOrder)
+	app.ProtocolKeeper.SetMigrationHandler(FarmLockBoostMigrationName, app.migrateFarmLockBoost)
+	app.ProtocolKeeper.SetMigrationHandler(FarmVaultMigrationName, app.migrateFarmVault)
+	app.ProtocolKeeper.SetMigrationHandler(TokenVestingMigrationName, app.migrateTokenVesting)
+	app.ProtocolKeeper.SetMigrationHandler(DelegatorRewardsMigrationName, app.migrateDelegatorRewards)
+	app.ProtocolKeeper.SetMigrationHandler(StakingRebalanceMigrationName, app.migrateStakingRebalance)
+
+	app.ProtocolKeeper.SetMigrationHandler(UpgradeMigrationName, func(ctx sdk.Context) error {
+		return app.ProtocolKeeper.RunMigrations(ctx,
+			OrderFeeScheduleMigrationName,
+			SwapLimitOrderMigrationName,
+			FarmLockBoostMigrationName,
+			FarmVaultMigrationName,
+			TokenVestingMigrationName,
+			DelegatorRewardsMigrationName,
+			StakingRebalanceMigrationName,
+		)
+	})
+}
+
+// migrateOrderFeeSchedule sets the maker fee rate, the fee tiers and the other params added to the order module
+func (app *OKExChainApp) migrateOrderFeeSchedule(ctx sdk.Context) error {
+	orderParams := order.DefaultParams()
+	params.MigrateParamSet(ctx, app.subspaces[order.ModuleName], &orderParams)
+	return nil
+}
+
+// migrateSwapLimitOrder sets the limit order fee added to the ammswap module
+func (app *OKExChainApp) migrateSwapLimitOrder(ctx sdk.Context) error {
+	swapParams := ammswap.DefaultParams()
+	params.MigrateParamSet(ctx, app.subspaces[ammswap.ModuleName], &swapParams)
+	return nil
+}
+
+// migrateFarmLockBoost lets the pools created before the lock durations are supported share the rewards by the value
+// locked
+func (app *OKExChainApp) migrateFarmLockBoost(ctx sdk.Context) error {
+	farmParams := farm.DefaultParams()
+	params.MigrateParamSet(ctx, app.subspaces[farm.ModuleName], &farmParams)
+	app.FarmKeeper.MigrateTotalWeightedValueLocked(ctx)
+	return nil
+}
+
+// migrateFarmVault sets the vault params added to the farm module
+func (app *OKExChainApp) migrateFarmVault(ctx sdk.Context) error {
+	farmParams := farm.DefaultParams()
+	params.MigrateParamSet(ctx, app.subspaces[farm.ModuleName], &farmParams)
+	return nil
+}
+
+// migrateTokenVesting releases the vesting schedules created before through the queue
+func (app *OKExChainApp) migrateTokenVesting(ctx sdk.Context) error {
+	app.TokenKeeper.MigrateVestingQueue(ctx)
+	return nil
+}
+
+// migrateDelegatorRewards lets the shares added before the delegator rewards accrue the rewards from now on
+func (app *OKExChainApp) migrateDelegatorRewards(ctx sdk.Context) error {
+	app.DistrKeeper.InitializeDelegations(ctx)
+	return nil
+}
+
+// migrateStakingRebalance sets the rebalance cool-down added to the staking module
+func (app *OKExChainApp) migrateStakingRebalance(ctx sdk.Context) error {
+	stakingParams := staking.DefaultParams()
+	params.MigrateParamSet(ctx, app.subspaces[staking.ModuleName], &stakingParams)
+	return nil
+}
+
+// getMsgMigrationName returns the name of the migration which the msg works on the migrated state only, or empty if
+// the msg works on the state before the upgrade
+func getMsgMigrationName(msg sdk.Msg) string {
+	switch msg := msg.(type) {
+	case order.MsgSetProductFeeRates:
+		return OrderFeeScheduleMigrationName
+	case swaptypes.MsgCreateLimitOrder, swaptypes.MsgCancelLimitOrder:
+		return SwapLimitOrderMigrationName
+	case farmtypes.MsgLock:
+		if msg.LockDuration > 0 {
+			return FarmLockBoostMigrationName
+		}
+	case farmtypes.MsgVaultDeposit, farmtypes.MsgVaultWithdraw:
+		return FarmVaultMigrationName
+	case token.MsgVestedSend:
+		return TokenVestingMigrationName
+	case distr.MsgWithdrawDelegatorReward, staking.MsgEditValidatorCommissionRate:
+		return DelegatorRewardsMigrationName
+	case staking.MsgRebalanceShares:
+		return StakingRebalanceMigrationName
+	}
+	return ""
+}
+
+// gateUpgradeMsgs wraps the ante handler to reject the msgs of the features whose migration isn't applied yet, so
+// that they never run on the state before the upgrade
+func (app *OKExChainApp) gateUpgradeMsgs(anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		for _, msg := range tx.GetMsgs() {
+			name := getMsgMigrationName(msg)
+			if len(name) != 0 && !app.ProtocolKeeper.IsMigrationApplied(ctx, name) {
+				return ctx, sdk.ErrUnknownRequest(fmt.Sprintf("%s/%s is disabled before the upgrade migration %s is applied",
+					msg.Route(), msg.Type(), name))
+			}
+		}
+		return anteHandler(ctx, tx, simulate)
+	}
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	distr "github.com/okex/okexchain/x/distribution"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/staking"
+)
+
+func TestGateUpgradeMsgs(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("delegator-address"))
+	valAddr := sdk.ValAddress([]byte("validator-address"))
+	amount := sdk.NewDecCoinFromDec("okt", sdk.OneDec())
+
+	require.Equal(t, "", getMsgMigrationName(farmtypes.NewMsgLock("pool", delAddr, amount, 0)))
+	require.Equal(t, FarmLockBoostMigrationName,
+		getMsgMigrationName(farmtypes.NewMsgLock("pool", delAddr, amount, time.Hour)))
+	require.Equal(t, StakingRebalanceMigrationName, getMsgMigrationName(
+		staking.NewMsgRebalanceShares(delAddr, []sdk.ValAddress{valAddr}, []sdk.ValAddress{valAddr})))
+
+	// the msgs are rejected on the state before the upgrade
+	app := Setup(true)
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	anteHandler := app.gateUpgradeMsgs(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	tx := auth.NewStdTx([]sdk.Msg{distr.NewMsgWithdrawDelegatorReward(delAddr, valAddr)}, auth.StdFee{}, nil, "")
+	_, err := anteHandler(ctx, tx, false)
+	require.Error(t, err)
+
+	// the msgs are accepted once the upgrade migration is applied
+	require.Nil(t, app.ProtocolKeeper.RunMigrations(ctx, UpgradeMigrationName))
+	_, err = anteHandler(ctx, tx, false)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), app.FarmKeeper.GetParams(ctx).VaultMaxSlippage)
+
+	// the state initialized from the genesis needs no migration
+	app = Setup(false)
+	ctx = app.BaseApp.NewContext(false, abci.Header{})
+	for _, name := range []string{OrderFeeScheduleMigrationName, DelegatorRewardsMigrationName, UpgradeMigrationName} {
+		require.True(t, app.ProtocolKeeper.IsMigrationApplied(ctx, name))
+	}
+}
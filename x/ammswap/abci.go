@@ -9,6 +9,8 @@ import (
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 }
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker called every block, executes the limit orders triggered by the pool prices
+// and refunds the expired ones
 func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ExecuteLimitOrders(ctx)
 }
@@ -22,6 +22,10 @@ var (
 	RegisterCodec        = types.RegisterCodec
 	NewMsgAddLiquidity   = types.NewMsgAddLiquidity
 	GetSwapTokenPairName = types.GetSwapTokenPairName
+	GetSpotPrice         = keeper.GetSpotPrice
+	DefaultParams        = types.DefaultParams
+
+	NewWithdrawProtocolFeeProposal = types.NewWithdrawProtocolFeeProposal
 
 	// variable aliases
 	// nolint
@@ -35,4 +39,6 @@ type (
 
 	// nolint
 	SwapTokenPair = types.SwapTokenPair
+	ProtocolFee   = types.ProtocolFee
+	LimitOrder    = types.LimitOrder
 )
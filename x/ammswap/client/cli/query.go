@@ -12,6 +12,7 @@ import (
 	"github.com/okex/okexchain/x/ammswap/types"
 	"github.com/spf13/cobra"
 	"strings"
+	"time"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -32,6 +33,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdAllSwapTokenPairs(queryRoute, cdc),
 			GetCmdRedeemableAssets(queryRoute, cdc),
 			GetCmdQueryBuyAmount(queryRoute, cdc),
+			GetCmdQuerySwapRoute(queryRoute, cdc),
+			GetCmdQueryTWAP(queryRoute, cdc),
+			GetCmdQueryProtocolFees(queryRoute, cdc),
+			GetCmdQueryLimitOrder(queryRoute, cdc),
+			GetCmdQueryLimitOrders(queryRoute, cdc),
 		)...,
 	)
 
@@ -109,6 +115,203 @@ $ %s query swap amount 100eth-245 xxb`, version.ClientName,
 	}
 }
 
+// GetCmdQuerySwapRoute queries the best route to swap and the amount expected to buy
+func GetCmdQuerySwapRoute(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "route [token-to-sell] [token-name-to-buy]",
+		Short: "Query the best route to swap and the amount of token returned through it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the best route over all the swap token pairs and the amount of token returned through it.
+The route of the given intermediate tokens is queried if --path is set.
+
+Example:
+$ %s query swap route 100eth-245 xxb
+$ %s query swap route 100eth-245 xxb --path okt,btc-366`, version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			sellToken, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+			var tokens []string
+			if path != "" {
+				tokens = strings.Split(path, ",")
+			}
+			params := types.NewQuerySwapRouteParams(sellToken, args[1], tokens)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var routeInfo types.SwapRouteInfo
+			cdc.MustUnmarshalJSON(res, &routeInfo)
+			return cliCtx.PrintOutput(routeInfo)
+		},
+	}
+	cmd.Flags().StringVar(&path, "path", "", "Intermediate tokens to swap through, separated by commas")
+	return cmd
+}
+
+// GetCmdQueryTWAP queries the time-weighted average price of a token pair
+func GetCmdQueryTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	var startHeight int64
+	var window string
+	cmd := &cobra.Command{
+		Use:   "twap [base-token] [quote-token]",
+		Short: "Query the time-weighted average price of a token pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the time-weighted average price of a token pair since the start height, or in the time window.
+
+Example:
+$ %s query swap twap eth-355 okt --start-height 1000
+$ %s query swap twap eth-355 okt --window 1h`, version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var timeWindow int64
+			if window != "" {
+				dur, err := time.ParseDuration(window)
+				if err != nil {
+					return err
+				}
+				timeWindow = int64(dur.Seconds())
+			}
+			params := types.NewQueryTWAPParams(types.GetSwapTokenPairName(args[0], args[1]), startHeight, timeWindow)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTWAP), bz)
+			if err != nil {
+				return err
+			}
+
+			var twap types.TWAP
+			cdc.MustUnmarshalJSON(res, &twap)
+			return cliCtx.PrintOutput(twap)
+		},
+	}
+	cmd.Flags().Int64Var(&startHeight, "start-height", 0, "The height the window starts from")
+	cmd.Flags().StringVar(&window, "window", "", "The time window such as \"30m\" or \"24h\", used if --start-height is not set")
+	return cmd
+}
+
+// GetCmdQueryProtocolFees queries the protocol fees accrued by a token pair, or by all the token pairs
+func GetCmdQueryProtocolFees(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "protocol-fees [base-token] [quote-token]",
+		Short: "Query the protocol fees accrued by a token pair, or by all the token pairs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the protocol fees which are accrued by the swap token pairs and not withdrawn yet.
+
+Example:
+$ %s query swap protocol-fees
+$ %s query swap protocol-fees eth-355 okt`, version.ClientName, version.ClientName,
+			),
+		),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProtocolFees)
+			if len(args) == 2 {
+				route = fmt.Sprintf("%s/%s", route, types.GetSwapTokenPairName(args[0], args[1]))
+			}
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var protocolFees []types.ProtocolFee
+			cdc.MustUnmarshalJSON(res, &protocolFees)
+			return cliCtx.PrintOutput(protocolFees)
+		},
+	}
+}
+
+// GetCmdQueryLimitOrder queries a limit order by its id
+func GetCmdQueryLimitOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-order [order-id]",
+		Short: "Query a limit order by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a limit order waiting for its trigger price by its id.
+
+Example:
+$ %s query swap limit-order 1`, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryLimitOrder, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var order types.LimitOrder
+			cdc.MustUnmarshalJSON(res, &order)
+			return cliCtx.PrintOutput(order)
+		},
+	}
+}
+
+// GetCmdQueryLimitOrders queries the limit orders of an address, or all the limit orders
+func GetCmdQueryLimitOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-orders [sender-addr]",
+		Short: "Query the limit orders of an address, or all the limit orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the limit orders waiting for their trigger prices.
+
+Example:
+$ %s query swap limit-orders
+$ %s query swap limit-orders okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0`, version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var sender sdk.AccAddress
+			if len(args) == 1 {
+				addr, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				sender = addr
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryLimitOrdersParams(sender))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLimitOrders), bz)
+			if err != nil {
+				return err
+			}
+
+			var orders []types.LimitOrder
+			cdc.MustUnmarshalJSON(res, &orders)
+			return cliCtx.PrintOutput(orders)
+		},
+	}
+}
+
 // GetCmdQueryParams queries the parameters of the AMM swap system
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
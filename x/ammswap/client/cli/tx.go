@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,9 +11,12 @@ import (
 	client "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	swaputils "github.com/okex/okexchain/x/ammswap/client/utils"
 	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/gov"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +34,12 @@ const (
 	flagRecipient        = "recipient"
 	flagToken0           = "token0"
 	flagToken1           = "token1"
+	flagPath             = "path"
+	flagAutoRoute        = "auto-route"
+	flagPoolType         = "pool-type"
+	flagAmplification    = "amplification"
+	flagFeeRate          = "fee-rate"
+	flagTriggerPrice     = "trigger-price"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,6 +54,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdRemoveLiquidity(cdc),
 		getCmdCreateExchange(cdc),
 		getCmdTokenSwap(cdc),
+		getCmdCreateLimitOrder(cdc),
+		getCmdCancelLimitOrder(cdc),
 	)...)
 
 	return txCmd
@@ -163,6 +175,9 @@ func getCmdCreateExchange(cdc *codec.Codec) *cobra.Command {
 	// flags
 	var token0 string
 	var token1 string
+	var poolType string
+	var amplification int64
+	var feeRate string
 	cmd := &cobra.Command{
 		Use:   "create-pair",
 		Short: "create token pair",
@@ -171,6 +186,7 @@ func getCmdCreateExchange(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ okexchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01okt 
+$ okexchaincli tx swap create-pair --token0 usdt-123 --token1 usdk-017 --pool-type stable_swap --amplification 100 --fee-rate 0.0005 --fees 0.01okt
 
 `),
 		),
@@ -179,7 +195,17 @@ $ okexchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
-			msg := types.NewMsgCreateExchange(token0, token1, cliCtx.FromAddress)
+			rate := sdk.ZeroDec()
+			if feeRate != "" {
+				var err error
+				if rate, err = sdk.NewDecFromStr(feeRate); err != nil {
+					return err
+				}
+			}
+			if poolType == types.PoolTypeStableSwap && amplification == 0 {
+				amplification = types.DefaultAmplification
+			}
+			msg := types.NewMsgCreateExchangeWithCurve(token0, token1, poolType, amplification, rate, cliCtx.FromAddress)
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -187,6 +213,11 @@ $ okexchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01
 
 	cmd.Flags().StringVar(&token0, flagToken0, "", "the base token name is required to create an AMM swap pair")
 	cmd.Flags().StringVar(&token1, flagToken1, "", "the quote token name is required to create an AMM swap pair")
+	cmd.Flags().StringVar(&poolType, flagPoolType, types.PoolTypeConstantProduct,
+		fmt.Sprintf("the curve of the pool, %s or %s", types.PoolTypeConstantProduct, types.PoolTypeStableSwap))
+	cmd.Flags().Int64Var(&amplification, flagAmplification, 0,
+		fmt.Sprintf("the amplification coefficient of the stable swap pool, %d by default", types.DefaultAmplification))
+	cmd.Flags().StringVar(&feeRate, flagFeeRate, "", "the fee tier of the pool, the module fee rate is used if not set")
 	cmd.MarkFlagRequired(flagToken0)
 	cmd.MarkFlagRequired(flagToken1)
 	return cmd
@@ -198,6 +229,8 @@ func getCmdTokenSwap(cdc *codec.Codec) *cobra.Command {
 	var minBoughtTokenAmount string
 	var deadline string
 	var recipient string
+	var path string
+	var autoRoute bool
 	cmd := &cobra.Command{
 		Use:   "token",
 		Short: "swap token",
@@ -206,6 +239,8 @@ func getCmdTokenSwap(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ okexchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
+$ okexchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366 --path okt,usdk-017
+$ okexchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366 --auto-route
 
 `),
 		),
@@ -239,6 +274,16 @@ $ okexchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
 
 			msg := types.NewMsgTokenToToken(soldTokenAmount, minBoughtTokenAmount,
 				deadline, recip, cliCtx.FromAddress)
+			if path != "" {
+				msg.Path = strings.Split(path, ",")
+			} else if autoRoute {
+				// the best route is queried from the node and swapped through as the path
+				route, err := queryBestSwapRoute(cliCtx, cdc, soldTokenAmount, minBoughtTokenAmount.Denom)
+				if err != nil {
+					return err
+				}
+				msg.Path = route[1 : len(route)-1]
+			}
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -252,8 +297,180 @@ $ okexchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
 		"The address to receive the amount bought")
 	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "100s",
 		"Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.Flags().StringVarP(&path, flagPath, "", "",
+		"Intermediate tokens to swap through, separated by commas. The direct pair is used if not set")
+	cmd.Flags().BoolVarP(&autoRoute, flagAutoRoute, "", false,
+		"Swap through the best route over all the pairs queried from the node instead of the direct pair")
 	cmd.MarkFlagRequired(flagSellAmount)
 	cmd.MarkFlagRequired(flagMinBuyAmount)
 
 	return cmd
 }
+
+func getCmdCreateLimitOrder(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var soldTokenAmount string
+	var minBoughtTokenAmount string
+	var triggerPrice string
+	var deadline string
+	var recipient string
+	cmd := &cobra.Command{
+		Use:   "limit-order",
+		Short: "sell token when the pool price reaches the trigger price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`sell token through the pool when the spot price of the sold token in the bought token
+reaches the trigger price. The sold token is escrowed until the order executes, expires or is cancelled.
+
+Example:
+$ okexchaincli tx swap limit-order --sell-amount 1eth-355 --min-buy-amount 60btc-366 --trigger-price 61 --deadline-duration 24h
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			soldTokenAmount, err := sdk.ParseDecCoin(soldTokenAmount)
+			if err != nil {
+				return err
+			}
+			minBoughtTokenAmount, err := sdk.ParseDecCoin(minBoughtTokenAmount)
+			if err != nil {
+				return err
+			}
+			triggerPrice, err := sdk.NewDecFromStr(triggerPrice)
+			if err != nil {
+				return err
+			}
+			dur, err := time.ParseDuration(deadline)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(dur).Unix()
+			var recip sdk.AccAddress
+			if recipient == "" {
+				recip = cliCtx.FromAddress
+			} else {
+				recip, err = sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateLimitOrder(soldTokenAmount, minBoughtTokenAmount, triggerPrice,
+				deadline, recip, cliCtx.FromAddress)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&soldTokenAmount, flagSellAmount, "", "",
+		"Amount expected to sell")
+	cmd.Flags().StringVarP(&minBoughtTokenAmount, flagMinBuyAmount, "", "",
+		"Minimum amount expected to buy")
+	cmd.Flags().StringVarP(&triggerPrice, flagTriggerPrice, "", "",
+		"Spot price of the sold token in the bought token at which the order executes")
+	cmd.Flags().StringVarP(&recipient, flagRecipient, "", "",
+		"The address to receive the amount bought")
+	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "24h",
+		"Duration after which the order expires and the sold token is refunded. such as \"30m\", \"24h\" or \"168h\".")
+	cmd.MarkFlagRequired(flagSellAmount)
+	cmd.MarkFlagRequired(flagMinBuyAmount)
+	cmd.MarkFlagRequired(flagTriggerPrice)
+
+	return cmd
+}
+
+func getCmdCancelLimitOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-limit-order [order-id]",
+		Short: "cancel a limit order and refund the sold token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`cancel a limit order and refund the sold token.
+
+Example:
+$ okexchaincli tx swap cancel-limit-order 1
+
+`),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelLimitOrder(orderID, cliCtx.FromAddress)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawProtocolFeeProposal implements a command handler for submitting a withdraw protocol fee proposal transaction
+func GetCmdWithdrawProtocolFeeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-protocol-fee [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to withdraw the protocol fees accrued by a swap token pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to withdraw the protocol fees accrued by a swap token pair along with an initial deposit.
+The protocol fees are sent to the community pool, or burned. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal withdraw-protocol-fee <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "withdraw protocol fees of eth-355_okt",
+ "description": "fund the community pool with the protocol fees",
+ "token_pair_name": "eth-355_okt",
+ "destination": "%s",
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, types.ProtocolFeeToCommunityPool, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := swaputils.ParseWithdrawProtocolFeeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewWithdrawProtocolFeeProposal(proposal.Title, proposal.Description,
+				proposal.TokenPairName, proposal.Destination)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// queryBestSwapRoute queries the best route over all the swap token pairs to sell the token
+func queryBestSwapRoute(cliCtx context.CLIContext, cdc *codec.Codec, soldToken sdk.SysCoin,
+	tokenToBuy string) ([]string, error) {
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapRouteParams(soldToken, tokenToBuy, nil))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwapRoute), bz)
+	if err != nil {
+		return nil, err
+	}
+	var routeInfo types.SwapRouteInfo
+	if err := cdc.UnmarshalJSON(res, &routeInfo); err != nil {
+		return nil, err
+	}
+	return routeInfo.Route, nil
+}
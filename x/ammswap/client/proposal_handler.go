@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/okex/okexchain/x/ammswap/client/cli"
+	"github.com/okex/okexchain/x/ammswap/client/rest"
+	govcli "github.com/okex/okexchain/x/gov/client"
+)
+
+var (
+	// WithdrawProtocolFeeProposalHandler alias gov NewProposalHandler
+	WithdrawProtocolFeeProposalHandler = govcli.NewProposalHandler(cli.GetCmdWithdrawProtocolFeeProposal,
+		rest.WithdrawProtocolFeeProposalRESTHandler)
+)
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"github.com/gorilla/mux"
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 )
@@ -10,3 +11,8 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 }
+
+// WithdrawProtocolFeeProposalRESTHandler defines ammswap proposal handler
+func WithdrawProtocolFeeProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
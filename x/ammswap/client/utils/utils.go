@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawProtocolFeeProposalJSON defines a WithdrawProtocolFeeProposal with a deposit used to parse
+// withdraw protocol fee proposals from a JSON file.
+type WithdrawProtocolFeeProposalJSON struct {
+	Title         string       `json:"title" yaml:"title"`
+	Description   string       `json:"description" yaml:"description"`
+	TokenPairName string       `json:"token_pair_name" yaml:"token_pair_name"`
+	Destination   string       `json:"destination" yaml:"destination"`
+	Deposit       sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseWithdrawProtocolFeeProposalJSON parse json from proposal file to WithdrawProtocolFeeProposalJSON struct
+func ParseWithdrawProtocolFeeProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal WithdrawProtocolFeeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
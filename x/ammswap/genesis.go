@@ -12,6 +12,9 @@ import (
 type GenesisState struct {
 	Params               Params          `json:"params"`
 	SwapTokenPairRecords []SwapTokenPair `json:"swap_token_pair_records"`
+	ProtocolFees         []ProtocolFee   `json:"protocol_fees"`
+	LimitOrders          []LimitOrder    `json:"limit_orders"`
+	LastLimitOrderID     uint64          `json:"last_limit_order_id"`
 }
 
 // nolint
@@ -32,6 +35,19 @@ func ValidateGenesis(data GenesisState) error {
 			return fmt.Errorf("invalid SwapTokenPairRecord: PoolToken: %s. Error: invalid PoolToken", record.PoolTokenName)
 		}
 	}
+	for _, protocolFee := range data.ProtocolFees {
+		if !protocolFee.Fees.IsValid() {
+			return fmt.Errorf("invalid ProtocolFee: %s: %s", protocolFee.TokenPairName, protocolFee.Fees)
+		}
+	}
+	for _, order := range data.LimitOrders {
+		if order.OrderID == 0 || order.OrderID > data.LastLimitOrderID {
+			return fmt.Errorf("invalid LimitOrder: OrderID: %d, LastLimitOrderID: %d", order.OrderID, data.LastLimitOrderID)
+		}
+		if !order.SoldTokenAmount.IsValid() || !order.SoldTokenAmount.IsPositive() {
+			return fmt.Errorf("invalid LimitOrder: SoldTokenAmount: %s", order.SoldTokenAmount)
+		}
+	}
 	return nil
 }
 
@@ -49,6 +65,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, record := range data.SwapTokenPairRecords {
 		keeper.SetSwapTokenPair(ctx, record.TokenPairName(), record)
 	}
+	for _, protocolFee := range data.ProtocolFees {
+		keeper.SetProtocolFees(ctx, protocolFee.TokenPairName, protocolFee.Fees)
+	}
+	for _, order := range data.LimitOrders {
+		keeper.SetLimitOrder(ctx, order)
+	}
+	keeper.SetLastLimitOrderID(ctx, data.LastLimitOrderID)
 }
 
 // ExportGenesis exports genesis from keeper
@@ -62,5 +85,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	}
 	params := k.GetParams(ctx)
-	return GenesisState{SwapTokenPairRecords: records, Params: params}
+	return GenesisState{
+		SwapTokenPairRecords: records,
+		Params:               params,
+		ProtocolFees:         k.GetAllProtocolFees(ctx),
+		LimitOrders:          k.GetLimitOrders(ctx),
+		LastLimitOrderID:     k.GetLastLimitOrderID(ctx),
+	}
 }
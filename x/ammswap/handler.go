@@ -1,6 +1,9 @@
 package ammswap
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/okex/okexchain/x/ammswap/keeper"
 	"github.com/okex/okexchain/x/ammswap/types"
 	"github.com/okex/okexchain/x/common"
@@ -36,6 +39,16 @@ func NewHandler(k Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenToToken(ctx, k, msg)
 			}
+		case types.MsgCreateLimitOrder:
+			name = "handleMsgCreateLimitOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCreateLimitOrder(ctx, k, msg)
+			}
+		case types.MsgCancelLimitOrder:
+			name = "handleMsgCancelLimitOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCancelLimitOrder(ctx, k, msg)
+			}
 		default:
 			return nil, types.ErrSwapUnknownMsgType()
 		}
@@ -49,12 +62,88 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgTokenToToken(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*sdk.Result, error) {
-	_, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName())
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+
+	// swap through the given path or the direct swap token pair. the best route is searched by the swap route query
+	// off the chain, which walks all the swap token pairs
+	route := msg.GetRoute()
+	amounts, err := k.CalculateRouteTokensToBuy(ctx, msg.SoldTokenAmount, route, k.GetParams(ctx))
 	if err != nil {
-		return swapTokenByRouter(ctx, k, msg)
-	} else {
-		return swapToken(ctx, k, msg)
+		return nil, err
 	}
+
+	// slippage applies to the final output only
+	tokenBuy := amounts[len(amounts)-1]
+	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+
+	res, err := swapTokenByRoute(ctx, k, route, amounts, msg)
+	if err != nil {
+		return res, err
+	}
+	event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
+	event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	event.AppendAttributes(sdk.NewAttribute("route", strings.Join(route, ",")))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateLimitOrder(ctx sdk.Context, k Keeper, msg types.MsgCreateLimitOrder) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	// the order waiting for the trigger price is retried in every block, so that it mustn't wait too long
+	if maxDeadline := ctx.BlockTime().Add(types.MaxLimitOrderLifetime).Unix(); msg.Deadline > maxDeadline {
+		return types.ErrLimitOrderDeadlineTooLate(msg.Deadline, maxDeadline).Result()
+	}
+	if _, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName()); err != nil {
+		return nil, err
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+
+	// escrow the sold token until the trigger price is reached in EndBlocker
+	order, err := k.PlaceLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelLimitOrder(ctx sdk.Context, k Keeper, msg types.MsgCancelLimitOrder) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	order, found := k.GetLimitOrder(ctx, msg.OrderID)
+	if !found {
+		return types.ErrLimitOrderNotExist(msg.OrderID).Result()
+	}
+	if !order.Sender.Equals(msg.Sender) {
+		return types.ErrNotLimitOrderSender(msg.OrderID, msg.Sender.String()).Result()
+	}
+	if err := k.QuitLimitOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)))
+	event.AppendAttributes(sdk.NewAttribute("refund_amount", order.SoldTokenAmount.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleMsgCreateExchange(ctx sdk.Context, k Keeper, msg types.MsgCreateExchange) (*sdk.Result, error) {
@@ -88,8 +177,15 @@ func handleMsgCreateExchange(ctx sdk.Context, k Keeper, msg types.MsgCreateExcha
 	// 3. create the pool token
 	k.NewPoolToken(ctx, poolTokenName)
 
-	// 4. create the token pair
+	// 4. create the token pair with the curve and the fee tier
 	swapTokenPair := types.NewSwapPair(msg.Token0Name, msg.Token1Name)
+	if msg.PoolType != "" {
+		swapTokenPair.PoolType = msg.PoolType
+		swapTokenPair.Amplification = msg.Amplification
+	}
+	if !msg.FeeRate.IsNil() {
+		swapTokenPair.FeeRate = msg.FeeRate
+	}
 	k.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
 
 	// 5. notify backend module
@@ -120,15 +216,22 @@ func handleMsgAddLiquidity(ctx sdk.Context, k Keeper, msg types.MsgAddLiquidity)
 		baseTokens.Amount = msg.MaxBaseAmount.Amount
 		liquidity = sdk.NewDec(1)
 	} else if swapTokenPair.BasePooledCoin.IsPositive() && swapTokenPair.QuotePooledCoin.IsPositive() {
-		baseTokens.Amount = common.MulAndQuo(msg.QuoteAmount.Amount, swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount)
 		totalSupply := k.GetPoolTokenAmount(ctx, swapTokenPair.PoolTokenName)
-		if baseTokens.IsZero() {
-			baseTokens.Amount = sdk.NewDecWithPrec(1, sdk.Precision)
-		}
 		if totalSupply.IsZero() {
 			return types.ErrIsZeroValue("totalSupply").Result()
 		}
-		liquidity = common.MulAndQuo(msg.QuoteAmount.Amount, totalSupply, swapTokenPair.QuotePooledCoin.Amount)
+		if swapTokenPair.IsStableSwap() {
+			// stable swap pool accepts the imbalanced deposit, and mints by the growth of the invariant
+			baseTokens.Amount = msg.MaxBaseAmount.Amount
+			liquidity = keeper.CalculateStableSwapLiquidity(swapTokenPair, baseTokens.Amount, msg.QuoteAmount.Amount,
+				totalSupply, k.GetParams(ctx))
+		} else {
+			baseTokens.Amount = common.MulAndQuo(msg.QuoteAmount.Amount, swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount)
+			if baseTokens.IsZero() {
+				baseTokens.Amount = sdk.NewDecWithPrec(1, sdk.Precision)
+			}
+			liquidity = common.MulAndQuo(msg.QuoteAmount.Amount, totalSupply, swapTokenPair.QuotePooledCoin.Amount)
+		}
 		if liquidity.IsZero() {
 			return types.ErrIsZeroValue("liquidity").Result()
 		}
@@ -155,6 +258,7 @@ func handleMsgAddLiquidity(ctx sdk.Context, k Keeper, msg types.MsgAddLiquidity)
 		return types.ErrSendCoinsFailed(err).Result()
 	}
 	// update swapTokenPair
+	k.UpdatePriceAccumulator(ctx, swapTokenPair)
 	swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(msg.QuoteAmount)
 	swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(baseTokens)
 	k.SetSwapTokenPair(ctx, msg.GetSwapTokenPairName(), swapTokenPair)
@@ -213,6 +317,7 @@ func handleMsgRemoveLiquidity(ctx sdk.Context, k Keeper, msg types.MsgRemoveLiqu
 		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
 	}
 	// update swapTokenPair
+	k.UpdatePriceAccumulator(ctx, swapTokenPair)
 	swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(quoteAmount)
 	swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(baseAmount)
 	k.SetSwapTokenPair(ctx, msg.GetSwapTokenPairName(), swapTokenPair)
@@ -230,107 +335,10 @@ func handleMsgRemoveLiquidity(ctx sdk.Context, k Keeper, msg types.MsgRemoveLiqu
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
-func swapToken(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*sdk.Result, error) {
-	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
-
-	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
-		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
-		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
-	}
-	if msg.Deadline < ctx.BlockTime().Unix() {
-		return types.ErrBlockTimeBigThanDeadline().Result()
-	}
-	swapTokenPair, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName())
-	if err != nil {
-		return nil, err
-	}
-	if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
-		return types.ErrIsZeroValue("base pooled coin or quote pooled coin").Result()
-	}
-	params := k.GetParams(ctx)
-	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPair, msg.SoldTokenAmount, msg.MinBoughtTokenAmount.Denom, params)
-	if tokenBuy.IsZero() {
-		return types.ErrIsZeroValue("token buy").Result()
-	}
-	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
-		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
-	}
-
-	res, err := swapTokenNativeToken(ctx, k, swapTokenPair, tokenBuy, msg)
-	if err != nil {
-		return res, err
-	}
-	event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
-	event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
-	ctx.EventManager().EmitEvent(event)
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
-}
-
-func swapTokenByRouter(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*sdk.Result, error) {
-	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
-
-	if msg.Deadline < ctx.BlockTime().Unix() {
-		return types.ErrBlockTimeBigThanDeadline().Result()
-	}
-	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
-		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
-		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
-	}
-	tokenPairOne := types.GetSwapTokenPairName(msg.SoldTokenAmount.Denom, sdk.DefaultBondDenom)
-	swapTokenPairOne, err := k.GetSwapTokenPair(ctx, tokenPairOne)
-	if err != nil {
-		return nil, types.ErrNonExistSwapTokenPair(msg.GetSwapTokenPairName())
-	}
-	if swapTokenPairOne.BasePooledCoin.IsZero() || swapTokenPairOne.QuotePooledCoin.IsZero() {
-		return types.ErrIsZeroValue("base pooled coin or quote pooled coin").Result()
-	}
-	tokenPairTwo := types.GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, sdk.DefaultBondDenom)
-	swapTokenPairTwo, err := k.GetSwapTokenPair(ctx, tokenPairTwo)
-	if err != nil {
-		return nil, types.ErrNonExistSwapTokenPair(msg.GetSwapTokenPairName())
-	}
-	if swapTokenPairTwo.BasePooledCoin.IsZero() || swapTokenPairTwo.QuotePooledCoin.IsZero() {
-		return types.ErrIsZeroValue("base pooled coin or quote pooled coin").Result()
-	}
-
-	nativeAmount := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.MustNewDecFromStr("0"))
-	params := k.GetParams(ctx)
-	msgOne := msg
-	msgOne.MinBoughtTokenAmount = nativeAmount
-	tokenNative := keeper.CalculateTokenToBuy(swapTokenPairOne, msgOne.SoldTokenAmount, msgOne.MinBoughtTokenAmount.Denom, params)
-	if tokenNative.IsZero() {
-		return types.ErrIsZeroValue("token native").Result()
-	}
-	msgTwo := msg
-	msgTwo.SoldTokenAmount = tokenNative
-	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPairTwo, msgTwo.SoldTokenAmount, msgTwo.MinBoughtTokenAmount.Denom, params)
-	// sanity check. user may set MinBoughtTokenAmount to zero on front end.
-	// if set zero,this will not return err
-	if tokenBuy.IsZero() {
-		return types.ErrIsZeroValue("token to buy amount").Result()
-	}
-	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
-		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
-	}
-
-	res, err := swapTokenNativeToken(ctx, k, swapTokenPairOne, tokenNative, msgOne)
-	if err != nil {
-		return res, err
-	}
-	res, err = swapTokenNativeToken(ctx, k, swapTokenPairTwo, tokenBuy, msgTwo)
-	if err != nil {
-		return res, err
-	}
-
-	event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
-	event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
-	ctx.EventManager().EmitEvent(event)
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
-}
-
-func swapTokenNativeToken(
-	ctx sdk.Context, k Keeper, swapTokenPair SwapTokenPair, tokenBuy sdk.SysCoin,
-	msg types.MsgTokenToToken,
+// swapTokenByRoute transfers the sold token from the sender and the bought token to the recipient,
+// and updates every swap token pair along the route
+func swapTokenByRoute(
+	ctx sdk.Context, k Keeper, route []string, amounts []sdk.SysCoin, msg types.MsgTokenToToken,
 ) (*sdk.Result, error) {
 	// transfer coins
 	err := k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender)
@@ -338,21 +346,16 @@ func swapTokenNativeToken(
 		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
 	}
 
+	tokenBuy := amounts[len(amounts)-1]
 	err = k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{tokenBuy}, msg.Recipient)
 	if err != nil {
 		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
 	}
 
-	// update swapTokenPair
-	if msg.MinBoughtTokenAmount.Denom < msg.SoldTokenAmount.Denom {
-		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(msg.SoldTokenAmount)
-		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(tokenBuy)
-	} else {
-		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(tokenBuy)
-		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(msg.SoldTokenAmount)
+	// update swapTokenPairs
+	if err := k.UpdateSwapTokenPairsByRoute(ctx, route, amounts, msg.Recipient); err != nil {
+		return nil, err
 	}
-	k.SetSwapTokenPair(ctx, msg.GetSwapTokenPairName(), swapTokenPair)
-	k.OnSwapToken(ctx, msg.Recipient, swapTokenPair, msg.SoldTokenAmount, tokenBuy)
 	return &sdk.Result{}, nil
 }
 
@@ -3,6 +3,7 @@ package ammswap
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -566,3 +567,275 @@ func buildRandomMsgTokenToToken(addr sdk.AccAddress) types.MsgTokenToToken {
 
 	return msg
 }
+
+func TestHandleMsgTokenToTokenWithPath(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	deadLine := time.Now().Unix()
+
+	// create pools of aab/okt, ccb/okt and a shallow pool of aab/ccb
+	testToken := token.InitTestToken(types.TestBasePooledToken)
+	secondTestToken := token.InitTestToken(types.TestBasePooledToken2)
+	testQuoteToken := token.InitTestToken(types.TestQuotePooledToken)
+	mapp.tokenKeeper.NewToken(ctx, testToken)
+	mapp.tokenKeeper.NewToken(ctx, secondTestToken)
+	mapp.tokenKeeper.NewToken(ctx, testQuoteToken)
+	pools := []struct {
+		base, quote string
+		amount      int64
+	}{
+		{testToken.Symbol, types.TestQuotePooledToken, 10000},
+		{secondTestToken.Symbol, types.TestQuotePooledToken, 10000},
+		{testToken.Symbol, secondTestToken.Symbol, 10},
+	}
+	for _, pool := range pools {
+		_, err := handler(ctx, types.NewMsgCreateExchange(pool.base, pool.quote, addr))
+		require.Nil(t, err)
+		_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), sdk.NewDecCoinFromDec(pool.base, sdk.NewDec(pool.amount)),
+			sdk.NewDecCoinFromDec(pool.quote, sdk.NewDec(pool.amount)), deadLine, addr))
+		require.Nil(t, err)
+	}
+
+	soldTokenAmount := sdk.NewDecCoinFromDec(secondTestToken.Symbol, sdk.NewDec(100))
+	route := []string{secondTestToken.Symbol, types.TestQuotePooledToken, testToken.Symbol}
+	amounts, err := keeper.CalculateRouteTokensToBuy(ctx, soldTokenAmount, route, keeper.GetParams(ctx))
+	require.Nil(t, err)
+	tokenBuy := amounts[len(amounts)-1]
+	balanceBefore := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+
+	// the slippage applies to the final output
+	msg := types.NewMsgTokenToToken(soldTokenAmount, sdk.NewDecCoinFromDec(testToken.Symbol, tokenBuy.Amount.Add(sdk.OneDec())),
+		deadLine, addr, addr)
+	msg.Path = []string{types.TestQuotePooledToken}
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	// a pool of the path does not exist
+	msg.MinBoughtTokenAmount.Amount = tokenBuy.Amount
+	msg.Path = []string{types.TestBasePooledToken3}
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	msg.Path = []string{types.TestQuotePooledToken}
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+
+	balanceAfter := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balanceBefore.AmountOf(testToken.Symbol).Add(tokenBuy.Amount), balanceAfter.AmountOf(testToken.Symbol))
+	require.Equal(t, balanceBefore.AmountOf(secondTestToken.Symbol).Sub(soldTokenAmount.Amount), balanceAfter.AmountOf(secondTestToken.Symbol))
+	require.Equal(t, balanceBefore.AmountOf(types.TestQuotePooledToken), balanceAfter.AmountOf(types.TestQuotePooledToken))
+
+	// every pool along the path is updated and the shallow pool is untouched
+	swapTokenPair, err := keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(secondTestToken.Symbol, types.TestQuotePooledToken))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(10100), swapTokenPair.BasePooledCoin.Amount)
+	require.Equal(t, sdk.NewDec(10000).Sub(amounts[1].Amount), swapTokenPair.QuotePooledCoin.Amount)
+	swapTokenPair, err = keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(testToken.Symbol, types.TestQuotePooledToken))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(10000).Sub(tokenBuy.Amount), swapTokenPair.BasePooledCoin.Amount)
+	require.Equal(t, sdk.NewDec(10000).Add(amounts[1].Amount), swapTokenPair.QuotePooledCoin.Amount)
+	swapTokenPair, err = keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(testToken.Symbol, secondTestToken.Symbol))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(10), swapTokenPair.BasePooledCoin.Amount)
+
+	// without path, the direct pair is used even if it's shallow
+	msg.Path = nil
+	msg.MinBoughtTokenAmount.Amount = sdk.ZeroDec()
+	result, err := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	require.Nil(t, err)
+	requireSwapRoute(t, []string{secondTestToken.Symbol, testToken.Symbol}, result)
+
+	// the best route isn't searched on chain if the direct swap token pair isn't available
+	msg = types.NewMsgTokenToToken(soldTokenAmount, sdk.NewDecCoinFromDec(types.TestBasePooledToken3, sdk.ZeroDec()),
+		deadLine, addr, addr)
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+}
+
+func requireSwapRoute(t *testing.T, route []string, result *sdk.Result) {
+	found := false
+	for _, event := range result.Events {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "route" {
+				require.Equal(t, strings.Join(route, ","), string(attr.Value))
+				found = true
+			}
+		}
+	}
+	require.True(t, found)
+}
+
+func TestHandleMsgLimitOrder(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	swapKeeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	now := time.Now()
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(now)
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(swapKeeper)
+	addr := addrKeysSlice[0].Address
+
+	testToken := token.InitTestToken(types.TestBasePooledToken)
+	testQuoteToken := token.InitTestToken(types.TestQuotePooledToken)
+	mapp.tokenKeeper.NewToken(ctx, testToken)
+	mapp.tokenKeeper.NewToken(ctx, testQuoteToken)
+	_, err := handler(ctx, types.NewMsgCreateExchange(testToken.Symbol, types.TestQuotePooledToken, addr))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), sdk.NewDecCoinFromDec(testToken.Symbol, sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000)), now.Unix(), addr))
+	require.Nil(t, err)
+
+	// sell aab when its price reaches 1.5 okt
+	soldTokenAmount := sdk.NewDecCoinFromDec(testToken.Symbol, sdk.NewDec(100))
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(140))
+	msg := types.NewMsgCreateLimitOrder(soldTokenAmount, minBoughtTokenAmount, sdk.NewDecWithPrec(15, 1),
+		now.Add(-time.Second).Unix(), addr, addr)
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	msg.Deadline = now.Add(types.MaxLimitOrderLifetime + time.Second).Unix()
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	balanceBefore := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	msg.Deadline = now.Add(time.Hour).Unix()
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	order, found := swapKeeper.GetLimitOrder(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, soldTokenAmount, order.SoldTokenAmount)
+	balanceAfter := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balanceBefore.AmountOf(testToken.Symbol).Sub(soldTokenAmount.Amount), balanceAfter.AmountOf(testToken.Symbol))
+	// the limit order fee is charged on placement
+	limitOrderFee := swapKeeper.GetParams(ctx).LimitOrderFee
+	require.Equal(t, balanceBefore.AmountOf(limitOrderFee.Denom).Sub(limitOrderFee.Amount), balanceAfter.AmountOf(limitOrderFee.Denom))
+
+	// not triggered yet
+	EndBlocker(ctx, swapKeeper)
+	_, found = swapKeeper.GetLimitOrder(ctx, 1)
+	require.True(t, found)
+
+	// the price of aab doubles
+	swapTokenPair, err := swapKeeper.GetSwapTokenPair(ctx, types.TestSwapTokenPairName)
+	require.Nil(t, err)
+	swapTokenPair.BasePooledCoin.Amount = sdk.NewDec(5000)
+	swapKeeper.SetSwapTokenPair(ctx, types.TestSwapTokenPairName, swapTokenPair)
+	tokenBuy := keeper.CalculateTokenToBuy(swapTokenPair, soldTokenAmount, types.TestQuotePooledToken, swapKeeper.GetParams(ctx))
+	EndBlocker(ctx, swapKeeper)
+	_, found = swapKeeper.GetLimitOrder(ctx, 1)
+	require.False(t, found)
+	balanceAfter = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balanceBefore.AmountOf(types.TestQuotePooledToken).Add(tokenBuy.Amount).Sub(limitOrderFee.Amount), balanceAfter.AmountOf(types.TestQuotePooledToken))
+	swapTokenPair, err = swapKeeper.GetSwapTokenPair(ctx, types.TestSwapTokenPairName)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(5100), swapTokenPair.BasePooledCoin.Amount)
+
+	// cancel
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgCancelLimitOrder(3, addr))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgCancelLimitOrder(2, addr))
+	require.Nil(t, err)
+	_, found = swapKeeper.GetLimitOrder(ctx, 2)
+	require.False(t, found)
+
+	// the expired order is refunded
+	msg.TriggerPrice = sdk.NewDec(100)
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	balanceBefore = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	EndBlocker(ctx.WithBlockTime(now.Add(2*time.Hour)), swapKeeper)
+	_, found = swapKeeper.GetLimitOrder(ctx, 3)
+	require.False(t, found)
+	balanceAfter = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balanceBefore.AmountOf(testToken.Symbol).Add(soldTokenAmount.Amount), balanceAfter.AmountOf(testToken.Symbol))
+}
+
+func TestHandleMsgWithFrozenAccount(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(types.TestBasePooledToken))
+	mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(types.TestQuotePooledToken))
+	handler := NewHandler(mapp.swapKeeper)
+	addr := addrKeysSlice[0].Address
+	deadLine := time.Now().Unix()
+
+	_, err := handler(ctx, types.NewMsgCreateExchange(types.TestBasePooledToken, types.TestQuotePooledToken, addr))
+	require.Nil(t, err)
+	addLiquidityMsg := types.NewMsgAddLiquidity(sdk.NewDec(1),
+		sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000)), deadLine, addr)
+	_, err = handler(ctx, addLiquidityMsg)
+	require.Nil(t, err)
+
+	// the frozen token can be neither added to the pool nor sold
+	mapp.tokenKeeper.FreezeAccount(ctx, addr, types.TestQuotePooledToken)
+	_, err = handler(ctx, addLiquidityMsg)
+	require.NotNil(t, err)
+	swapMsg := types.NewMsgTokenToToken(sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(2)),
+		sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(1)), deadLine, addr, addr)
+	_, err = handler(ctx, swapMsg)
+	require.NotNil(t, err)
+
+	mapp.tokenKeeper.UnfreezeAccount(ctx, addr, types.TestQuotePooledToken)
+	_, err = handler(ctx, swapMsg)
+	require.Nil(t, err)
+}
+
+func TestLimitOrderKilledAtTrigger(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	swapKeeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	now := time.Now()
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(now)
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(swapKeeper)
+	addr := addrKeysSlice[0].Address
+
+	testToken := token.InitTestToken(types.TestBasePooledToken)
+	testQuoteToken := token.InitTestToken(types.TestQuotePooledToken)
+	mapp.tokenKeeper.NewToken(ctx, testToken)
+	mapp.tokenKeeper.NewToken(ctx, testQuoteToken)
+	_, err := handler(ctx, types.NewMsgCreateExchange(testToken.Symbol, types.TestQuotePooledToken, addr))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), sdk.NewDecCoinFromDec(testToken.Symbol, sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000)), now.Unix(), addr))
+	require.Nil(t, err)
+
+	// the head orders of the index are triggered at once but never buy the minimum amount
+	deadline := now.Add(time.Hour).Unix()
+	for i := 0; i < 2; i++ {
+		_, err = handler(ctx, types.NewMsgCreateLimitOrder(sdk.NewDecCoinFromDec(testToken.Symbol, sdk.OneDec()),
+			sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(1000)), sdk.NewDecWithPrec(1, 6),
+			deadline, addr, addr))
+		require.Nil(t, err)
+	}
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(sdk.NewDecCoinFromDec(testToken.Symbol, sdk.NewDec(10)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(9)), sdk.NewDecWithPrec(5, 1),
+		deadline, addr, addr))
+	require.Nil(t, err)
+
+	// the head orders are refunded and killed, and the order behind them is executed
+	balanceBefore := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	EndBlocker(ctx, swapKeeper)
+	for orderID := uint64(1); orderID <= 3; orderID++ {
+		_, found := swapKeeper.GetLimitOrder(ctx, orderID)
+		require.False(t, found)
+	}
+	balanceAfter := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balanceBefore.AmountOf(testToken.Symbol).Add(sdk.NewDec(2)), balanceAfter.AmountOf(testToken.Symbol))
+	require.True(t, balanceAfter.AmountOf(types.TestQuotePooledToken).
+		GTE(balanceBefore.AmountOf(types.TestQuotePooledToken).Add(sdk.NewDec(9))))
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+// max iterations of the newton's method on the stable swap invariant
+const stableSwapMaxIterations = 255
+
+var stableSwapPrecision = sdk.NewDecWithPrec(1, sdk.Precision)
+
+// GetStableSwapInvariant calculates D of the stable swap invariant of two tokens:
+// 4A(x+y) + D = 4AD + D^3/(4xy)
+func GetStableSwapInvariant(x, y sdk.Dec, amplification int64) sdk.Dec {
+	sum := x.Add(y)
+	if !x.IsPositive() || !y.IsPositive() {
+		return sum
+	}
+	ann := sdk.NewDec(amplification * 4)
+	d := sum
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		// dP = D^3/(4xy)
+		dP := d.Mul(d).Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2))
+		prev := d
+		// D = (Ann*S + 2*dP)*D / ((Ann-1)*D + 3*dP)
+		d = ann.Mul(sum).Add(dP.MulInt64(2)).Mul(d).Quo(ann.Sub(sdk.OneDec()).Mul(d).Add(dP.MulInt64(3)))
+		if d.Sub(prev).Abs().LTE(stableSwapPrecision) {
+			break
+		}
+	}
+	return d
+}
+
+// getStableSwapY calculates the reserve of the other token which keeps the invariant d with the reserve x
+func getStableSwapY(x, d sdk.Dec, amplification int64) sdk.Dec {
+	ann := sdk.NewDec(amplification * 4)
+	// c = D^3/(4*x*Ann), b = x + D/Ann
+	c := d.Mul(d).Quo(x.MulInt64(2)).Mul(d).Quo(ann.MulInt64(2))
+	b := x.Add(d.Quo(ann))
+	y := d
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		prev := y
+		// y = (y^2 + c) / (2y + b - D)
+		y = y.Mul(y).Add(c).Quo(y.MulInt64(2).Add(b).Sub(d))
+		if y.Sub(prev).Abs().LTE(stableSwapPrecision) {
+			break
+		}
+	}
+	return y
+}
+
+// GetStableSwapInputPrice calculates the output amount of the stable swap pool
+func GetStableSwapInputPrice(inputAmount, inputReserve, outputReserve sdk.Dec, amplification int64, feeRate sdk.Dec) sdk.Dec {
+	if !inputReserve.IsPositive() || !outputReserve.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	inputAmountWithFee := inputAmount.MulTruncate(sdk.OneDec().Sub(feeRate))
+	d := GetStableSwapInvariant(inputReserve, outputReserve, amplification)
+	y := getStableSwapY(inputReserve.Add(inputAmountWithFee), d, amplification)
+	// round down in favor of the pool
+	outputAmount := outputReserve.Sub(y).Sub(stableSwapPrecision)
+	if !outputAmount.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return outputAmount
+}
+
+// GetSpotPrice returns the marginal price of the sell token in the other token of the swap token pair
+func GetSpotPrice(swapTokenPair types.SwapTokenPair, sellTokenDenom string) sdk.Dec {
+	inputReserve, outputReserve := swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount
+	if sellTokenDenom == swapTokenPair.QuotePooledCoin.Denom {
+		inputReserve, outputReserve = outputReserve, inputReserve
+	}
+	if !inputReserve.IsPositive() || !outputReserve.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	if !swapTokenPair.IsStableSwap() {
+		return outputReserve.Quo(inputReserve)
+	}
+
+	// price = -dy/dx = (Ann + dP/x) / (Ann + dP/y), dP = D^3/(4xy)
+	ann := sdk.NewDec(swapTokenPair.Amplification * 4)
+	d := GetStableSwapInvariant(inputReserve, outputReserve, swapTokenPair.Amplification)
+	dP := d.Mul(d).Quo(inputReserve.MulInt64(2)).Mul(d).Quo(outputReserve.MulInt64(2))
+	return ann.Add(dP.Quo(inputReserve)).Quo(ann.Add(dP.Quo(outputReserve)))
+}
+
+// CalculateStableSwapLiquidity calculates the pool token to mint by depositing the tokens into the stable swap pool.
+// the deposit can be imbalanced, and the imbalanced part is charged half of the pool fee as if it was swapped
+func CalculateStableSwapLiquidity(swapTokenPair types.SwapTokenPair, baseAmount, quoteAmount, totalSupply sdk.Dec,
+	params types.Params) sdk.Dec {
+	amplification := swapTokenPair.Amplification
+	oldBase, oldQuote := swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount
+	d0 := GetStableSwapInvariant(oldBase, oldQuote, amplification)
+	newBase, newQuote := oldBase.Add(baseAmount), oldQuote.Add(quoteAmount)
+	d1 := GetStableSwapInvariant(newBase, newQuote, amplification)
+	if !d0.IsPositive() || d1.LTE(d0) {
+		return sdk.ZeroDec()
+	}
+
+	feeRate := swapTokenPair.GetFeeRate(params).QuoInt64(2)
+	idealBase := common.MulAndQuo(d1, oldBase, d0)
+	idealQuote := common.MulAndQuo(d1, oldQuote, d0)
+	newBase = newBase.Sub(feeRate.Mul(idealBase.Sub(newBase).Abs()))
+	newQuote = newQuote.Sub(feeRate.Mul(idealQuote.Sub(newQuote).Abs()))
+	d2 := GetStableSwapInvariant(newBase, newQuote, amplification)
+	if d2.LTE(d0) {
+		return sdk.ZeroDec()
+	}
+	return common.MulAndQuo(totalSupply, d2.Sub(d0), d0)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestStableSwapPair(baseAmount, quoteAmount int64) types.SwapTokenPair {
+	swapTokenPair := types.NewSwapPair(types.TestBasePooledToken, types.TestBasePooledToken2)
+	swapTokenPair.PoolType = types.PoolTypeStableSwap
+	swapTokenPair.Amplification = types.DefaultAmplification
+	swapTokenPair.BasePooledCoin.Amount = sdk.NewDec(baseAmount)
+	swapTokenPair.QuotePooledCoin.Amount = sdk.NewDec(quoteAmount)
+	return swapTokenPair
+}
+
+func TestStableSwapCurve(t *testing.T) {
+	params := types.DefaultParams()
+	stablePair := getTestStableSwapPair(1000000, 1000000)
+	constantProductPair := stablePair
+	constantProductPair.PoolType = types.PoolTypeConstantProduct
+	constantProductPair.Amplification = 0
+
+	// the invariant of the balanced pool is the sum of the reserves
+	d := GetStableSwapInvariant(stablePair.BasePooledCoin.Amount, stablePair.QuotePooledCoin.Amount, stablePair.Amplification)
+	require.True(t, d.Sub(sdk.NewDec(2000000)).Abs().LTE(sdk.NewDecWithPrec(1, 6)))
+	require.True(t, GetSpotPrice(stablePair, types.TestBasePooledToken).Sub(sdk.OneDec()).Abs().LTE(sdk.NewDecWithPrec(1, 6)))
+
+	// the stable swap pool gives much less slippage than the constant product pool
+	sellToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100000))
+	stableBuy := CalculateTokenToBuy(stablePair, sellToken, types.TestBasePooledToken2, params)
+	constantProductBuy := CalculateTokenToBuy(constantProductPair, sellToken, types.TestBasePooledToken2, params)
+	require.True(t, stableBuy.Amount.GT(constantProductBuy.Amount))
+	require.True(t, stableBuy.Amount.GT(sdk.NewDec(99500)))
+	require.True(t, stableBuy.Amount.LT(sdk.NewDec(99700)))
+
+	// the invariant does not decrease after the swap
+	newD := GetStableSwapInvariant(stablePair.BasePooledCoin.Amount.Add(sellToken.Amount),
+		stablePair.QuotePooledCoin.Amount.Sub(stableBuy.Amount), stablePair.Amplification)
+	require.True(t, newD.GTE(d))
+
+	// the fee tier of the pool overrides the module fee rate
+	stablePair.FeeRate = types.PoolFeeTiers[0]
+	lowFeeBuy := CalculateTokenToBuy(stablePair, sellToken, types.TestBasePooledToken2, params)
+	require.True(t, lowFeeBuy.Amount.GT(stableBuy.Amount))
+}
+
+func TestCalculateStableSwapLiquidity(t *testing.T) {
+	params := types.DefaultParams()
+	stablePair := getTestStableSwapPair(1000000, 1000000)
+	totalSupply := sdk.NewDec(1000)
+
+	// the balanced deposit mints in proportion
+	liquidity := CalculateStableSwapLiquidity(stablePair, sdk.NewDec(10000), sdk.NewDec(10000), totalSupply, params)
+	require.True(t, liquidity.Sub(sdk.NewDec(10)).Abs().LTE(sdk.NewDecWithPrec(1, 9)))
+
+	// the imbalanced deposit is charged for the imbalanced part
+	liquidity = CalculateStableSwapLiquidity(stablePair, sdk.NewDec(20000), sdk.ZeroDec(), totalSupply, params)
+	require.True(t, liquidity.IsPositive())
+	require.True(t, liquidity.LT(sdk.NewDec(10)))
+
+	// nothing to deposit
+	liquidity = CalculateStableSwapLiquidity(stablePair, sdk.ZeroDec(), sdk.ZeroDec(), totalSupply, params)
+	require.True(t, liquidity.IsZero())
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/params"
 	tokentypes "github.com/okex/okexchain/x/token/types"
 )
 
@@ -17,6 +18,8 @@ import (
 type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 	tokenKeeper  types.TokenKeeper
+	distrKeeper  types.DistrKeeper
+	govKeeper    types.GovKeeper
 
 	storeKey       sdk.StoreKey
 	cdc            *codec.Codec
@@ -41,6 +44,16 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetDistrKeeper sets keeper of distribution
+func (k *Keeper) SetDistrKeeper(dk types.DistrKeeper) {
+	k.distrKeeper = dk
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
 // GetSwapTokenPair gets SwapTokenPair with quote token name
 func (k Keeper) GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (types.SwapTokenPair, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -141,10 +154,12 @@ func (k Keeper) GetTokenKeeper() types.TokenKeeper {
 	return k.tokenKeeper
 }
 
-// GetParams gets inflation params from the global param store
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return params
+// GetParams gets inflation params from the global param store, the params unset before the upgrade get the default
+// values
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	swapParams := types.DefaultParams()
+	params.GetParamSetIfExists(ctx, k.paramSpace, &swapParams)
+	return swapParams
 }
 
 // SetParams sets inflation params from the global param store
@@ -184,7 +199,13 @@ func CalculateTokenToBuy(swapTokenPair types.SwapTokenPair, sellToken sdk.SysCoi
 		inputReserve = swapTokenPair.BasePooledCoin.Amount
 		outputReserve = swapTokenPair.QuotePooledCoin.Amount
 	}
-	tokenBuyAmt := GetInputPrice(sellToken.Amount, inputReserve, outputReserve, params.FeeRate)
+	var tokenBuyAmt sdk.Dec
+	feeRate := swapTokenPair.GetFeeRate(params)
+	if swapTokenPair.IsStableSwap() {
+		tokenBuyAmt = GetStableSwapInputPrice(sellToken.Amount, inputReserve, outputReserve, swapTokenPair.Amplification, feeRate)
+	} else {
+		tokenBuyAmt = GetInputPrice(sellToken.Amount, inputReserve, outputReserve, feeRate)
+	}
 	tokenBuy := sdk.NewDecCoinFromDec(buyTokenDenom, tokenBuyAmt)
 
 	return tokenBuy
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/okex/okexchain/x/ammswap/types"
+)
+
+// GetLimitOrder gets the limit order from KVStore
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderID uint64) (types.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLimitOrderKey(orderID))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+	var order types.LimitOrder
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &order)
+	return order, true
+}
+
+// SetLimitOrder sets the limit order and its trigger price and deadline indexes to KVStore
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLimitOrderKey(order.OrderID), k.cdc.MustMarshalBinaryLengthPrefixed(order))
+	store.Set(types.GetLimitOrderTriggerKey(order), sdk.Uint64ToBigEndian(order.OrderID))
+	store.Set(types.GetLimitOrderDeadlineKey(order), sdk.Uint64ToBigEndian(order.OrderID))
+}
+
+// DeleteLimitOrder deletes the limit order and its indexes from KVStore
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(order.OrderID))
+	store.Delete(types.GetLimitOrderTriggerKey(order))
+	store.Delete(types.GetLimitOrderDeadlineKey(order))
+}
+
+// getLimitOrdersByIndex returns the limit orders whose ids are the values of the index keys in the range, at most
+// limit orders are returned if the limit is positive
+func (k Keeper) getLimitOrdersByIndex(ctx sdk.Context, start, end []byte, limit int) []types.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	var orders []types.LimitOrder
+	for ; iterator.Valid() && (limit <= 0 || len(orders) < limit); iterator.Next() {
+		if order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value())); found {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+// GetLimitOrders returns all the limit orders in ascending order of id
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []types.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrderPrefixKey)
+	defer iterator.Close()
+
+	var orders []types.LimitOrder
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetLastLimitOrderID returns the id of the last limit order created
+func (k Keeper) GetLastLimitOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastLimitOrderIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastLimitOrderID sets the id of the last limit order created
+func (k Keeper) SetLastLimitOrderID(ctx sdk.Context, orderID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastLimitOrderIDKey, sdk.Uint64ToBigEndian(orderID))
+}
+
+// PlaceLimitOrder charges the limit order fee, escrows the sold token of the limit order in the module account
+// and saves the order
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, msg types.MsgCreateLimitOrder) (types.LimitOrder, error) {
+	if fee := k.GetParams(ctx).LimitOrderFee; fee.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Sender, auth.FeeCollectorName, sdk.SysCoins{fee})
+		if err != nil {
+			return types.LimitOrder{}, types.ErrSendCoinsToPoolFailed(err.Error())
+		}
+	}
+	if err := k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender); err != nil {
+		return types.LimitOrder{}, types.ErrSendCoinsToPoolFailed(err.Error())
+	}
+	orderID := k.GetLastLimitOrderID(ctx) + 1
+	k.SetLastLimitOrderID(ctx, orderID)
+	order := types.NewLimitOrder(orderID, msg, ctx.BlockHeight())
+	k.SetLimitOrder(ctx, order)
+	return order, nil
+}
+
+// QuitLimitOrder refunds the sold token of the limit order to the sender and deletes the order
+func (k Keeper) QuitLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	if err := k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{order.SoldTokenAmount}, order.Sender); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error())
+	}
+	k.DeleteLimitOrder(ctx, order)
+	return nil
+}
+
+// swapLimitOrder swaps the escrowed token of the limit order through its swap token pair, and deletes the order
+func (k Keeper) swapLimitOrder(ctx sdk.Context, order types.LimitOrder, tokenBuy sdk.SysCoin) error {
+	cacheCtx, write := ctx.CacheContext()
+	err := k.SendCoinsFromPoolToAccount(cacheCtx, sdk.SysCoins{tokenBuy}, order.Recipient)
+	if err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error())
+	}
+	route := []string{order.SoldTokenAmount.Denom, tokenBuy.Denom}
+	amounts := []sdk.SysCoin{order.SoldTokenAmount, tokenBuy}
+	if err := k.UpdateSwapTokenPairsByRoute(cacheCtx, route, amounts, order.Recipient); err != nil {
+		return err
+	}
+	k.DeleteLimitOrder(cacheCtx, order)
+	write()
+	return nil
+}
+
+// ExecuteLimitOrders swaps the limit orders whose trigger price is reached by the spot price of the pool,
+// and refunds the expired ones. only the expired orders and the orders whose trigger price is reached are read
+// through the indexes, and the orders of a token pair are executed in ascending order of the trigger price
+func (k Keeper) ExecuteLimitOrders(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	now := ctx.BlockTime().Unix()
+	expiredOrders := k.getLimitOrdersByIndex(ctx, types.LimitOrderDeadlinePrefixKey,
+		types.GetLimitOrderDeadlinePrefix(now), 0)
+	for _, order := range expiredOrders {
+		if err := k.QuitLimitOrder(ctx, order); err != nil {
+			logger.Error(fmt.Sprintf("refund limit order(%d) failed: %v", order.OrderID, err))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExpireLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Sender.String()),
+		))
+	}
+
+	params := k.GetParams(ctx)
+	for _, swapTokenPair := range k.GetSwapTokenPairs(ctx) {
+		tokenPairName := swapTokenPair.TokenPairName()
+		for _, soldTokenDenom := range []string{swapTokenPair.BasePooledCoin.Denom, swapTokenPair.QuotePooledCoin.Denom} {
+			spotPrice := GetSpotPrice(swapTokenPair, soldTokenDenom)
+			if !spotPrice.IsPositive() {
+				continue
+			}
+			// the orders whose trigger price is no greater than the spot price are triggered, and the number of them
+			// tried in a block is bounded
+			triggeredOrders := k.getLimitOrdersByIndex(ctx, types.GetLimitOrderTriggerPrefix(tokenPairName, soldTokenDenom),
+				sdk.PrefixEndBytes(types.GetLimitOrderTriggerPriceKey(tokenPairName, soldTokenDenom, spotPrice)),
+				types.MaxTriggeredLimitOrdersPerBlock)
+			for _, order := range triggeredOrders {
+				k.executeTriggeredLimitOrder(ctx, order, params)
+			}
+		}
+	}
+}
+
+// executeTriggeredLimitOrder executes the limit order if its trigger price is still reached by the spot price, which
+// moves as the orders before are executed. The triggered order which can't buy the minimum amount is refunded and
+// killed at once, otherwise it would be retried in every block and keep the orders behind it from being tried
+func (k Keeper) executeTriggeredLimitOrder(ctx sdk.Context, order types.LimitOrder, params types.Params) {
+	logger := k.Logger(ctx)
+	swapTokenPair, err := k.GetSwapTokenPair(ctx, order.GetSwapTokenPairName())
+	if err != nil {
+		logger.Info(fmt.Sprintf("execute limit order(%d) failed: %v", order.OrderID, err))
+		return
+	}
+	if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() ||
+		!order.IsTriggered(GetSpotPrice(swapTokenPair, order.SoldTokenAmount.Denom)) {
+		return
+	}
+
+	tokenBuy := CalculateTokenToBuy(swapTokenPair, order.SoldTokenAmount, order.MinBoughtTokenAmount.Denom, params)
+	if tokenBuy.IsZero() || tokenBuy.Amount.LT(order.MinBoughtTokenAmount.Amount) {
+		if err := k.QuitLimitOrder(ctx, order); err != nil {
+			logger.Error(fmt.Sprintf("refund limit order(%d) failed: %v", order.OrderID, err))
+			return
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeKillLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenPair, order.GetSwapTokenPairName()),
+			sdk.NewAttribute(types.AttributeKeyBoughtToken, tokenBuy.String()),
+		))
+		return
+	}
+
+	if err := k.swapLimitOrder(ctx, order, tokenBuy); err != nil {
+		logger.Info(fmt.Sprintf("execute limit order(%d) failed: %v", order.OrderID, err))
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenPair, order.GetSwapTokenPairName()),
+		sdk.NewAttribute(types.AttributeKeyBoughtToken, tokenBuy.String()),
+	))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLimitOrdersByIndex(t *testing.T) {
+	_, addrList, ctx, keeper, _ := initQurierTest(t)
+	addr := addrList[0].Address
+	tokenPairName := types.GetSwapTokenPairName(types.TestBasePooledToken, types.TestQuotePooledToken)
+
+	newOrder := func(orderID uint64, soldDenom, boughtDenom string, triggerPrice sdk.Dec, deadline int64) types.LimitOrder {
+		msg := types.NewMsgCreateLimitOrder(sdk.NewDecCoinFromDec(soldDenom, sdk.NewDec(1)),
+			sdk.NewDecCoinFromDec(boughtDenom, sdk.NewDec(1)), triggerPrice, deadline, addr, addr)
+		return types.NewLimitOrder(orderID, msg, ctx.BlockHeight())
+	}
+	orders := []types.LimitOrder{
+		newOrder(1, types.TestBasePooledToken, types.TestQuotePooledToken, sdk.NewDec(3), 300),
+		newOrder(2, types.TestBasePooledToken, types.TestQuotePooledToken, sdk.NewDec(1), 200),
+		newOrder(3, types.TestBasePooledToken, types.TestQuotePooledToken, sdk.NewDec(2), 100),
+		newOrder(4, types.TestQuotePooledToken, types.TestBasePooledToken, sdk.NewDecWithPrec(1, 1), 100),
+	}
+	for _, order := range orders {
+		keeper.SetLimitOrder(ctx, order)
+	}
+
+	// the orders selling the base token are sorted by the trigger price, and the ones triggered at the spot price
+	// are returned
+	triggered := keeper.getLimitOrdersByIndex(ctx, types.GetLimitOrderTriggerPrefix(tokenPairName, types.TestBasePooledToken),
+		sdk.PrefixEndBytes(types.GetLimitOrderTriggerPriceKey(tokenPairName, types.TestBasePooledToken, sdk.NewDec(2))), 0)
+	require.Equal(t, []types.LimitOrder{orders[1], orders[2]}, triggered)
+
+	// the orders expired before the time are returned in ascending order of the deadline
+	expired := keeper.getLimitOrdersByIndex(ctx, types.LimitOrderDeadlinePrefixKey, types.GetLimitOrderDeadlinePrefix(201), 0)
+	require.Equal(t, []types.LimitOrder{orders[2], orders[3], orders[1]}, expired)
+
+	// at most limit orders are returned
+	expired = keeper.getLimitOrdersByIndex(ctx, types.LimitOrderDeadlinePrefixKey, types.GetLimitOrderDeadlinePrefix(201), 2)
+	require.Equal(t, []types.LimitOrder{orders[2], orders[3]}, expired)
+
+	// the indexes are deleted along with the order
+	keeper.DeleteLimitOrder(ctx, orders[2])
+	expired = keeper.getLimitOrdersByIndex(ctx, types.LimitOrderDeadlinePrefixKey, types.GetLimitOrderDeadlinePrefix(201), 0)
+	require.Equal(t, []types.LimitOrder{orders[3], orders[1]}, expired)
+	triggered = keeper.getLimitOrdersByIndex(ctx, types.GetLimitOrderTriggerPrefix(tokenPairName, types.TestBasePooledToken),
+		sdk.PrefixEndBytes(types.GetLimitOrderTriggerPriceKey(tokenPairName, types.TestBasePooledToken, sdk.NewDec(2))), 0)
+	require.Equal(t, []types.LimitOrder{orders[1]}, triggered)
+}
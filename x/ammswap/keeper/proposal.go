@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	sdkGov "github.com/okex/okexchain/x/gov"
+	govKeeper "github.com/okex/okexchain/x/gov/keeper"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	if _, ok := content.(types.WithdrawProtocolFeeProposal); ok {
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	if _, ok := content.(types.WithdrawProtocolFeeProposal); ok {
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	if _, ok := content.(types.WithdrawProtocolFeeProposal); ok {
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.WithdrawProtocolFeeProposal:
+		return k.CheckWithdrawProtocolFeeProposal(ctx, content)
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized ammswap proposal content type: %T", content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// CheckWithdrawProtocolFeeProposal checks the token pair of the withdraw protocol fee proposal exists
+func (k Keeper) CheckWithdrawProtocolFeeProposal(ctx sdk.Context, proposal types.WithdrawProtocolFeeProposal) sdk.Error {
+	if _, err := k.GetSwapTokenPair(ctx, proposal.TokenPairName); err != nil {
+		return types.ErrNonExistSwapTokenPair(proposal.TokenPairName)
+	}
+	return nil
+}
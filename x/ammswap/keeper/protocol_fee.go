@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+)
+
+// GetProtocolFees returns the protocol fees accrued by the token pair
+func (k Keeper) GetProtocolFees(ctx sdk.Context, tokenPairName string) sdk.SysCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProtocolFeeKey(tokenPairName))
+	if bz == nil {
+		return sdk.SysCoins{}
+	}
+	var fees sdk.SysCoins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &fees)
+	return fees
+}
+
+// SetProtocolFees sets the protocol fees accrued by the token pair
+func (k Keeper) SetProtocolFees(ctx sdk.Context, tokenPairName string, fees sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if fees.IsZero() {
+		store.Delete(types.GetProtocolFeeKey(tokenPairName))
+		return
+	}
+	store.Set(types.GetProtocolFeeKey(tokenPairName), k.cdc.MustMarshalBinaryLengthPrefixed(fees))
+}
+
+// GetAllProtocolFees returns the protocol fees accrued by all the token pairs
+func (k Keeper) GetAllProtocolFees(ctx sdk.Context) []types.ProtocolFee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProtocolFeePrefixKey)
+	defer iterator.Close()
+
+	var protocolFees []types.ProtocolFee
+	for ; iterator.Valid(); iterator.Next() {
+		var fees sdk.SysCoins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fees)
+		tokenPairName := string(iterator.Key()[len(types.ProtocolFeePrefixKey):])
+		protocolFees = append(protocolFees, types.NewProtocolFee(tokenPairName, fees))
+	}
+	return protocolFees
+}
+
+// CollectProtocolFee takes the protocol share of the swap fee paid by the sold token, and accrues it to the token pair.
+// the protocol fee stays in the module account but is no longer a part of the pool reserves
+func (k Keeper) CollectProtocolFee(ctx sdk.Context, swapTokenPair types.SwapTokenPair, soldToken sdk.SysCoin,
+	params types.Params) sdk.SysCoin {
+	protocolFee := sdk.NewDecCoinFromDec(soldToken.Denom, sdk.ZeroDec())
+	if params.ProtocolFeeRate.IsNil() || !params.ProtocolFeeRate.IsPositive() {
+		return protocolFee
+	}
+	protocolFee.Amount = soldToken.Amount.MulTruncate(swapTokenPair.GetFeeRate(params)).MulTruncate(params.ProtocolFeeRate)
+	if protocolFee.IsZero() {
+		return protocolFee
+	}
+
+	tokenPairName := swapTokenPair.TokenPairName()
+	fees := k.GetProtocolFees(ctx, tokenPairName).Add(protocolFee)
+	k.SetProtocolFees(ctx, tokenPairName, fees)
+	return protocolFee
+}
+
+// WithdrawProtocolFees withdraws all the protocol fees accrued by the token pair,
+// to the community pool or burns them
+func (k Keeper) WithdrawProtocolFees(ctx sdk.Context, tokenPairName, destination string) (sdk.SysCoins, error) {
+	fees := k.GetProtocolFees(ctx, tokenPairName)
+	if fees.IsZero() {
+		return nil, types.ErrNoProtocolFee(tokenPairName)
+	}
+
+	switch destination {
+	case types.ProtocolFeeToCommunityPool:
+		if err := k.distrKeeper.FundCommunityPoolFromModule(ctx, fees, types.ModuleName); err != nil {
+			return nil, err
+		}
+	case types.ProtocolFeeBurn:
+		if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, types.ErrInvalidProtocolFeeDestination(destination)
+	}
+	k.SetProtocolFees(ctx, tokenPairName, sdk.SysCoins{})
+
+	k.Logger(ctx).Info(fmt.Sprintf("withdrew protocol fees %s of %s to %s", fees, tokenPairName, destination))
+	return fees, nil
+}
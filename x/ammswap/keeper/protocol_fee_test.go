@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestProtocolFee(t *testing.T) {
+	mapp, addrList, ctx, keeper, querier := initQurierTest(t)
+	swapTokenPair := initTestPool(t, addrList, mapp, ctx, keeper, sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(100)), sdk.NewDec(1))
+	tokenPairName := swapTokenPair.TokenPairName()
+	soldToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100))
+
+	// the protocol fee is switched off by default
+	params := keeper.GetParams(ctx)
+	protocolFee := keeper.CollectProtocolFee(ctx, swapTokenPair, soldToken, params)
+	require.True(t, protocolFee.IsZero())
+	require.True(t, keeper.GetProtocolFees(ctx, tokenPairName).IsZero())
+
+	// half of the swap fee goes to the protocol
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+	protocolFee = keeper.CollectProtocolFee(ctx, swapTokenPair, soldToken, params)
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), protocolFee.Amount)
+	keeper.CollectProtocolFee(ctx, swapTokenPair, soldToken, params)
+	require.Equal(t, sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDecWithPrec(3, 1))},
+		keeper.GetProtocolFees(ctx, tokenPairName))
+
+	// query
+	resultBytes, err := querier(ctx, []string{types.QueryProtocolFees, tokenPairName}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var result []types.ProtocolFee
+	keeper.cdc.MustUnmarshalJSON(resultBytes, &result)
+	require.Equal(t, 1, len(result))
+	require.Equal(t, keeper.GetProtocolFees(ctx, tokenPairName), result[0].Fees)
+	require.Equal(t, result, keeper.GetAllProtocolFees(ctx))
+
+	// withdraw by burning
+	err = keeper.SendCoinsToPool(ctx, sdk.SysCoins{soldToken}, addrList[0].Address)
+	require.Nil(t, err)
+	_, err = keeper.WithdrawProtocolFees(ctx, tokenPairName, "treasury")
+	require.NotNil(t, err)
+	fees, err := keeper.WithdrawProtocolFees(ctx, tokenPairName, types.ProtocolFeeBurn)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), fees.AmountOf(types.TestBasePooledToken))
+	require.True(t, keeper.GetProtocolFees(ctx, tokenPairName).IsZero())
+	require.Equal(t, 0, len(keeper.GetAllProtocolFees(ctx)))
+	_, err = keeper.WithdrawProtocolFees(ctx, tokenPairName, types.ProtocolFeeBurn)
+	require.NotNil(t, err)
+}
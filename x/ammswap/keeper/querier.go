@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/okex/okexchain/x/common"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -28,6 +29,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			res, err = querySwapQuoteInfo(ctx, req, k)
 		case types.QuerySwapAddLiquidityQuote:
 			res, err = querySwapAddLiquidityQuote(ctx, req, k)
+		case types.QuerySwapRoute:
+			res, err = querySwapRoute(ctx, req, k)
+		case types.QueryTWAP:
+			res, err = queryTWAP(ctx, req, k)
+		case types.QueryProtocolFees:
+			res, err = queryProtocolFees(ctx, path[1:], k)
+		case types.QueryLimitOrder:
+			res, err = queryLimitOrder(ctx, path[1:], k)
+		case types.QueryLimitOrders:
+			res, err = queryLimitOrders(ctx, req, k)
 
 		default:
 			return nil, types.ErrSwapUnknownQueryType()
@@ -84,35 +95,11 @@ func queryBuyAmount(
 	if errToken != nil {
 		return nil, errToken
 	}
-	params := keeper.GetParams(ctx)
-	var buyAmount sdk.Dec
-	swapTokenPair := types.GetSwapTokenPairName(queryParams.SoldToken.Denom, queryParams.TokenToBuy)
-	tokenPair, errTokenPair := keeper.GetSwapTokenPair(ctx, swapTokenPair)
-	if errTokenPair == nil {
-		if tokenPair.BasePooledCoin.IsZero() || tokenPair.QuotePooledCoin.IsZero() {
-			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
-		}
-		buyAmount = CalculateTokenToBuy(tokenPair, queryParams.SoldToken, queryParams.TokenToBuy, params).Amount
-	} else {
-		tokenPairName1 := types.GetSwapTokenPairName(queryParams.SoldToken.Denom, sdk.DefaultBondDenom)
-		tokenPair1, err := keeper.GetSwapTokenPair(ctx, tokenPairName1)
-		if err != nil {
-			return nil, err
-		}
-		if tokenPair1.BasePooledCoin.IsZero() || tokenPair1.QuotePooledCoin.IsZero() {
-			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
-		}
-		tokenPairName2 := types.GetSwapTokenPairName(queryParams.TokenToBuy, sdk.DefaultBondDenom)
-		tokenPair2, err := keeper.GetSwapTokenPair(ctx, tokenPairName2)
-		if err != nil {
-			return nil, err
-		}
-		if tokenPair2.BasePooledCoin.IsZero() || tokenPair2.QuotePooledCoin.IsZero() {
-			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
-		}
-		nativeToken := CalculateTokenToBuy(tokenPair1, queryParams.SoldToken, sdk.DefaultBondDenom, params)
-		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.TokenToBuy, params).Amount
+	_, amounts, err := keeper.FindBestSwapRoute(ctx, queryParams.SoldToken, queryParams.TokenToBuy, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, err
 	}
+	buyAmount := amounts[len(amounts)-1].Amount
 
 	bz := keeper.cdc.MustMarshalJSON(buyAmount)
 
@@ -186,13 +173,9 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		}
 		buyAmount = CalculateTokenToBuy(tokenPair, sellAmount, queryParams.BuyToken, swapParams).Amount
 		// calculate market price
-		if tokenPair.BasePooledCoin.Denom == sellAmount.Denom {
-			marketPrice = tokenPair.QuotePooledCoin.Amount.Quo(tokenPair.BasePooledCoin.Amount)
-		} else {
-			marketPrice = tokenPair.BasePooledCoin.Amount.Quo(tokenPair.QuotePooledCoin.Amount)
-		}
+		marketPrice = GetSpotPrice(tokenPair, sellAmount.Denom)
 		// calculate fee
-		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
+		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(tokenPair.GetFeeRate(swapParams)))
 	} else {
 		tokenPairName1 := types.GetSwapTokenPairName(sellAmount.Denom, common.NativeToken)
 		tokenPair1, err := keeper.GetSwapTokenPair(ctx, tokenPairName1)
@@ -214,25 +197,15 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		buyAmount = CalculateTokenToBuy(tokenPair2, nativeToken, queryParams.BuyToken, swapParams).Amount
 
 		// calculate market price
-		var sellTokenMarketPrice sdk.Dec
-		var routeTokenMarketPrice sdk.Dec
-		if tokenPair1.BasePooledCoin.Denom == sellAmount.Denom {
-			sellTokenMarketPrice = tokenPair1.QuotePooledCoin.Amount.Quo(tokenPair1.BasePooledCoin.Amount)
-		} else {
-			sellTokenMarketPrice = tokenPair1.BasePooledCoin.Amount.Quo(tokenPair1.QuotePooledCoin.Amount)
-		}
-		if tokenPair2.BasePooledCoin.Denom == common.NativeToken {
-			routeTokenMarketPrice = tokenPair2.QuotePooledCoin.Amount.Quo(tokenPair2.BasePooledCoin.Amount)
-		} else {
-			routeTokenMarketPrice = tokenPair2.BasePooledCoin.Amount.Quo(tokenPair2.QuotePooledCoin.Amount)
-		}
+		sellTokenMarketPrice := GetSpotPrice(tokenPair1, sellAmount.Denom)
+		routeTokenMarketPrice := GetSpotPrice(tokenPair2, common.NativeToken)
 		if routeTokenMarketPrice.IsPositive() && sellTokenMarketPrice.IsPositive() {
 			marketPrice = sellTokenMarketPrice.Mul(routeTokenMarketPrice)
 		}
 
 		// calculate fee
-		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
-		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(swapParams.FeeRate))
+		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(tokenPair1.GetFeeRate(swapParams)))
+		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(tokenPair2.GetFeeRate(swapParams)))
 		fee2 := CalculateTokenToBuy(tokenPair1, routeTokenFee, sellAmount.Denom, swapParams)
 		fee = fee1.Add(fee2)
 
@@ -315,6 +288,14 @@ func querySwapAddLiquidityQuote(ctx sdk.Context, req abci.RequestQuery, keeper K
 		addAmount = common.MulAndQuo(queryTokenAmount.Amount, swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount)
 		liquidity = common.MulAndQuo(queryTokenAmount.Amount, totalSupply, swapTokenPair.QuotePooledCoin.Amount)
 	}
+	// stable swap pool mints by the growth of the invariant
+	if swapTokenPair.IsStableSwap() {
+		baseAmount, quoteAmount := addAmount, queryTokenAmount.Amount
+		if swapTokenPair.BasePooledCoin.Denom == queryTokenAmount.Denom {
+			baseAmount, quoteAmount = queryTokenAmount.Amount, addAmount
+		}
+		liquidity = CalculateStableSwapLiquidity(swapTokenPair, baseAmount, quoteAmount, totalSupply, keeper.GetParams(ctx))
+	}
 	addInfo := types.SwapAddInfo{
 		BaseTokenAmount: addAmount,
 		PoolShare:       liquidity.Quo(totalSupply.Add(liquidity)),
@@ -328,3 +309,106 @@ func querySwapAddLiquidityQuote(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 
 }
+
+// querySwapRoute returns the route of the given path, or the best route if no path is given,
+// and the amount expected to buy through it
+func querySwapRoute(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QuerySwapRouteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if !queryParams.SoldToken.IsPositive() || queryParams.TokenToBuy == "" {
+		return nil, types.ErrSellAmountOrBuyTokenIsEmpty()
+	}
+	if queryParams.SoldToken.Denom == queryParams.TokenToBuy {
+		return nil, types.ErrSellAmountEqualBuyToken()
+	}
+
+	params := keeper.GetParams(ctx)
+	var route []string
+	var amounts []sdk.SysCoin
+	if len(queryParams.Path) > 0 {
+		route = append([]string{queryParams.SoldToken.Denom}, queryParams.Path...)
+		route = append(route, queryParams.TokenToBuy)
+		amounts, err = keeper.CalculateRouteTokensToBuy(ctx, queryParams.SoldToken, route, params)
+	} else {
+		route, amounts, err = keeper.FindBestSwapRoute(ctx, queryParams.SoldToken, queryParams.TokenToBuy, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	routeInfo := types.SwapRouteInfo{
+		Route:     route,
+		BuyAmount: amounts[len(amounts)-1],
+	}
+	return keeper.cdc.MustMarshalJSON(routeInfo), nil
+}
+
+// queryTWAP returns the time-weighted average price of the token pair in the height or time window
+func queryTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QueryTWAPParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	var twap types.TWAP
+	switch {
+	case queryParams.StartHeight > 0:
+		twap, err = keeper.GetTWAPByHeight(ctx, queryParams.TokenPairName, queryParams.StartHeight)
+	case queryParams.TimeWindow > 0:
+		twap, err = keeper.GetTWAPByTime(ctx, queryParams.TokenPairName, queryParams.TimeWindow)
+	default:
+		return nil, types.ErrIsZeroValue("start height and time window")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return keeper.cdc.MustMarshalJSON(twap), nil
+}
+
+// queryProtocolFees returns the protocol fees accrued by the token pair, or by all the token pairs if none is given
+func queryProtocolFees(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) > 0 && path[0] != "" {
+		tokenPairName := path[0]
+		if _, err := keeper.GetSwapTokenPair(ctx, tokenPairName); err != nil {
+			return nil, err
+		}
+		protocolFee := types.NewProtocolFee(tokenPairName, keeper.GetProtocolFees(ctx, tokenPairName))
+		return keeper.cdc.MustMarshalJSON([]types.ProtocolFee{protocolFee}), nil
+	}
+	return keeper.cdc.MustMarshalJSON(keeper.GetAllProtocolFees(ctx)), nil
+}
+
+// queryLimitOrder returns the limit order by its id
+func queryLimitOrder(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	orderID, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, common.ErrStrconvFailed(err.Error())
+	}
+	order, found := keeper.GetLimitOrder(ctx, orderID)
+	if !found {
+		return nil, types.ErrLimitOrderNotExist(orderID)
+	}
+	return keeper.cdc.MustMarshalJSON(order), nil
+}
+
+// queryLimitOrders returns the limit orders of the sender, or all the limit orders if no sender is given
+func queryLimitOrders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QueryLimitOrdersParams
+	if len(req.Data) > 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams); err != nil {
+			return nil, common.ErrUnMarshalJSONFailed(err.Error())
+		}
+	}
+
+	orders := []types.LimitOrder{}
+	for _, order := range keeper.GetLimitOrders(ctx) {
+		if queryParams.Sender.Empty() || order.Sender.Equals(queryParams.Sender) {
+			orders = append(orders, order)
+		}
+	}
+	return keeper.cdc.MustMarshalJSON(orders), nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+)
+
+// CalculateRouteTokensToBuy calculates the amount of every token along the route.
+// the first amount is the sold token and the last one is the token to buy
+func (k Keeper) CalculateRouteTokensToBuy(ctx sdk.Context, soldToken sdk.SysCoin, route []string,
+	params types.Params) ([]sdk.SysCoin, error) {
+	if err := types.ValidateSwapRoute(route); err != nil {
+		return nil, err
+	}
+	if route[0] != soldToken.Denom {
+		return nil, types.ErrInvalidSwapRoute("the route must start with the sold token")
+	}
+
+	amounts := []sdk.SysCoin{soldToken}
+	for i := 1; i < len(route); i++ {
+		swapTokenPair, err := k.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(route[i-1], route[i]))
+		if err != nil {
+			return nil, err
+		}
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
+		}
+		tokenBuy := CalculateTokenToBuy(swapTokenPair, amounts[i-1], route[i], params)
+		if tokenBuy.IsZero() {
+			return nil, types.ErrIsZeroValue("token buy")
+		}
+		amounts = append(amounts, tokenBuy)
+	}
+	return amounts, nil
+}
+
+// FindBestSwapRoute finds the route over all the swap token pairs with at most MaxSwapRouteHops hops,
+// which returns the most token to buy. the shorter route wins if two routes return the same amount.
+// it walks all the swap token pairs without gas metering, so that it's only used by the queries
+func (k Keeper) FindBestSwapRoute(ctx sdk.Context, soldToken sdk.SysCoin, buyTokenDenom string,
+	params types.Params) ([]string, []sdk.SysCoin, error) {
+	// build the graph of tokens with the swap token pairs which have liquidity
+	pairs := make(map[string]types.SwapTokenPair)
+	neighbors := make(map[string][]string)
+	for _, swapTokenPair := range k.GetSwapTokenPairs(ctx) {
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			continue
+		}
+		base, quote := swapTokenPair.BasePooledCoin.Denom, swapTokenPair.QuotePooledCoin.Denom
+		pairs[types.GetSwapTokenPairName(base, quote)] = swapTokenPair
+		neighbors[base] = append(neighbors[base], quote)
+		neighbors[quote] = append(neighbors[quote], base)
+	}
+	for token := range neighbors {
+		sort.Strings(neighbors[token])
+	}
+
+	var bestRoute []string
+	var bestAmounts []sdk.SysCoin
+	route := []string{soldToken.Denom}
+	amounts := []sdk.SysCoin{soldToken}
+	visited := map[string]bool{soldToken.Denom: true}
+
+	var search func()
+	search = func() {
+		current := route[len(route)-1]
+		if current == buyTokenDenom {
+			tokenBuy := amounts[len(amounts)-1]
+			if bestAmounts == nil || tokenBuy.Amount.GT(bestAmounts[len(bestAmounts)-1].Amount) ||
+				(tokenBuy.Amount.Equal(bestAmounts[len(bestAmounts)-1].Amount) && len(route) < len(bestRoute)) {
+				bestRoute = append([]string{}, route...)
+				bestAmounts = append([]sdk.SysCoin{}, amounts...)
+			}
+			return
+		}
+		if len(route)-1 >= types.MaxSwapRouteHops {
+			return
+		}
+		for _, next := range neighbors[current] {
+			if visited[next] {
+				continue
+			}
+			swapTokenPair := pairs[types.GetSwapTokenPairName(current, next)]
+			tokenBuy := CalculateTokenToBuy(swapTokenPair, amounts[len(amounts)-1], next, params)
+			if tokenBuy.IsZero() {
+				continue
+			}
+			visited[next] = true
+			route = append(route, next)
+			amounts = append(amounts, tokenBuy)
+			search()
+			route = route[:len(route)-1]
+			amounts = amounts[:len(amounts)-1]
+			visited[next] = false
+		}
+	}
+	search()
+
+	if bestRoute == nil {
+		return nil, nil, types.ErrSwapRouteNotFound(soldToken.Denom, buyTokenDenom)
+	}
+	return bestRoute, bestAmounts, nil
+}
+
+// UpdateSwapTokenPairsByRoute updates every swap token pair along the route by the amounts swapped.
+// all the pools share the module account, so the intermediate tokens need no transfer
+func (k Keeper) UpdateSwapTokenPairsByRoute(ctx sdk.Context, route []string, amounts []sdk.SysCoin,
+	recipient sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	for i := 1; i < len(route); i++ {
+		tokenPairName := types.GetSwapTokenPairName(route[i-1], route[i])
+		swapTokenPair, err := k.GetSwapTokenPair(ctx, tokenPairName)
+		if err != nil {
+			return err
+		}
+		k.UpdatePriceAccumulator(ctx, swapTokenPair)
+		sold, bought := amounts[i-1], amounts[i]
+		// the protocol share of the swap fee does not go into the pool
+		pooled := sold.Sub(k.CollectProtocolFee(ctx, swapTokenPair, sold, params))
+		if bought.Denom < sold.Denom {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(pooled)
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(bought)
+		} else {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(bought)
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(pooled)
+		}
+		k.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
+		k.OnSwapToken(ctx, recipient, swapTokenPair, sold, bought)
+	}
+	return nil
+}
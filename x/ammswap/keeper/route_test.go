@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestFindBestSwapRoute(t *testing.T) {
+	mapp, addrList, ctx, keeper, querier := initQurierTest(t)
+	params := keeper.GetParams(ctx)
+
+	// aab -> okt -> ccb is deeper than the direct pool aab -> ccb
+	initTestPool(t, addrList, mapp, ctx, keeper, sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(100)), sdk.NewDec(1))
+	initTestPool(t, addrList, mapp, ctx, keeper, sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(100)), sdk.NewDec(1))
+	directPair := initTestPool(t, addrList, mapp, ctx, keeper, sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(1)), sdk.NewDec(1))
+
+	soldToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10))
+	route, amounts, err := keeper.FindBestSwapRoute(ctx, soldToken, types.TestBasePooledToken2, params)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2}, route)
+	expectedAmounts, err := keeper.CalculateRouteTokensToBuy(ctx, soldToken, route, params)
+	require.Nil(t, err)
+	require.Equal(t, expectedAmounts, amounts)
+
+	directAmounts, err := keeper.CalculateRouteTokensToBuy(ctx, soldToken,
+		[]string{types.TestBasePooledToken, types.TestBasePooledToken2}, params)
+	require.Nil(t, err)
+	require.True(t, amounts[2].Amount.GT(directAmounts[1].Amount))
+
+	// query the best route
+	queryParams := types.NewQuerySwapRouteParams(soldToken, types.TestBasePooledToken2, nil)
+	resultBytes, err := querier(ctx, []string{types.QuerySwapRoute}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(queryParams)})
+	require.Nil(t, err)
+	var routeInfo types.SwapRouteInfo
+	keeper.cdc.MustUnmarshalJSON(resultBytes, &routeInfo)
+	require.Equal(t, route, routeInfo.Route)
+	require.Equal(t, amounts[2], routeInfo.BuyAmount)
+
+	// query the route of the given path
+	queryParams = types.NewQuerySwapRouteParams(soldToken, types.TestBasePooledToken2, []string{types.TestQuotePooledToken})
+	resultBytes, err = querier(ctx, []string{types.QuerySwapRoute}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(queryParams)})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(resultBytes, &routeInfo)
+	require.Equal(t, route, routeInfo.Route)
+
+	// the direct pool wins after it gets deeper
+	directPair.BasePooledCoin.Amount = sdk.NewDec(10000)
+	directPair.QuotePooledCoin.Amount = sdk.NewDec(10000)
+	keeper.SetSwapTokenPair(ctx, directPair.TokenPairName(), directPair)
+	route, _, err = keeper.FindBestSwapRoute(ctx, soldToken, types.TestBasePooledToken2, params)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.TestBasePooledToken, types.TestBasePooledToken2}, route)
+
+	// no route to the token without pool
+	_, _, err = keeper.FindBestSwapRoute(ctx, soldToken, types.TestBasePooledToken3, params)
+	require.NotNil(t, err)
+
+	// invalid routes
+	_, err = keeper.CalculateRouteTokensToBuy(ctx, soldToken,
+		[]string{types.TestBasePooledToken, types.TestBasePooledToken3}, params)
+	require.NotNil(t, err)
+	_, err = keeper.CalculateRouteTokensToBuy(ctx, soldToken,
+		[]string{types.TestBasePooledToken2, types.TestQuotePooledToken}, params)
+	require.NotNil(t, err)
+}
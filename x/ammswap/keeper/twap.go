@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+)
+
+// SetPriceAccumulator saves the price accumulator of a token pair at its height
+func (k Keeper) SetPriceAccumulator(ctx sdk.Context, accumulator types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPriceAccumulatorKey(accumulator.TokenPairName, accumulator.Height)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(accumulator))
+}
+
+// GetPriceAccumulators returns all the price accumulators of a token pair in ascending order of height
+func (k Keeper) GetPriceAccumulators(ctx sdk.Context, tokenPairName string) []types.PriceAccumulator {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorPrefix(tokenPairName))
+	defer iterator.Close()
+
+	var accumulators []types.PriceAccumulator
+	for ; iterator.Valid(); iterator.Next() {
+		var accumulator types.PriceAccumulator
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &accumulator)
+		accumulators = append(accumulators, accumulator)
+	}
+	return accumulators
+}
+
+// GetLatestPriceAccumulator returns the latest price accumulator of a token pair
+func (k Keeper) GetLatestPriceAccumulator(ctx sdk.Context, tokenPairName string) (types.PriceAccumulator, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetPriceAccumulatorPrefix(tokenPairName))
+	defer iterator.Close()
+
+	var accumulator types.PriceAccumulator
+	if !iterator.Valid() {
+		return accumulator, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &accumulator)
+	return accumulator, true
+}
+
+// UpdatePriceAccumulator accumulates the prices of the token pair with the reserves before they change.
+// only the first change of the pool in a block takes effect, so the prices can not be moved within a block
+func (k Keeper) UpdatePriceAccumulator(ctx sdk.Context, swapTokenPair types.SwapTokenPair) {
+	tokenPairName := swapTokenPair.TokenPairName()
+	height, now := ctx.BlockHeight(), ctx.BlockTime().Unix()
+	last, found := k.GetLatestPriceAccumulator(ctx, tokenPairName)
+	if found && last.Height >= height {
+		return
+	}
+
+	accumulator := types.NewPriceAccumulator(tokenPairName, height, now, sdk.ZeroDec(), sdk.ZeroDec())
+	if found {
+		accumulator = accumulateToTime(swapTokenPair, last, now)
+		accumulator.Height = height
+	}
+	k.SetPriceAccumulator(ctx, accumulator)
+	k.prunePriceAccumulators(ctx, tokenPairName, now-types.PriceAccumulatorRetention)
+}
+
+// prunePriceAccumulators deletes the price accumulators before the cutoff time,
+// except the latest of them which is still needed as the start of the oldest window
+func (k Keeper) prunePriceAccumulators(ctx sdk.Context, tokenPairName string, cutoff int64) {
+	store := ctx.KVStore(k.storeKey)
+	var expiredKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorPrefix(tokenPairName))
+	for ; iterator.Valid(); iterator.Next() {
+		var accumulator types.PriceAccumulator
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &accumulator)
+		if accumulator.Timestamp >= cutoff {
+			break
+		}
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(expiredKeys)-1; i++ {
+		store.Delete(expiredKeys[i])
+	}
+}
+
+// seekStartPriceAccumulator walks the price accumulators of the token pair backwards from the end key, and returns
+// the first one at or before the start. so only the price accumulators after the start are read
+func (k Keeper) seekStartPriceAccumulator(ctx sdk.Context, tokenPairName string, end []byte,
+	isStart func(types.PriceAccumulator) bool) (types.PriceAccumulator, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetPriceAccumulatorPrefix(tokenPairName), end)
+	defer iterator.Close()
+
+	var accumulator types.PriceAccumulator
+	for ; iterator.Valid(); iterator.Next() {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &accumulator)
+		if isStart(accumulator) {
+			return accumulator, true
+		}
+	}
+	return accumulator, false
+}
+
+// getEarliestPriceAccumulator returns the earliest price accumulator of a token pair
+func (k Keeper) getEarliestPriceAccumulator(ctx sdk.Context, tokenPairName string) (types.PriceAccumulator, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorPrefix(tokenPairName))
+	defer iterator.Close()
+
+	var accumulator types.PriceAccumulator
+	if !iterator.Valid() {
+		return accumulator, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &accumulator)
+	return accumulator, true
+}
+
+// getTWAP returns the time-weighted average price of the token pair from the latest price accumulator
+// at or before the start, which is sought backwards from the end key, to the current block
+func (k Keeper) getTWAP(ctx sdk.Context, tokenPairName string, end []byte,
+	isStart func(types.PriceAccumulator) bool) (types.TWAP, error) {
+	swapTokenPair, err := k.GetSwapTokenPair(ctx, tokenPairName)
+	if err != nil {
+		return types.TWAP{}, err
+	}
+	latest, found := k.GetLatestPriceAccumulator(ctx, tokenPairName)
+	if !found {
+		return types.TWAP{}, types.ErrNoPriceAccumulator(tokenPairName)
+	}
+
+	// the pool may be younger than the window, then it starts from the first accumulator
+	start, found := k.seekStartPriceAccumulator(ctx, tokenPairName, end, isStart)
+	if !found {
+		start, _ = k.getEarliestPriceAccumulator(ctx, tokenPairName)
+	}
+	now := ctx.BlockTime().Unix()
+	endAccumulator := accumulateToTime(swapTokenPair, latest, now)
+	elapsed := endAccumulator.Timestamp - start.Timestamp
+	if elapsed <= 0 {
+		return types.TWAP{}, types.ErrNoPriceAccumulator(tokenPairName)
+	}
+
+	return types.TWAP{
+		TokenPairName:   tokenPairName,
+		StartHeight:     start.Height,
+		StartTime:       start.Timestamp,
+		EndHeight:       ctx.BlockHeight(),
+		EndTime:         now,
+		BaseTokenPrice:  endAccumulator.BaseCumulativePrice.Sub(start.BaseCumulativePrice).QuoInt64(elapsed),
+		QuoteTokenPrice: endAccumulator.QuoteCumulativePrice.Sub(start.QuoteCumulativePrice).QuoInt64(elapsed),
+	}, nil
+}
+
+// GetTWAPByHeight returns the time-weighted average price of the token pair since the start height
+func (k Keeper) GetTWAPByHeight(ctx sdk.Context, tokenPairName string, startHeight int64) (types.TWAP, error) {
+	// the price accumulators are keyed by height, so the start is the first one before the key of the next height
+	end := types.GetPriceAccumulatorKey(tokenPairName, startHeight+1)
+	return k.getTWAP(ctx, tokenPairName, end, func(accumulator types.PriceAccumulator) bool {
+		return accumulator.Height <= startHeight
+	})
+}
+
+// GetTWAPByTime returns the time-weighted average price of the token pair in the last seconds of the window
+func (k Keeper) GetTWAPByTime(ctx sdk.Context, tokenPairName string, window int64) (types.TWAP, error) {
+	startTime := ctx.BlockTime().Unix() - window
+	end := sdk.PrefixEndBytes(types.GetPriceAccumulatorPrefix(tokenPairName))
+	return k.getTWAP(ctx, tokenPairName, end, func(accumulator types.PriceAccumulator) bool {
+		return accumulator.Timestamp <= startTime
+	})
+}
+
+// accumulateToTime accumulates the current prices of the token pair from the accumulator to the time
+func accumulateToTime(swapTokenPair types.SwapTokenPair, accumulator types.PriceAccumulator, timestamp int64) types.PriceAccumulator {
+	elapsed := timestamp - accumulator.Timestamp
+	if elapsed <= 0 {
+		return accumulator
+	}
+	basePrice := GetSpotPrice(swapTokenPair, swapTokenPair.BasePooledCoin.Denom)
+	quotePrice := GetSpotPrice(swapTokenPair, swapTokenPair.QuotePooledCoin.Denom)
+	accumulator.BaseCumulativePrice = accumulator.BaseCumulativePrice.Add(basePrice.MulInt64(elapsed))
+	accumulator.QuoteCumulativePrice = accumulator.QuoteCumulativePrice.Add(quotePrice.MulInt64(elapsed))
+	accumulator.Timestamp = timestamp
+	return accumulator
+}
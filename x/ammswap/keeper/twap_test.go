@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestTWAP(t *testing.T) {
+	mapp, addrList, ctx, keeper, querier := initQurierTest(t)
+	startTime := time.Unix(1000000, 0)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(startTime)
+	swapTokenPair := initTestPool(t, addrList, mapp, ctx, keeper, sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(200)), sdk.NewDec(1))
+	tokenPairName := swapTokenPair.TokenPairName()
+
+	// no price accumulated yet
+	_, err := keeper.GetTWAPByTime(ctx, tokenPairName, 60)
+	require.NotNil(t, err)
+
+	// base price is 2 for 100 seconds
+	keeper.UpdatePriceAccumulator(ctx, swapTokenPair)
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(100 * time.Second))
+	keeper.UpdatePriceAccumulator(ctx, swapTokenPair)
+	swapTokenPair.QuotePooledCoin.Amount = sdk.NewDec(400)
+	keeper.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
+
+	// the second change in the same block does not move the accumulator
+	manipulated := swapTokenPair
+	manipulated.QuotePooledCoin.Amount = sdk.NewDec(100000)
+	keeper.UpdatePriceAccumulator(ctx, manipulated)
+	accumulator, found := keeper.GetLatestPriceAccumulator(ctx, tokenPairName)
+	require.True(t, found)
+	require.Equal(t, int64(11), accumulator.Height)
+	require.Equal(t, sdk.NewDec(200), accumulator.BaseCumulativePrice)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1).MulInt64(100), accumulator.QuoteCumulativePrice)
+
+	// base price is 4 for another 100 seconds
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(startTime.Add(200 * time.Second))
+	twap, err := keeper.GetTWAPByHeight(ctx, tokenPairName, 10)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), twap.StartHeight)
+	require.Equal(t, int64(12), twap.EndHeight)
+	require.Equal(t, sdk.NewDec(3), twap.BaseTokenPrice)
+	require.Equal(t, sdk.NewDecWithPrec(375, 3), twap.QuoteTokenPrice)
+
+	twap, err = keeper.GetTWAPByTime(ctx, tokenPairName, 100)
+	require.Nil(t, err)
+	require.Equal(t, int64(11), twap.StartHeight)
+	require.Equal(t, sdk.NewDec(4), twap.BaseTokenPrice)
+
+	// the window longer than the pool starts from the first accumulator
+	twap, err = keeper.GetTWAPByTime(ctx, tokenPairName, 100000)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), twap.StartHeight)
+
+	// query
+	queryParams := types.NewQueryTWAPParams(tokenPairName, 11, 0)
+	resultBytes, err := querier(ctx, []string{types.QueryTWAP}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(queryParams)})
+	require.Nil(t, err)
+	var result types.TWAP
+	keeper.cdc.MustUnmarshalJSON(resultBytes, &result)
+	require.Equal(t, sdk.NewDec(4), result.BaseTokenPrice)
+
+	queryParams = types.NewQueryTWAPParams(tokenPairName, 0, 0)
+	_, err = querier(ctx, []string{types.QueryTWAP}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(queryParams)})
+	require.NotNil(t, err)
+
+	// the accumulators out of the retention are pruned, except the latest of them
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(startTime.Add(time.Duration(types.PriceAccumulatorRetention+150) * time.Second))
+	keeper.UpdatePriceAccumulator(ctx, swapTokenPair)
+	accumulators := keeper.GetPriceAccumulators(ctx, tokenPairName)
+	require.Equal(t, 2, len(accumulators))
+	require.Equal(t, int64(11), accumulators[0].Height)
+}
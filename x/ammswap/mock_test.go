@@ -62,15 +62,19 @@ func getMockAppWithBalance(t *testing.T, numGenAccs int, balance int64) (mockApp
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[feeCollector.String()] = true
 
-	mockApp.bankKeeper = bank.NewBaseKeeper(mockApp.AccountKeeper,
-		mockApp.ParamsKeeper.Subspace(bank.DefaultParamspace),
-		blacklistedAddrs)
-
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName: nil,
 		token.ModuleName:      {supply.Minter, supply.Burner},
 		ModuleName:            {supply.Minter, supply.Burner},
 	}
+	moduleAccAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		moduleAccAddrs[supply.NewModuleAddress(acc).String()] = true
+	}
+
+	mockApp.bankKeeper = token.NewFreezeBankKeeper(bank.NewBaseKeeper(mockApp.AccountKeeper,
+		mockApp.ParamsKeeper.Subspace(bank.DefaultParamspace),
+		blacklistedAddrs), mockApp.keyToken, moduleAccAddrs)
 	mockApp.supplyKeeper = supply.NewKeeper(mockApp.Cdc, mockApp.keySupply, mockApp.AccountKeeper,
 		mockApp.bankKeeper, maccPerms)
 
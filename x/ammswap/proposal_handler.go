@@ -0,0 +1,44 @@
+package ammswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/common"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// NewProposalHandler handles "gov" type message in "ammswap"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.WithdrawProtocolFeeProposal:
+			return handleWithdrawProtocolFeeProposal(ctx, k, proposal)
+		default:
+			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleWithdrawProtocolFeeProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	withdrawProposal, ok := proposal.Content.(types.WithdrawProtocolFeeProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+	if sdkErr := k.CheckWithdrawProtocolFeeProposal(ctx, withdrawProposal); sdkErr != nil {
+		return sdkErr
+	}
+
+	fees, err := k.WithdrawProtocolFees(ctx, withdrawProposal.TokenPairName, withdrawProposal.Destination)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawProtocolFee,
+		sdk.NewAttribute(types.AttributeKeyTokenPair, withdrawProposal.TokenPairName),
+		sdk.NewAttribute(types.AttributeKeyDestination, withdrawProposal.Destination),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+	))
+	return nil
+}
@@ -10,6 +10,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRemoveLiquidity{}, "okexchain/ammswap/MsgRemoveLiquidity", nil)
 	cdc.RegisterConcrete(MsgCreateExchange{}, "okexchain/ammswap/MsgCreateExchange", nil)
 	cdc.RegisterConcrete(MsgTokenToToken{}, "okexchain/ammswap/MsgSwapToken", nil)
+	cdc.RegisterConcrete(MsgCreateLimitOrder{}, "okexchain/ammswap/MsgCreateLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCancelLimitOrder{}, "okexchain/ammswap/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(WithdrawProtocolFeeProposal{}, "okexchain/ammswap/WithdrawProtocolFeeProposal", nil)
 }
 
 // ModuleCdc defines the module codec
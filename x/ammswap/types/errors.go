@@ -56,6 +56,18 @@ const (
 	CodeIsSwapTokenPairExist                 uint32 = 65043
 	CodeIsPoolTokenPairExist                 uint32 = 65044
 	CodeInternalError                        uint32 = 65045
+	CodeInvalidSwapRoute                     uint32 = 65046
+	CodeSwapRouteNotFound                    uint32 = 65047
+	CodeInvalidPoolType                      uint32 = 65048
+	CodeInvalidPoolFeeRate                   uint32 = 65049
+	CodeNoPriceAccumulator                   uint32 = 65050
+	CodeNoProtocolFee                        uint32 = 65051
+	CodeInvalidProtocolFeeDestination        uint32 = 65052
+	CodeUnexpectedProposalType               uint32 = 65053
+	CodeLimitOrderNotExist                   uint32 = 65054
+	CodeNotLimitOrderSender                  uint32 = 65055
+	CodeTriggerPriceTooLarge                 uint32 = 65056
+	CodeLimitOrderDeadlineTooLate            uint32 = 65057
 )
 
 func ErrNonExistSwapTokenPair(tokenPairName string) sdk.EnvelopedErr {
@@ -239,3 +251,51 @@ func ErrSwapTokenPairExist() sdk.EnvelopedErr {
 func ErrPoolTokenPairExist() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeIsPoolTokenPairExist, "the pool token pair already exists")}
 }
+
+func ErrInvalidSwapRoute(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidSwapRoute, fmt.Sprintf("invalid swap route: %s", msg))}
+}
+
+func ErrSwapRouteNotFound(soldToken, buyToken string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSwapRouteNotFound, fmt.Sprintf("no swap route found from %s to %s", soldToken, buyToken))}
+}
+
+func ErrInvalidPoolType(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidPoolType, fmt.Sprintf("invalid pool type: %s", msg))}
+}
+
+func ErrInvalidPoolFeeRate(feeRate string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidPoolFeeRate, fmt.Sprintf("fee rate %s is not one of the pool fee tiers", feeRate))}
+}
+
+func ErrNoPriceAccumulator(tokenPairName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoPriceAccumulator, fmt.Sprintf("no price accumulated for %s in the window", tokenPairName))}
+}
+
+func ErrNoProtocolFee(tokenPairName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoProtocolFee, fmt.Sprintf("no protocol fee accrued by %s", tokenPairName))}
+}
+
+func ErrInvalidProtocolFeeDestination(destination string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidProtocolFeeDestination, fmt.Sprintf("invalid protocol fee destination: %s", destination))}
+}
+
+func ErrUnexpectedProposalType(proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnexpectedProposalType, fmt.Sprintf("unsupported ammswap proposal type: %s", proposalType))}
+}
+
+func ErrLimitOrderNotExist(orderID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeLimitOrderNotExist, fmt.Sprintf("limit order %d does not exist", orderID))}
+}
+
+func ErrNotLimitOrderSender(orderID uint64, addr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotLimitOrderSender, fmt.Sprintf("%s is not the sender of limit order %d", addr, orderID))}
+}
+
+func ErrTriggerPriceTooLarge(triggerPrice string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerPriceTooLarge, fmt.Sprintf("trigger price %s is too large", triggerPrice))}
+}
+
+func ErrLimitOrderDeadlineTooLate(deadline int64, maxDeadline int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeLimitOrderDeadlineTooLate, fmt.Sprintf("limit order deadline %d is later than %d", deadline, maxDeadline))}
+}
@@ -3,4 +3,14 @@ package types
 // ammswap module event types
 const (
 	AttributeValueCategory = ModuleName
+
+	EventTypeWithdrawProtocolFee = "withdraw_protocol_fee"
+	EventTypeExecuteLimitOrder   = "execute_limit_order"
+	EventTypeExpireLimitOrder    = "expire_limit_order"
+	EventTypeKillLimitOrder      = "kill_limit_order"
+
+	AttributeKeyTokenPair   = "token_pair"
+	AttributeKeyDestination = "destination"
+	AttributeKeyOrderID     = "order_id"
+	AttributeKeyBoughtToken = "bought_token_amount"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
 	"github.com/okex/okexchain/x/params"
 	token "github.com/okex/okexchain/x/token/types"
 )
@@ -12,6 +13,7 @@ type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 }
 
 // BankKeeper defines the expected bank interface
@@ -41,6 +43,16 @@ type TokenKeeper interface {
 	GetTokensInfo(ctx sdk.Context) (tokens []token.Token)
 }
 
+// DistrKeeper defines the expected distribution interface
+type DistrKeeper interface {
+	FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.SysCoins, senderModule string) error
+}
+
+// GovKeeper defines the expected gov interface
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
 
 type BackendKeeper interface {
 	OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin)
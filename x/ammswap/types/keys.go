@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "ammswap"
@@ -21,14 +25,91 @@ const (
 	QueryBuyAmount             = "buy"
 	QuerySwapQuoteInfo         = "swapQuoteInfo"
 	QuerySwapAddLiquidityQuote = "swapAddLiquidityQuote"
+	QuerySwapRoute             = "swapRoute"
+	QueryTWAP                  = "twap"
+	QueryProtocolFees          = "protocolFees"
+	QueryLimitOrder            = "limitOrder"
+	QueryLimitOrders           = "limitOrders"
 )
 
 var (
 	// TokenPairPrefixKey to be used for KVStore
 	TokenPairPrefixKey = []byte{0x01}
+	// PriceAccumulatorPrefixKey to be used for the cumulative prices of token pairs
+	PriceAccumulatorPrefixKey = []byte{0x02}
+	// ProtocolFeePrefixKey to be used for the protocol fees accrued by token pairs
+	ProtocolFeePrefixKey = []byte{0x03}
+	// LimitOrderPrefixKey to be used for the limit orders waiting for the trigger price
+	LimitOrderPrefixKey = []byte{0x04}
+	// LastLimitOrderIDKey to be used for the id of the last limit order created
+	LastLimitOrderIDKey = []byte{0x05}
+	// LimitOrderTriggerPrefixKey to be used for the index of the limit orders by the trigger price
+	LimitOrderTriggerPrefixKey = []byte{0x06}
+	// LimitOrderDeadlinePrefixKey to be used for the index of the limit orders by the deadline
+	LimitOrderDeadlinePrefixKey = []byte{0x07}
 )
 
 // nolint
 func GetTokenPairKey(key string) []byte {
 	return append(TokenPairPrefixKey, []byte(key)...)
 }
+
+// GetPriceAccumulatorPrefix returns the prefix of the price accumulators of the token pair
+func GetPriceAccumulatorPrefix(tokenPairName string) []byte {
+	key := append(PriceAccumulatorPrefixKey, []byte(tokenPairName)...)
+	return append(key, 0x00)
+}
+
+// GetPriceAccumulatorKey returns the key of the price accumulator of the token pair at the height
+func GetPriceAccumulatorKey(tokenPairName string, height int64) []byte {
+	return append(GetPriceAccumulatorPrefix(tokenPairName), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetProtocolFeeKey returns the key of the protocol fees accrued by the token pair
+func GetProtocolFeeKey(tokenPairName string) []byte {
+	return append(ProtocolFeePrefixKey, []byte(tokenPairName)...)
+}
+
+// GetLimitOrderKey returns the key of the limit order
+func GetLimitOrderKey(orderID uint64) []byte {
+	return append(LimitOrderPrefixKey, sdk.Uint64ToBigEndian(orderID)...)
+}
+
+// GetLimitOrderTriggerPrefix returns the prefix of the limit orders selling the token through the token pair
+func GetLimitOrderTriggerPrefix(tokenPairName, soldTokenDenom string) []byte {
+	key := append(LimitOrderTriggerPrefixKey, []byte(tokenPairName)...)
+	key = append(append(key, 0x00), []byte(soldTokenDenom)...)
+	return append(key, 0x00)
+}
+
+// GetLimitOrderTriggerPriceKey returns the prefix of the limit orders indexed under the trigger price
+func GetLimitOrderTriggerPriceKey(tokenPairName, soldTokenDenom string, triggerPrice sdk.Dec) []byte {
+	// the fixed-length big endian bytes keep the limit orders sorted by the trigger price. the prices beyond the
+	// length are kept as the max price, which never happens to the trigger prices validated
+	priceBytes := make([]byte, MaxTriggerPriceLen)
+	bz := triggerPrice.BigInt().Bytes()
+	if len(bz) > MaxTriggerPriceLen {
+		for i := range priceBytes {
+			priceBytes[i] = 0xff
+		}
+	} else {
+		copy(priceBytes[len(priceBytes)-len(bz):], bz)
+	}
+	return append(GetLimitOrderTriggerPrefix(tokenPairName, soldTokenDenom), priceBytes...)
+}
+
+// GetLimitOrderTriggerKey returns the key of the trigger price index of the limit order
+func GetLimitOrderTriggerKey(order LimitOrder) []byte {
+	return append(GetLimitOrderTriggerPriceKey(order.GetSwapTokenPairName(), order.SoldTokenAmount.Denom,
+		order.TriggerPrice), sdk.Uint64ToBigEndian(order.OrderID)...)
+}
+
+// GetLimitOrderDeadlinePrefix returns the prefix of the limit orders which expire after the deadline
+func GetLimitOrderDeadlinePrefix(deadline int64) []byte {
+	return append(LimitOrderDeadlinePrefixKey, sdk.Uint64ToBigEndian(uint64(deadline))...)
+}
+
+// GetLimitOrderDeadlineKey returns the key of the deadline index of the limit order
+func GetLimitOrderDeadlineKey(order LimitOrder) []byte {
+	return append(GetLimitOrderDeadlinePrefix(order.Deadline), sdk.Uint64ToBigEndian(order.OrderID)...)
+}
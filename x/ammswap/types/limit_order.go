@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxTriggerPriceLen is the max length in bytes of the trigger price, which is kept in the index keys with the
+	// fixed length
+	MaxTriggerPriceLen = 32
+	// MaxLimitOrderLifetime is the longest time a limit order waits for the trigger price before it expires
+	MaxLimitOrderLifetime = 30 * 24 * time.Hour
+	// MaxTriggeredLimitOrdersPerBlock is the max number of the triggered limit orders of a token pair tried to be
+	// executed in a block. the triggered orders which can't buy the minimum amount are killed, so that the orders
+	// behind them are tried in the following blocks
+	MaxTriggeredLimitOrdersPerBlock = 100
+)
+
+// LimitOrder escrows the sold token in the module account, and swaps it through the swap token pair
+// once the spot price of the sold token reaches the trigger price
+type LimitOrder struct {
+	OrderID              uint64         `json:"order_id"`
+	Sender               sdk.AccAddress `json:"sender"`
+	Recipient            sdk.AccAddress `json:"recipient"`
+	SoldTokenAmount      sdk.SysCoin    `json:"sold_token_amount"`       // Amount of tokens escrowed to sell
+	MinBoughtTokenAmount sdk.SysCoin    `json:"min_bought_token_amount"` // Minimum token purchased when the order executes
+	TriggerPrice         sdk.Dec        `json:"trigger_price"`           // Spot price of the sold token in the bought token to execute at
+	Deadline             int64          `json:"deadline"`                // Time after which the order expires and is refunded
+	CreatedHeight        int64          `json:"created_height"`
+}
+
+// NewLimitOrder creates a new instance of LimitOrder
+func NewLimitOrder(orderID uint64, msg MsgCreateLimitOrder, createdHeight int64) LimitOrder {
+	return LimitOrder{
+		OrderID:              orderID,
+		Sender:               msg.Sender,
+		Recipient:            msg.Recipient,
+		SoldTokenAmount:      msg.SoldTokenAmount,
+		MinBoughtTokenAmount: msg.MinBoughtTokenAmount,
+		TriggerPrice:         msg.TriggerPrice,
+		Deadline:             msg.Deadline,
+		CreatedHeight:        createdHeight,
+	}
+}
+
+// GetSwapTokenPairName returns the name of the swap token pair the order swaps through
+func (o LimitOrder) GetSwapTokenPairName() string {
+	return GetSwapTokenPairName(o.SoldTokenAmount.Denom, o.MinBoughtTokenAmount.Denom)
+}
+
+// IsTriggered returns true if the spot price of the sold token reaches the trigger price
+func (o LimitOrder) IsTriggered(spotPrice sdk.Dec) bool {
+	return spotPrice.IsPositive() && spotPrice.GTE(o.TriggerPrice)
+}
+
+// String implements the stringer interface
+func (o LimitOrder) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`LimitOrder:
+  OrderID:              %d
+  Sender:               %s
+  Recipient:            %s
+  SoldTokenAmount:      %s
+  MinBoughtTokenAmount: %s
+  TriggerPrice:         %s
+  Deadline:             %d
+  CreatedHeight:        %d`, o.OrderID, o.Sender, o.Recipient, o.SoldTokenAmount, o.MinBoughtTokenAmount,
+		o.TriggerPrice, o.Deadline, o.CreatedHeight))
+}
+
+// QueryLimitOrdersParams defines the params of querying the limit orders, of the sender if it is set
+type QueryLimitOrdersParams struct {
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+// NewQueryLimitOrdersParams creates a new instance of QueryLimitOrdersParams
+func NewQueryLimitOrdersParams(sender sdk.AccAddress) QueryLimitOrdersParams {
+	return QueryLimitOrdersParams{
+		Sender: sender,
+	}
+}
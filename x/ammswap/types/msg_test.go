@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"github.com/okex/okexchain/x/common"
 	"testing"
 	"time"
@@ -264,3 +265,99 @@ func TestMsgTokenToTokenInvalid(t *testing.T) {
 		testCode(t, err, testCase.exceptResultCode)
 	}
 }
+
+func TestMsgTokenToTokenPath(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(1))
+	soldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken2, sdk.NewDec(2))
+	msg := NewMsgTokenToToken(soldTokenAmount, minBoughtTokenAmount, time.Now().Unix(), addr, addr)
+	require.Equal(t, []string{TestBasePooledToken2, TestBasePooledToken}, msg.GetRoute())
+
+	msg.Path = []string{TestQuotePooledToken}
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []string{TestBasePooledToken2, TestQuotePooledToken, TestBasePooledToken}, msg.GetRoute())
+
+	tests := []struct {
+		testCase string
+		path     []string
+	}{
+		{"invalid token", []string{"1aaa"}},
+		{"repeated token", []string{TestQuotePooledToken, TestBasePooledToken2}},
+		{"too many hops", []string{TestQuotePooledToken, TestBasePooledToken3, "eeb"}},
+	}
+	for _, testCase := range tests {
+		msg.Path = testCase.path
+		require.NotNil(t, msg.ValidateBasic(), testCase.testCase)
+	}
+}
+
+func TestMsgCreateExchangeWithCurve(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	tests := []struct {
+		testCase      string
+		poolType      string
+		amplification int64
+		feeRate       sdk.Dec
+		expectSuccess bool
+	}{
+		{"default", "", 0, sdk.ZeroDec(), true},
+		{"constant product with fee tier", PoolTypeConstantProduct, 0, PoolFeeTiers[2], true},
+		{"stable swap", PoolTypeStableSwap, DefaultAmplification, PoolFeeTiers[0], true},
+		{"stable swap without amplification", PoolTypeStableSwap, 0, sdk.ZeroDec(), false},
+		{"stable swap with too big amplification", PoolTypeStableSwap, MaxAmplification + 1, sdk.ZeroDec(), false},
+		{"constant product with amplification", PoolTypeConstantProduct, DefaultAmplification, sdk.ZeroDec(), false},
+		{"unknown pool type", "unknown", 0, sdk.ZeroDec(), false},
+		{"fee rate not in tiers", "", 0, sdk.NewDecWithPrec(2, 3), false},
+	}
+	for _, testCase := range tests {
+		msg := NewMsgCreateExchangeWithCurve(TestBasePooledToken, TestQuotePooledToken, testCase.poolType,
+			testCase.amplification, testCase.feeRate, addr)
+		err := msg.ValidateBasic()
+		require.Equal(t, testCase.expectSuccess, err == nil, testCase.testCase)
+	}
+}
+
+func TestWithdrawProtocolFeeProposal(t *testing.T) {
+	proposal := NewWithdrawProtocolFeeProposal("title", "description", TestSwapTokenPairName, ProtocolFeeToCommunityPool)
+	require.Nil(t, proposal.ValidateBasic())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+
+	proposal.Destination = ProtocolFeeBurn
+	require.Nil(t, proposal.ValidateBasic())
+
+	proposal.Destination = "treasury"
+	require.NotNil(t, proposal.ValidateBasic())
+
+	proposal = NewWithdrawProtocolFeeProposal("title", "description", "", ProtocolFeeBurn)
+	require.NotNil(t, proposal.ValidateBasic())
+}
+
+func TestMsgCreateLimitOrder(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	soldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(100))
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(TestQuotePooledToken, sdk.NewDec(150))
+	deadline := time.Now().Add(time.Hour).Unix()
+
+	msg := NewMsgCreateLimitOrder(soldTokenAmount, minBoughtTokenAmount, sdk.NewDecWithPrec(15, 1), deadline, addr, addr)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, TypeMsgCreateLimitOrder, msg.Type())
+	require.Equal(t, TestSwapTokenPairName, msg.GetSwapTokenPairName())
+
+	msg.TriggerPrice = sdk.ZeroDec()
+	require.NotNil(t, msg.ValidateBasic())
+	msg.TriggerPrice = sdk.NewDecFromBigIntWithPrec(new(big.Int).Lsh(big.NewInt(1), 8*MaxTriggerPriceLen), sdk.Precision)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgCreateLimitOrder(soldTokenAmount, sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(150)),
+		sdk.NewDecWithPrec(15, 1), deadline, addr, addr)
+	require.NotNil(t, msg.ValidateBasic())
+
+	cancelMsg := NewMsgCancelLimitOrder(1, addr)
+	require.Nil(t, cancelMsg.ValidateBasic())
+	require.Equal(t, TypeMsgCancelLimitOrder, cancelMsg.Type())
+	cancelMsg.OrderID = 0
+	require.NotNil(t, cancelMsg.ValidateBasic())
+}
@@ -8,6 +8,9 @@ import (
 const (
 	TypeMsgAddLiquidity = "add_liquidity"
 	TypeMsgTokenSwap    = "token_swap"
+
+	TypeMsgCreateLimitOrder = "create_limit_order"
+	TypeMsgCancelLimitOrder = "cancel_limit_order"
 )
 
 // MsgAddLiquidity Deposit quote_amount and base_amount at current ratio to mint pool tokens.
@@ -140,9 +143,12 @@ func (msg MsgRemoveLiquidity) GetSwapTokenPairName() string {
 
 // MsgCreateExchange creates a new exchange with token
 type MsgCreateExchange struct {
-	Token0Name string         `json:"token0_name"`
-	Token1Name string         `json:"token1_name"`
-	Sender     sdk.AccAddress `json:"sender"` // Sender
+	Token0Name    string         `json:"token0_name"`
+	Token1Name    string         `json:"token1_name"`
+	Sender        sdk.AccAddress `json:"sender"`                  // Sender
+	PoolType      string         `json:"pool_type,omitempty"`     // The curve of the pool, constant product if empty
+	Amplification int64          `json:"amplification,omitempty"` // The amplification coefficient of the stable swap pool
+	FeeRate       sdk.Dec        `json:"fee_rate"`                // The fee tier of the pool, the module fee rate is used if zero
 }
 
 // NewMsgCreateExchange create a new exchange with token
@@ -151,6 +157,20 @@ func NewMsgCreateExchange(token0Name string, token1Name string, sender sdk.AccAd
 		Token0Name: token0Name,
 		Token1Name: token1Name,
 		Sender:     sender,
+		FeeRate:    sdk.ZeroDec(),
+	}
+}
+
+// NewMsgCreateExchangeWithCurve create a new exchange with token, the curve and the fee tier of the pool
+func NewMsgCreateExchangeWithCurve(token0Name string, token1Name string, poolType string, amplification int64,
+	feeRate sdk.Dec, sender sdk.AccAddress) MsgCreateExchange {
+	return MsgCreateExchange{
+		Token0Name:    token0Name,
+		Token1Name:    token1Name,
+		Sender:        sender,
+		PoolType:      poolType,
+		Amplification: amplification,
+		FeeRate:       feeRate,
 	}
 }
 
@@ -176,7 +196,11 @@ func (msg MsgCreateExchange) ValidateBasic() sdk.Error {
 	if msg.Token0Name == msg.Token1Name {
 		return ErrToken0NameEqualToken1Name()
 	}
-	return nil
+
+	if err := ValidatePoolType(msg.PoolType, msg.Amplification); err != nil {
+		return err
+	}
+	return ValidatePoolFeeRate(msg.FeeRate)
 }
 
 // GetSignBytes encodes the message for signing
@@ -201,6 +225,7 @@ type MsgTokenToToken struct {
 	Deadline             int64          `json:"deadline"`                // Time after which this transaction can no longer be executed.
 	Recipient            sdk.AccAddress `json:"recipient"`               // Recipient address,transfer Tokens to recipient.default recipient is sender.
 	Sender               sdk.AccAddress `json:"sender"`                  // Sender
+	Path                 []string       `json:"path,omitempty"`          // Intermediate tokens to swap through. the direct pair is used if empty.
 }
 
 // NewMsgTokenToToken is a constructor function for MsgTokenOKTSwap
@@ -248,6 +273,9 @@ func (msg MsgTokenToToken) ValidateBasic() sdk.Error {
 	if err != nil {
 		return err
 	}
+	if len(msg.Path) > 0 {
+		return ValidateSwapRoute(msg.GetRoute())
+	}
 	return nil
 }
 
@@ -265,3 +293,129 @@ func (msg MsgTokenToToken) GetSigners() []sdk.AccAddress {
 func (msg MsgTokenToToken) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, msg.SoldTokenAmount.Denom)
 }
+
+// GetRoute returns all the tokens the swap goes through, from the sold token to the bought token
+func (msg MsgTokenToToken) GetRoute() []string {
+	route := make([]string, 0, len(msg.Path)+2)
+	route = append(route, msg.SoldTokenAmount.Denom)
+	route = append(route, msg.Path...)
+	return append(route, msg.MinBoughtTokenAmount.Denom)
+}
+
+// MsgCreateLimitOrder defines the message to sell a token through the swap token pair
+// once the spot price of the sold token reaches the trigger price
+type MsgCreateLimitOrder struct {
+	SoldTokenAmount      sdk.SysCoin    `json:"sold_token_amount"`       // Amount of Tokens sold.
+	MinBoughtTokenAmount sdk.SysCoin    `json:"min_bought_token_amount"` // Minimum token purchased.
+	TriggerPrice         sdk.Dec        `json:"trigger_price"`           // Spot price of the sold token in the bought token to execute at.
+	Deadline             int64          `json:"deadline"`                // Time after which the order expires and the sold token is refunded.
+	Recipient            sdk.AccAddress `json:"recipient"`               // Recipient address,transfer Tokens to recipient.default recipient is sender.
+	Sender               sdk.AccAddress `json:"sender"`                  // Sender
+}
+
+// NewMsgCreateLimitOrder is a constructor function for MsgCreateLimitOrder
+func NewMsgCreateLimitOrder(soldTokenAmount, minBoughtTokenAmount sdk.SysCoin, triggerPrice sdk.Dec, deadline int64,
+	recipient, sender sdk.AccAddress) MsgCreateLimitOrder {
+	return MsgCreateLimitOrder{
+		SoldTokenAmount:      soldTokenAmount,
+		MinBoughtTokenAmount: minBoughtTokenAmount,
+		TriggerPrice:         triggerPrice,
+		Deadline:             deadline,
+		Recipient:            recipient,
+		Sender:               sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateLimitOrder) Type() string { return TypeMsgCreateLimitOrder }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateLimitOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+
+	if !(msg.SoldTokenAmount.IsPositive()) {
+		return ErrSoldTokenAmountIsNegative()
+	}
+	if !msg.SoldTokenAmount.IsValid() {
+		return ErrSoldTokenAmount()
+	}
+
+	if !msg.MinBoughtTokenAmount.IsValid() {
+		return ErrMinBoughtTokenAmount()
+	}
+
+	if msg.TriggerPrice.IsNil() || !msg.TriggerPrice.IsPositive() {
+		return ErrIsZeroValue("trigger price")
+	}
+	if len(msg.TriggerPrice.BigInt().Bytes()) > MaxTriggerPriceLen {
+		return ErrTriggerPriceTooLarge(msg.TriggerPrice.String())
+	}
+
+	baseAmountName, quoteAmountName := GetBaseQuoteTokenName(msg.SoldTokenAmount.Denom, msg.MinBoughtTokenAmount.Denom)
+	return ValidateBaseAndQuoteAmount(baseAmountName, quoteAmountName)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSwapTokenPairName defines token pair
+func (msg MsgCreateLimitOrder) GetSwapTokenPairName() string {
+	return GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, msg.SoldTokenAmount.Denom)
+}
+
+// MsgCancelLimitOrder defines the message to cancel a limit order and refund the sold token
+type MsgCancelLimitOrder struct {
+	OrderID uint64         `json:"order_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+// NewMsgCancelLimitOrder is a constructor function for MsgCancelLimitOrder
+func NewMsgCancelLimitOrder(orderID uint64, sender sdk.AccAddress) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		OrderID: orderID,
+		Sender:  sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelLimitOrder) Type() string { return TypeMsgCancelLimitOrder }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelLimitOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+	if msg.OrderID == 0 {
+		return ErrIsZeroValue("order id")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -5,12 +5,16 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/okex/okexchain/x/common"
 	"github.com/okex/okexchain/x/params"
 )
 
 // FeeRate defines swap fee rate
 var (
 	defaultFeeRate = sdk.NewDecWithPrec(3, 3)
+	// the protocol fee is switched off by default, all the swap fees go to the liquidity providers
+	defaultProtocolFeeRate = sdk.ZeroDec()
+	defaultLimitOrderFee   = sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDecWithPrec(1, 2))
 )
 
 // Default parameter namespace
@@ -22,7 +26,9 @@ const (
 
 // Parameter store keys
 var (
-	KeyFeeRate = []byte("FeeRate")
+	KeyFeeRate         = []byte("FeeRate")
+	KeyProtocolFeeRate = []byte("ProtocolFeeRate")
+	KeyLimitOrderFee   = []byte("LimitOrderFee")
 )
 
 // ParamKeyTable for swap module
@@ -33,19 +39,27 @@ func ParamKeyTable() params.KeyTable {
 // Params - used for initializing default parameter for swap at genesis
 type Params struct {
 	FeeRate sdk.Dec `json:"fee_rate"`
+	// ProtocolFeeRate is the fraction of the swap fee which is collected by the protocol instead of the pool
+	ProtocolFeeRate sdk.Dec `json:"protocol_fee_rate"`
+	// LimitOrderFee is charged to the fee collector when a limit order is placed
+	LimitOrderFee sdk.SysCoin `json:"limit_order_fee"`
 }
 
 // NewParams creates a new Params object
-func NewParams(feeRate sdk.Dec) Params {
+func NewParams(feeRate, protocolFeeRate sdk.Dec, limitOrderFee sdk.SysCoin) Params {
 	return Params{
-		FeeRate: feeRate,
+		FeeRate:         feeRate,
+		ProtocolFeeRate: protocolFeeRate,
+		LimitOrderFee:   limitOrderFee,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Poolswap Params:
-  TradeFeeRate: %s`, p.FeeRate)
+  TradeFeeRate: %s
+  ProtocolFeeRate: %s
+  LimitOrderFee: %s`, p.FeeRate, p.ProtocolFeeRate, p.LimitOrderFee)
 }
 
 
@@ -64,14 +78,31 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateProtocolFeeRate(value interface{}) error {
+	v, ok := value.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("protocol fee rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol fee rate too large: %s", v)
+	}
+	return nil
+}
+
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: KeyFeeRate, Value: &p.FeeRate, ValidatorFn: validateParams},
+		{Key: KeyProtocolFeeRate, Value: &p.ProtocolFeeRate, ValidatorFn: validateProtocolFeeRate},
+		{Key: KeyLimitOrderFee, Value: &p.LimitOrderFee, ValidatorFn: common.ValidateSysCoin("limit order fee")},
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(defaultFeeRate)
+	return NewParams(defaultFeeRate, defaultProtocolFeeRate, defaultLimitOrderFee)
 }
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeWithdrawProtocolFee defines the type for a WithdrawProtocolFeeProposal
+	proposalTypeWithdrawProtocolFee = "WithdrawProtocolFee"
+)
+
+// destinations of the withdrawn protocol fees
+const (
+	// ProtocolFeeToCommunityPool sends the protocol fees to the community pool of distribution
+	ProtocolFeeToCommunityPool = "community_pool"
+	// ProtocolFeeBurn burns the protocol fees
+	ProtocolFeeBurn = "burn"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeWithdrawProtocolFee)
+	govtypes.RegisterProposalTypeCodec(WithdrawProtocolFeeProposal{}, "okexchain/ammswap/WithdrawProtocolFeeProposal")
+}
+
+var _ govtypes.Content = (*WithdrawProtocolFeeProposal)(nil)
+
+// WithdrawProtocolFeeProposal - structure for the proposal to withdraw the protocol fees accrued by a token pair
+type WithdrawProtocolFeeProposal struct {
+	Title         string `json:"title" yaml:"title"`
+	Description   string `json:"description" yaml:"description"`
+	TokenPairName string `json:"token_pair_name" yaml:"token_pair_name"`
+	Destination   string `json:"destination" yaml:"destination"`
+}
+
+// NewWithdrawProtocolFeeProposal creates a new instance of WithdrawProtocolFeeProposal
+func NewWithdrawProtocolFeeProposal(title, description, tokenPairName, destination string) WithdrawProtocolFeeProposal {
+	return WithdrawProtocolFeeProposal{
+		Title:         title,
+		Description:   description,
+		TokenPairName: tokenPairName,
+		Destination:   destination,
+	}
+}
+
+// GetTitle returns title of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) GetTitle() string {
+	return wp.Title
+}
+
+// GetDescription returns description of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) GetDescription() string {
+	return wp.Description
+}
+
+// ProposalRoute returns route key of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) ProposalType() string {
+	return proposalTypeWithdrawProtocolFee
+}
+
+// ValidateBasic validates a withdraw protocol fee proposal
+func (wp WithdrawProtocolFeeProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(wp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(wp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(wp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(wp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if wp.ProposalType() != proposalTypeWithdrawProtocolFee {
+		return govtypes.ErrInvalidProposalType(wp.ProposalType())
+	}
+
+	if len(wp.TokenPairName) == 0 {
+		return govtypes.ErrInvalidProposalContent("token pair name is required")
+	}
+
+	switch wp.Destination {
+	case ProtocolFeeToCommunityPool, ProtocolFeeBurn:
+	default:
+		return ErrInvalidProtocolFeeDestination(wp.Destination)
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a WithdrawProtocolFeeProposal
+func (wp WithdrawProtocolFeeProposal) String() string {
+	return fmt.Sprintf(`WithdrawProtocolFeeProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ TokenPairName:			%s
+ Destination:			%s`,
+		wp.Title, wp.Description, wp.ProposalType(), wp.TokenPairName, wp.Destination)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProtocolFee records the protocol fees accrued by a token pair which are not withdrawn yet
+type ProtocolFee struct {
+	TokenPairName string       `json:"token_pair_name"`
+	Fees          sdk.SysCoins `json:"fees"`
+}
+
+// NewProtocolFee creates a new instance of ProtocolFee
+func NewProtocolFee(tokenPairName string, fees sdk.SysCoins) ProtocolFee {
+	return ProtocolFee{
+		TokenPairName: tokenPairName,
+		Fees:          fees,
+	}
+}
+
+// String implements the stringer interface
+func (p ProtocolFee) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ProtocolFee:
+  TokenPairName: %s
+  Fees:          %s`, p.TokenPairName, p.Fees))
+}
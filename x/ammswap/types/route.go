@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSwapRouteHops is the max number of swap token pairs a swap can go through
+const MaxSwapRouteHops = 3
+
+// ValidateSwapRoute checks the tokens of a swap route, from the sold token to the bought token
+func ValidateSwapRoute(route []string) error {
+	if len(route) < 2 {
+		return ErrInvalidSwapRoute("at least 2 tokens are required")
+	}
+	if len(route)-1 > MaxSwapRouteHops {
+		return ErrInvalidSwapRoute(fmt.Sprintf("the number of hops is bigger than %d", MaxSwapRouteHops))
+	}
+	visited := make(map[string]bool, len(route))
+	for _, token := range route {
+		if err := ValidateSwapAmountName(token); err != nil {
+			return err
+		}
+		if visited[token] {
+			return ErrInvalidSwapRoute(fmt.Sprintf("token %s appears more than once", token))
+		}
+		visited[token] = true
+	}
+	return nil
+}
+
+// QuerySwapRouteParams defines the params of querying the swap route
+type QuerySwapRouteParams struct {
+	SoldToken  sdk.SysCoin `json:"sold_token"`
+	TokenToBuy string      `json:"token_to_buy"`
+	Path       []string    `json:"path,omitempty"`
+}
+
+// NewQuerySwapRouteParams creates a new instance of QuerySwapRouteParams
+func NewQuerySwapRouteParams(soldToken sdk.SysCoin, tokenToBuy string, path []string) QuerySwapRouteParams {
+	return QuerySwapRouteParams{
+		SoldToken:  soldToken,
+		TokenToBuy: tokenToBuy,
+		Path:       path,
+	}
+}
+
+// SwapRouteInfo is the route of a swap and the amount expected to buy through it
+type SwapRouteInfo struct {
+	Route     []string    `json:"route"`
+	BuyAmount sdk.SysCoin `json:"buy_amount"`
+}
+
+// String implements the Stringer interface
+func (info SwapRouteInfo) String() string {
+	return fmt.Sprintf(`Route:     %v
+BuyAmount: %s`, info.Route, info.BuyAmount)
+}
@@ -12,20 +12,43 @@ import (
 // PoolTokenPrefix defines pool token prefix name
 const PoolTokenPrefix = "ammswap_"
 
+// pool types of the swap token pair
+const (
+	// PoolTypeConstantProduct prices the pool by x*y=k
+	PoolTypeConstantProduct = "constant_product"
+	// PoolTypeStableSwap prices the pool by the stable swap invariant, for pegged assets
+	PoolTypeStableSwap = "stable_swap"
+
+	DefaultAmplification int64 = 100
+	MaxAmplification     int64 = 10000
+)
+
+// PoolFeeTiers defines the fee rates a swap token pair can choose besides the module fee rate
+var PoolFeeTiers = []sdk.Dec{
+	sdk.NewDecWithPrec(5, 4),
+	sdk.NewDecWithPrec(3, 3),
+	sdk.NewDecWithPrec(1, 2),
+}
+
 // SwapTokenPair defines token pair exchange
 type SwapTokenPair struct {
-	QuotePooledCoin sdk.SysCoin `json:"quote_pooled_coin"` // The volume of quote token in the token pair exchange pool
-	BasePooledCoin  sdk.SysCoin `json:"base_pooled_coin"`  // The volume of base token in the token pair exchange pool
-	PoolTokenName   string      `json:"pool_token_name"`   // The name of pool token
+	QuotePooledCoin sdk.SysCoin `json:"quote_pooled_coin"`       // The volume of quote token in the token pair exchange pool
+	BasePooledCoin  sdk.SysCoin `json:"base_pooled_coin"`        // The volume of base token in the token pair exchange pool
+	PoolTokenName   string      `json:"pool_token_name"`         // The name of pool token
+	PoolType        string      `json:"pool_type,omitempty"`     // The curve of the pool, constant product if empty
+	Amplification   int64       `json:"amplification,omitempty"` // The amplification coefficient of the stable swap pool
+	FeeRate         sdk.Dec     `json:"fee_rate"`                // The fee tier of the pool, the module fee rate is used if zero
 }
 
 func NewSwapPair(token0, token1 string) SwapTokenPair {
 	base, quote := GetBaseQuoteTokenName(token0, token1)
 
 	swapTokenPair := SwapTokenPair{
-		sdk.NewDecCoinFromDec(quote, sdk.ZeroDec()),
-		sdk.NewDecCoinFromDec(base, sdk.ZeroDec()),
-		GetPoolTokenName(token0, token1),
+		QuotePooledCoin: sdk.NewDecCoinFromDec(quote, sdk.ZeroDec()),
+		BasePooledCoin:  sdk.NewDecCoinFromDec(base, sdk.ZeroDec()),
+		PoolTokenName:   GetPoolTokenName(token0, token1),
+		PoolType:        PoolTypeConstantProduct,
+		FeeRate:         sdk.ZeroDec(),
 	}
 	return swapTokenPair
 }
@@ -36,6 +59,8 @@ func NewSwapTokenPair(quotePooledCoin sdk.SysCoin, basePooledCoin sdk.SysCoin, p
 		QuotePooledCoin: quotePooledCoin,
 		BasePooledCoin:  basePooledCoin,
 		PoolTokenName:   poolTokenName,
+		PoolType:        PoolTypeConstantProduct,
+		FeeRate:         sdk.ZeroDec(),
 	}
 	return swapTokenPair
 }
@@ -44,7 +69,24 @@ func NewSwapTokenPair(quotePooledCoin sdk.SysCoin, basePooledCoin sdk.SysCoin, p
 func (s SwapTokenPair) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`QuotePooledCoin: %s
 BasePooledCoin: %s
-PoolTokenName: %s`, s.QuotePooledCoin.String(), s.BasePooledCoin.String(), s.PoolTokenName))
+PoolTokenName: %s
+PoolType: %s
+Amplification: %d
+FeeRate: %s`, s.QuotePooledCoin.String(), s.BasePooledCoin.String(), s.PoolTokenName, s.PoolType,
+		s.Amplification, s.FeeRate))
+}
+
+// IsStableSwap returns true if the pool is priced by the stable swap invariant
+func (s SwapTokenPair) IsStableSwap() bool {
+	return s.PoolType == PoolTypeStableSwap
+}
+
+// GetFeeRate returns the fee rate of the pool, which falls back to the module fee rate
+func (s SwapTokenPair) GetFeeRate(params Params) sdk.Dec {
+	if s.FeeRate.IsNil() || !s.FeeRate.IsPositive() {
+		return params.FeeRate
+	}
+	return s.FeeRate
 }
 
 // TokenPairName defines token pair
@@ -63,6 +105,7 @@ func InitPoolToken(poolTokenName string) token.Token {
 		Owner:               supply.NewModuleAddress(ModuleName),
 		Type:                GenerateTokenType,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 }
 
@@ -118,3 +161,33 @@ func SplitPoolToken(symbol string) (token0, token1 string) {
 	token1 = splits[1]
 	return
 }
+
+// ValidatePoolType checks the pool type and the amplification coefficient of the stable swap pool
+func ValidatePoolType(poolType string, amplification int64) error {
+	switch poolType {
+	case "", PoolTypeConstantProduct:
+		if amplification != 0 {
+			return ErrInvalidPoolType("amplification is only supported by stable swap pool")
+		}
+	case PoolTypeStableSwap:
+		if amplification < 1 || amplification > MaxAmplification {
+			return ErrInvalidPoolType(fmt.Sprintf("amplification should be between 1 and %d", MaxAmplification))
+		}
+	default:
+		return ErrInvalidPoolType(fmt.Sprintf("unknown pool type: %s", poolType))
+	}
+	return nil
+}
+
+// ValidatePoolFeeRate checks the fee rate is one of PoolFeeTiers. zero means the module fee rate
+func ValidatePoolFeeRate(feeRate sdk.Dec) error {
+	if feeRate.IsNil() || feeRate.IsZero() {
+		return nil
+	}
+	for _, tier := range PoolFeeTiers {
+		if feeRate.Equal(tier) {
+			return nil
+		}
+	}
+	return ErrInvalidPoolFeeRate(feeRate.String())
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceAccumulatorRetention is the seconds the price accumulators are kept for
+const PriceAccumulatorRetention int64 = 7 * 24 * 60 * 60
+
+// PriceAccumulator records the cumulative prices of a token pair at the first change of the pool in a block.
+// the cumulative price is the sum of the price multiplied by the seconds it lasts
+type PriceAccumulator struct {
+	TokenPairName        string  `json:"token_pair_name"`
+	Height               int64   `json:"height"`
+	Timestamp            int64   `json:"timestamp"`
+	BaseCumulativePrice  sdk.Dec `json:"base_cumulative_price"`  // cumulative price of base token in quote token
+	QuoteCumulativePrice sdk.Dec `json:"quote_cumulative_price"` // cumulative price of quote token in base token
+}
+
+// NewPriceAccumulator creates a new instance of PriceAccumulator
+func NewPriceAccumulator(tokenPairName string, height, timestamp int64,
+	baseCumulativePrice, quoteCumulativePrice sdk.Dec) PriceAccumulator {
+	return PriceAccumulator{
+		TokenPairName:        tokenPairName,
+		Height:               height,
+		Timestamp:            timestamp,
+		BaseCumulativePrice:  baseCumulativePrice,
+		QuoteCumulativePrice: quoteCumulativePrice,
+	}
+}
+
+// QueryTWAPParams defines the params of querying the time-weighted average price of a token pair.
+// the window starts from StartHeight if it is set, or TimeWindow seconds ago
+type QueryTWAPParams struct {
+	TokenPairName string `json:"token_pair_name"`
+	StartHeight   int64  `json:"start_height"`
+	TimeWindow    int64  `json:"time_window"`
+}
+
+// NewQueryTWAPParams creates a new instance of QueryTWAPParams
+func NewQueryTWAPParams(tokenPairName string, startHeight, timeWindow int64) QueryTWAPParams {
+	return QueryTWAPParams{
+		TokenPairName: tokenPairName,
+		StartHeight:   startHeight,
+		TimeWindow:    timeWindow,
+	}
+}
+
+// TWAP is the time-weighted average price of a token pair
+type TWAP struct {
+	TokenPairName   string  `json:"token_pair_name"`
+	StartHeight     int64   `json:"start_height"`
+	StartTime       int64   `json:"start_time"`
+	EndHeight       int64   `json:"end_height"`
+	EndTime         int64   `json:"end_time"`
+	BaseTokenPrice  sdk.Dec `json:"base_token_price"`  // average price of base token in quote token
+	QuoteTokenPrice sdk.Dec `json:"quote_token_price"` // average price of quote token in base token
+}
+
+// String implements the Stringer interface
+func (twap TWAP) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`TokenPairName: %s
+StartHeight: %d
+StartTime: %d
+EndHeight: %d
+EndTime: %d
+BaseTokenPrice: %s
+QuoteTokenPrice: %s`, twap.TokenPairName, twap.StartHeight, twap.StartTime, twap.EndHeight, twap.EndTime,
+		twap.BaseTokenPrice, twap.QuoteTokenPrice))
+}
@@ -594,13 +594,15 @@ func queryFarmFirstPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		firstPool.Balance = accountCoins.AmountOf(farmPool.MinLockAmount.Denom)
 
 		// locked info
+		accountWeighted := sdk.ZeroDec()
 		if lockedInfo, found := keeper.farmKeeper.GetLockInfo(ctx, address, farmPool.Name); found {
 			firstPool.AccountStaked = lockedInfo.Amount.Amount
+			accountWeighted = lockedInfo.WeightedAmount().Amount
 		}
 
-		// estimated farm
-		if !farmPool.TotalValueLocked.IsZero() {
-			firstPool.EstimatedFarm = farmAmount.Mul(firstPool.AccountStaked.Quo(farmPool.TotalValueLocked.Amount))
+		// estimated farm, shared by the weighted value locked
+		if !farmPool.TotalWeightedValueLocked.IsZero() {
+			firstPool.EstimatedFarm = farmAmount.Mul(accountWeighted.Quo(farmPool.TotalWeightedValueLocked.Amount))
 		}
 
 		if firstPool.EstimatedFarm.IsZero() {
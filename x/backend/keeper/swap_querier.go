@@ -104,11 +104,8 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		// calculate liquidity in dollar
 		liquidity := calculateDollarAmount(ctx, keeper, swapTokenPair.BasePooledCoin, swapTokenPair.QuotePooledCoin)
 
-		// calculate last price
-		lastPrice := sdk.ZeroDec()
-		if swapTokenPair.QuotePooledCoin.Amount.IsPositive() {
-			lastPrice = swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount)
-		}
+		// calculate last price on the curve of the pool
+		lastPrice := ammswap.GetSpotPrice(swapTokenPair, swapTokenPair.QuotePooledCoin.Denom)
 
 		// 24h volume and price
 		volume24h := sdk.ZeroDec()
@@ -121,7 +118,7 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		// calculate fee apy
 		feeApy := sdk.ZeroDec()
 		if liquidity.IsPositive() && liquidity.IsPositive() {
-			feeApy = volume24h.Mul(swapParams.FeeRate).Quo(liquidity).Mul(sdk.NewDec(365))
+			feeApy = volume24h.Mul(swapTokenPair.GetFeeRate(swapParams)).Quo(liquidity).Mul(sdk.NewDec(365))
 		}
 
 		// calculate price change
@@ -186,11 +183,7 @@ func calculateDollarAmount(ctx sdk.Context, keeper Keeper, baseAmount sdk.SysCoi
 	} else {
 		baseTokenPairName := ammswap.GetSwapTokenPairName(baseAmount.Denom, dollarQuoteToken)
 		if baseTokenPair, err := keeper.swapKeeper.GetSwapTokenPair(ctx, baseTokenPairName); err == nil {
-			if baseTokenPair.BasePooledCoin.Denom == dollarQuoteToken && baseTokenPair.QuotePooledCoin.Amount.IsPositive() {
-				baseTokenDollar = common.MulAndQuo(baseTokenPair.BasePooledCoin.Amount, baseAmount.Amount, baseTokenPair.QuotePooledCoin.Amount)
-			} else if baseTokenPair.BasePooledCoin.Amount.IsPositive() {
-				baseTokenDollar = common.MulAndQuo(baseTokenPair.QuotePooledCoin.Amount, baseAmount.Amount, baseTokenPair.BasePooledCoin.Amount)
-			}
+			baseTokenDollar = baseAmount.Amount.Mul(ammswap.GetSpotPrice(baseTokenPair, baseAmount.Denom))
 		}
 	}
 
@@ -199,11 +192,7 @@ func calculateDollarAmount(ctx sdk.Context, keeper Keeper, baseAmount sdk.SysCoi
 	} else {
 		quoteTokenPairName := ammswap.GetSwapTokenPairName(quoteAmount.Denom, dollarQuoteToken)
 		if quoteTokenPair, err := keeper.swapKeeper.GetSwapTokenPair(ctx, quoteTokenPairName); err == nil {
-			if quoteTokenPair.BasePooledCoin.Denom == dollarQuoteToken && quoteTokenPair.QuotePooledCoin.Amount.IsPositive() {
-				quoteTokenDollar = common.MulAndQuo(quoteTokenPair.BasePooledCoin.Amount, quoteAmount.Amount, quoteTokenPair.QuotePooledCoin.Amount)
-			} else if quoteTokenPair.BasePooledCoin.Amount.IsPositive() {
-				quoteTokenDollar = common.MulAndQuo(quoteTokenPair.QuotePooledCoin.Amount, quoteAmount.Amount, quoteTokenPair.BasePooledCoin.Amount)
-			}
+			quoteTokenDollar = quoteAmount.Amount.Mul(ammswap.GetSpotPrice(quoteTokenPair, quoteAmount.Denom))
 		}
 	}
 
@@ -13,6 +13,7 @@ type OrderMetric struct {
 	PendingNum       metrics.Gauge
 	CanceledNum      metrics.Gauge
 	ExpiredNum       metrics.Gauge
+	KilledNum        metrics.Gauge
 	PartialFilledNum metrics.Gauge
 }
 
@@ -56,6 +57,12 @@ func NewOrderMetrics(labelsAndValues ...string) *OrderMetric {
 			Name:      "expired",
 			Help:      "the number of expired order",
 		}, labels).With(labelsAndValues...),
+		KilledNum: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: xNameSpace,
+			Subsystem: orderSubSystem,
+			Name:      "killed",
+			Help:      "the number of killed order",
+		}, labels).With(labelsAndValues...),
 		PartialFilledNum: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: xNameSpace,
 			Subsystem: orderSubSystem,
@@ -72,6 +79,7 @@ func NopOrderMetrics() *OrderMetric {
 		PendingNum:       discard.NewGauge(),
 		CanceledNum:      discard.NewGauge(),
 		ExpiredNum:       discard.NewGauge(),
+		KilledNum:        discard.NewGauge(),
 		PartialFilledNum: discard.NewGauge(),
 	}
 }
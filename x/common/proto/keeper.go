@@ -2,26 +2,49 @@ package proto
 
 import (
 	"fmt"
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultUpgradeThreshold is the default threshold of an app-upgrade
+var DefaultUpgradeThreshold = sdk.NewDecWithPrec(9, 1)
+
 // ProtocolDefinition is the struct of app-upgrade detail info
 type ProtocolDefinition struct {
 	Version   uint64  `json:"version"`
 	Software  string  `json:"software"`
 	Height    uint64  `json:"height"`
 	Threshold sdk.Dec `json:"threshold"`
+	// name of the upgrade plan and the migration run at the height, the migration is looked up by the name if empty
+	Name          string `json:"name,omitempty"`
+	MigrationHook string `json:"migration_hook,omitempty"`
 }
 
 // NewProtocolDefinition creates a new instance of ProtocolDefinition
 func NewProtocolDefinition(version uint64, software string, height uint64, threshold sdk.Dec) ProtocolDefinition {
 	return ProtocolDefinition{
-		version,
-		software,
-		height,
-		threshold,
+		Version:   version,
+		Software:  software,
+		Height:    height,
+		Threshold: threshold,
+	}
+}
+
+// WithUpgradePlan sets the name and the migration hook of the upgrade plan
+func (pd ProtocolDefinition) WithUpgradePlan(name, migrationHook string) ProtocolDefinition {
+	pd.Name = name
+	pd.MigrationHook = migrationHook
+	return pd
+}
+
+// GetMigrationName returns the name of the migration run at the height of the upgrade
+func (pd ProtocolDefinition) GetMigrationName() string {
+	if len(pd.MigrationHook) != 0 {
+		return pd.MigrationHook
 	}
+	return pd.Name
 }
 
 // AppUpgradeConfig is the struct of app-upgrade-specific params
@@ -42,7 +65,7 @@ func NewAppUpgradeConfig(proposalID uint64, protocolDef ProtocolDefinition) AppU
 func DefaultUpgradeConfig(software string) AppUpgradeConfig {
 	return AppUpgradeConfig{
 		ProposalID:  uint64(0),
-		ProtocolDef: NewProtocolDefinition(uint64(0), software, uint64(1), sdk.NewDecWithPrec(9, 1)),
+		ProtocolDef: NewProtocolDefinition(uint64(0), software, uint64(1), DefaultUpgradeThreshold),
 	}
 }
 
@@ -52,15 +75,107 @@ type VersionKeeper interface {
 	GetUpgradeConfigByStore(store sdk.KVStore) (upgradeConfig AppUpgradeConfig, found bool)
 }
 
+// MigrationHandler is the in-process store migration run at the height of an app-upgrade
+type MigrationHandler func(ctx sdk.Context) error
+
 // ProtocolKeeper is designed for a protocol controller
 type ProtocolKeeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	migrations map[string]MigrationHandler
 }
 
 // NewProtocolKeeper creates a new instance of ProtocolKeeper
 func NewProtocolKeeper(key sdk.StoreKey) ProtocolKeeper {
-	return ProtocolKeeper{key, cdc}
+	return ProtocolKeeper{
+		storeKey:   key,
+		cdc:        cdc,
+		migrations: make(map[string]MigrationHandler),
+	}
+}
+
+// SetMigrationHandler registers the in-process store migration of an app-upgrade
+func (pk ProtocolKeeper) SetMigrationHandler(name string, handler MigrationHandler) {
+	if _, ok := pk.migrations[name]; ok {
+		panic(fmt.Sprintf("migration handler %s has already been registered", name))
+	}
+	pk.migrations[name] = handler
+}
+
+// HasMigrationHandler checks whether the in-process store migration of an app-upgrade is registered
+func (pk ProtocolKeeper) HasMigrationHandler(name string) bool {
+	_, ok := pk.migrations[name]
+	return ok
+}
+
+// ApplyUpgradeConfig applies the upgrade config when the height of it is reached. The registered migration is run
+// in-process, otherwise the node halts at the height to wait for the new binary with the migration. The node halts
+// too if the migration fails, so that it's never left running the new binary on the unmigrated state
+func (pk ProtocolKeeper) ApplyUpgradeConfig(ctx sdk.Context) {
+	upgradeConfig, found := pk.GetUpgradeConfig(ctx)
+	if !found || uint64(ctx.BlockHeight()) < upgradeConfig.ProtocolDef.Height {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "protocol")
+	protocolDef := upgradeConfig.ProtocolDef
+	if !pk.HasMigrationHandler(protocolDef.GetMigrationName()) {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d, software: %s",
+			protocolDef.Name, protocolDef.Height, protocolDef.Software)
+		logger.Error(msg)
+		panic(msg)
+	}
+
+	if err := pk.RunMigrations(ctx, protocolDef.GetMigrationName()); err != nil {
+		msg := fmt.Sprintf("UPGRADE %q FAILED at height %d: %s", protocolDef.Name, ctx.BlockHeight(), err)
+		logger.Error(msg)
+		panic(msg)
+	}
+	pk.SetCurrentVersion(ctx, protocolDef.Version)
+	logger.Info(fmt.Sprintf("upgrade %q applied at height %d, current version: %d",
+		protocolDef.Name, ctx.BlockHeight(), protocolDef.Version))
+	pk.ClearUpgradeConfig(ctx)
+}
+
+// RunMigrations runs the registered in-process store migrations in order, and records each of them as applied.
+// The migrations applied before are skipped, so that a migration run by several app-upgrades is applied only once
+func (pk ProtocolKeeper) RunMigrations(ctx sdk.Context, names ...string) error {
+	for _, name := range names {
+		if pk.IsMigrationApplied(ctx, name) {
+			continue
+		}
+		handler, ok := pk.migrations[name]
+		if !ok {
+			return fmt.Errorf("migration %s is not registered", name)
+		}
+		if err := handler(ctx); err != nil {
+			return fmt.Errorf("migration %s failed: %s", name, err)
+		}
+		pk.setMigrationApplied(ctx, name)
+	}
+	return nil
+}
+
+// SetMigrationsApplied records all the registered migrations as applied, since the state initialized from the
+// genesis of the software is never migrated
+func (pk ProtocolKeeper) SetMigrationsApplied(ctx sdk.Context) {
+	names := make([]string, 0, len(pk.migrations))
+	for name := range pk.migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pk.setMigrationApplied(ctx, name)
+	}
+}
+
+// IsMigrationApplied checks whether the in-process store migration has been applied to the state
+func (pk ProtocolKeeper) IsMigrationApplied(ctx sdk.Context, name string) bool {
+	return ctx.KVStore(pk.storeKey).Has(getAppliedMigrationKey(name))
+}
+
+func (pk ProtocolKeeper) setMigrationApplied(ctx sdk.Context, name string) {
+	ctx.KVStore(pk.storeKey).Set(getAppliedMigrationKey(name), []byte{1})
 }
 
 // GetCurrentVersionByStore gets the current version of protocol from store
@@ -147,6 +262,16 @@ func (pk ProtocolKeeper) IsValidVersion(ctx sdk.Context, version uint64) bool {
 	return isValidVersion(currentVersion, lastFailedVersion, version)
 }
 
+// GetNextVersion gets the version for the next app-upgrade
+func (pk ProtocolKeeper) GetNextVersion(ctx sdk.Context) uint64 {
+	currentVersion := pk.GetCurrentVersion(ctx)
+	lastFailedVersion := pk.GetLastFailedVersion(ctx)
+	if currentVersion >= lastFailedVersion {
+		return currentVersion + 1
+	}
+	return lastFailedVersion + 1
+}
+
 // rule: new version should be currentVersion+1 or lastFailedVersion or lastFailedVersion+1
 func isValidVersion(currentVersion uint64, lastFailedVersion uint64, version uint64) bool {
 	if currentVersion >= lastFailedVersion {
@@ -1,6 +1,7 @@
 package proto
 
 import (
+	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,3 +77,81 @@ func TestUpgradeConfigByStore(t *testing.T) {
 	_, found = keeper.GetUpgradeConfigByStore(store)
 	require.True(t, found)
 }
+
+func TestApplyUpgradeConfig(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	migratedKey := []byte("migrated")
+	require.Equal(t, uint64(1), keeper.GetNextVersion(ctx))
+
+	// nothing happens without the upgrade config
+	require.NotPanics(t, func() { keeper.ApplyUpgradeConfig(ctx) })
+
+	// the node halts at the upgrade height without the migration registered
+	protocolDef := NewProtocolDefinition(keeper.GetNextVersion(ctx), "binary info", 10,
+		DefaultUpgradeThreshold).WithUpgradePlan("v1", "")
+	keeper.SetUpgradeConfig(ctx, NewAppUpgradeConfig(1, protocolDef))
+	require.NotPanics(t, func() { keeper.ApplyUpgradeConfig(ctx.WithBlockHeight(9)) })
+	require.Panics(t, func() { keeper.ApplyUpgradeConfig(ctx.WithBlockHeight(10)) })
+
+	// the migration is run at the upgrade height by the new software
+	keeper.SetMigrationHandler("v1", func(ctx sdk.Context) error {
+		ctx.KVStore(keeper.storeKey).Set(migratedKey, []byte{1})
+		return nil
+	})
+	require.True(t, keeper.HasMigrationHandler("v1"))
+	require.Panics(t, func() { keeper.SetMigrationHandler("v1", nil) })
+	keeper.ApplyUpgradeConfig(ctx.WithBlockHeight(10))
+	require.Equal(t, uint64(1), keeper.GetCurrentVersion(ctx))
+	require.True(t, ctx.KVStore(keeper.storeKey).Has(migratedKey))
+	require.True(t, keeper.IsMigrationApplied(ctx, "v1"))
+	_, found := keeper.GetUpgradeConfig(ctx)
+	require.False(t, found)
+
+	// the node halts if the migration fails, and the upgrade is retried after the restart
+	protocolDef = NewProtocolDefinition(keeper.GetNextVersion(ctx), "binary info", 20,
+		DefaultUpgradeThreshold).WithUpgradePlan("v2", "v2-migration")
+	require.Equal(t, "v2-migration", protocolDef.GetMigrationName())
+	keeper.SetUpgradeConfig(ctx, NewAppUpgradeConfig(2, protocolDef))
+	keeper.SetMigrationHandler("v2-migration", func(ctx sdk.Context) error {
+		ctx.KVStore(keeper.storeKey).Delete(migratedKey)
+		return errors.New("migration failed")
+	})
+	cacheCtx, _ := ctx.CacheContext()
+	require.Panics(t, func() { keeper.ApplyUpgradeConfig(cacheCtx.WithBlockHeight(20)) })
+	require.Equal(t, uint64(1), keeper.GetCurrentVersion(ctx))
+	require.True(t, ctx.KVStore(keeper.storeKey).Has(migratedKey))
+	_, found = keeper.GetUpgradeConfig(ctx)
+	require.True(t, found)
+}
+
+func TestRunMigrations(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	runs := make(map[string]int)
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		keeper.SetMigrationHandler(name, func(ctx sdk.Context) error {
+			runs[name]++
+			return nil
+		})
+	}
+	keeper.SetMigrationHandler("all", func(ctx sdk.Context) error {
+		return keeper.RunMigrations(ctx, "a", "b", "c")
+	})
+
+	// the migrations applied are skipped
+	require.Nil(t, keeper.RunMigrations(ctx, "a"))
+	require.Nil(t, keeper.RunMigrations(ctx, "all"))
+	require.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, runs)
+	require.True(t, keeper.IsMigrationApplied(ctx, "all"))
+
+	// the migrations unregistered are never recorded as applied
+	require.Error(t, keeper.RunMigrations(ctx, "d"))
+	require.False(t, keeper.IsMigrationApplied(ctx, "d"))
+
+	// the state initialized from the genesis has all the migrations applied
+	ctx, keeper = createTestInput(t)
+	keeper.SetMigrationHandler("a", func(ctx sdk.Context) error { return nil })
+	require.False(t, keeper.IsMigrationApplied(ctx, "a"))
+	keeper.SetMigrationsApplied(ctx)
+	require.True(t, keeper.IsMigrationApplied(ctx, "a"))
+}
@@ -10,4 +10,10 @@ var (
 	currentVersionKey    = []byte("current_version")
 	lastFailedVersionKey = []byte("last_failed_version")
 	cdc                  = codec.New()
+
+	appliedMigrationKeyPrefix = []byte("applied_migration/")
 )
+
+func getAppliedMigrationKey(name string) []byte {
+	return append(appliedMigrationKeyPrefix, []byte(name)...)
+}
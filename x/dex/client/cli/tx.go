@@ -291,6 +291,58 @@ Where proposal.json contains:
 
 }
 
+// GetCmdSubmitTradingRulesProposal implements a command handler for submitting a dex trading rules proposal transaction
+func GetCmdSubmitTradingRulesProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trading-rules-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to change the tick size and lot size of a token pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a dex trading rules proposal along with an initial deposit.
+The proposal could be submitted by the owner of the token pair or a validator.
+The order price must be a multiple of the tick size, and the order quantity must be a multiple of the lot size.
+Setting them to zero removes the limit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal trading-rules-proposal <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "coarser ticks of xxx/%s",
+ "description": "concentrate the depth of an illiquid pair",
+ "product": "xxx_%s",
+ "tick_size": "0.01",
+ "lot_size": "1",
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := dexUtils.ParseTradingRulesProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTradingRulesProposal(proposal.Title, proposal.Description, from, proposal.Product,
+				proposal.TickSize, proposal.LotSize)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func getCmdRegisterOperator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-operator",
@@ -10,4 +10,7 @@ import (
 var (
 	// DelistProposalHandler alias gov NewProposalHandler
 	DelistProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDelistProposal, rest.DelistProposalRESTHandler)
+	// TradingRulesProposalHandler alias gov NewProposalHandler
+	TradingRulesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTradingRulesProposal,
+		rest.TradingRulesProposalRESTHandler)
 )
@@ -188,3 +188,8 @@ func operatorsHandler(cliContext context.CLIContext) func(http.ResponseWriter, *
 func DelistProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
 	return govRest.ProposalRESTHandler{}
 }
+
+// TradingRulesProposalRESTHandler defines dex trading rules proposal handler
+func TradingRulesProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -30,3 +30,29 @@ func ParseDelistProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposa
 
 	return proposal, nil
 }
+
+// TradingRulesProposalJSON defines a TradingRulesProposal with a deposit used
+// to parse trading rules proposals from a JSON file.
+type TradingRulesProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Product     string       `json:"product" yaml:"product"`
+	TickSize    sdk.Dec      `json:"tick_size" yaml:"tick_size"`
+	LotSize     sdk.Dec      `json:"lot_size" yaml:"lot_size"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseTradingRulesProposalJSON parse json from proposal file to TradingRulesProposalJSON struct
+func ParseTradingRulesProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposal TradingRulesProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -93,6 +93,8 @@ func handleMsgList(ctx sdk.Context, keeper IKeeper, msg MsgList, logger log.Logg
 		Delisting:        false,
 		Deposits:         DefaultTokenPairDeposit,
 		BlockHeight:      ctx.BlockHeight(),
+		TickSize:         sdk.ZeroDec(),
+		LotSize:          sdk.ZeroDec(),
 	}
 
 	// check whether a specific token pair exists with the symbols of base asset and quote asset
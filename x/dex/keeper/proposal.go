@@ -11,9 +11,10 @@ import (
 	govTypes "github.com/okex/okexchain/x/gov/types"
 )
 
-// GetMinDeposit returns min deposit
+// GetMinDeposit returns min deposit, the trading rules proposal shares the deposit params with the delist proposal
 func (k Keeper) GetMinDeposit(ctx sdk.Context, content gov.Content) (minDeposit sdk.SysCoins) {
-	if _, ok := content.(types.DelistProposal); ok {
+	switch content.(type) {
+	case types.DelistProposal, types.TradingRulesProposal:
 		minDeposit = k.GetParams(ctx).DelistMinDeposit
 	}
 	return
@@ -21,7 +22,8 @@ func (k Keeper) GetMinDeposit(ctx sdk.Context, content gov.Content) (minDeposit
 
 // GetMaxDepositPeriod returns max deposit period
 func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content gov.Content) (maxDepositPeriod time.Duration) {
-	if _, ok := content.(types.DelistProposal); ok {
+	switch content.(type) {
+	case types.DelistProposal, types.TradingRulesProposal:
 		maxDepositPeriod = k.GetParams(ctx).DelistMaxDepositPeriod
 	}
 	return
@@ -29,7 +31,8 @@ func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content gov.Content) (maxDe
 
 // GetVotingPeriod returns voting period
 func (k Keeper) GetVotingPeriod(ctx sdk.Context, content gov.Content) (votingPeriod time.Duration) {
-	if _, ok := content.(types.DelistProposal); ok {
+	switch content.(type) {
+	case types.DelistProposal, types.TradingRulesProposal:
 		votingPeriod = k.GetParams(ctx).DelistVotingPeriod
 	}
 	return
@@ -69,11 +72,55 @@ func (k Keeper) checkMsgDelistProposal(ctx sdk.Context, delistProposal types.Del
 	return nil
 }
 
+// checkMsgTradingRulesProposal checks the trading rules proposal, which could be submitted by
+// the owner of the token pair or a validator
+func (k Keeper) checkMsgTradingRulesProposal(ctx sdk.Context, proposal types.TradingRulesProposal,
+	proposer sdk.AccAddress, initialDeposit sdk.SysCoins) sdk.Error {
+	// check the propose of the msg is equal the proposer in proposal content
+	if !proposer.Equals(proposal.Proposer) {
+		return gov.ErrInvalidProposer()
+	}
+
+	tokenPair := k.GetTokenPair(ctx, proposal.Product)
+	if tokenPair == nil {
+		return types.ErrTokenPairNotFound(proposal.Product)
+	}
+
+	// check the proposer of the msg is the owner of token pair or a validator
+	if !tokenPair.Owner.Equals(proposer) && !k.stakingKeeper.IsValidator(ctx, proposer) {
+		return gov.ErrInvalidProposer()
+	}
+
+	// check the tick size and lot size are within the digits of token pair
+	if !proposal.TickSize.RoundDecimal(tokenPair.MaxPriceDigit).Equal(proposal.TickSize) {
+		return types.ErrInvalidTickSize(proposal.TickSize.String())
+	}
+	if !proposal.LotSize.RoundDecimal(tokenPair.MaxQuantityDigit).Equal(proposal.LotSize) {
+		return types.ErrInvalidLotSize(proposal.LotSize.String())
+	}
+
+	// check the initial deposit
+	localMinDeposit := k.GetParams(ctx).DelistMinDeposit.MulDec(sdk.NewDecWithPrec(1, 1))
+	err := common.HasSufficientCoins(proposer, initialDeposit, localMinDeposit)
+	if err != nil {
+		return types.ErrInvalidAsset(localMinDeposit.String())
+	}
+
+	// check whether the proposer can afford the initial deposit
+	err = common.HasSufficientCoins(proposer, k.bankKeeper.GetCoins(ctx, proposer), initialDeposit)
+	if err != nil {
+		return types.ErrBalanceNotEnough(proposer.String(), initialDeposit.String())
+	}
+	return nil
+}
+
 // CheckMsgSubmitProposal validates MsgSubmitProposal
 func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) (sdkErr sdk.Error) {
 	switch content := msg.Content.(type) {
 	case types.DelistProposal:
 		sdkErr = k.checkMsgDelistProposal(ctx, content, msg.Proposer, msg.InitialDeposit)
+	case types.TradingRulesProposal:
+		sdkErr = k.checkMsgTradingRulesProposal(ctx, content, msg.Proposer, msg.InitialDeposit)
 	default:
 		errContent := fmt.Sprintf("unrecognized dex proposal content type: %T", content)
 		sdkErr = sdk.ErrUnknownRequest(errContent)
@@ -90,6 +90,39 @@ func TestKeeper_CheckMsgSubmitProposal(t *testing.T) {
 
 }
 
+func TestKeeper_CheckTradingRulesProposal(t *testing.T) {
+	testInput := createTestInputWithBalance(t, 1, 10000)
+	ctx := testInput.Ctx
+
+	testInput.DexKeeper.SetParams(ctx, *types.DefaultParams())
+	tokenPair := GetBuiltInTokenPair()
+	deposit := sdk.SysCoins{sdk.NewDecCoin(common.NativeToken, sdk.NewInt(150))}
+
+	content := types.NewTradingRulesProposal("trading rules of xxb_okb", "coarser ticks", tokenPair.Owner,
+		tokenPair.Name(), sdk.MustNewDecFromStr("0.01"), sdk.OneDec())
+	proposal := govTypes.NewMsgSubmitProposal(content, deposit, tokenPair.Owner)
+
+	// error case : fail to check proposal because product(token pair) not exist
+	require.Error(t, testInput.DexKeeper.CheckMsgSubmitProposal(ctx, proposal))
+	require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, tokenPair))
+
+	// successful case : check proposal successfully
+	require.NoError(t, testInput.DexKeeper.CheckMsgSubmitProposal(ctx, proposal))
+
+	// error case : the tick size is more precise than the max price digit of token pair
+	content.TickSize = sdk.MustNewDecFromStr("0.000000001")
+	proposal = govTypes.NewMsgSubmitProposal(content, deposit, tokenPair.Owner)
+	require.Error(t, testInput.DexKeeper.CheckMsgSubmitProposal(ctx, proposal))
+
+	// error case : the proposer in content is different from the proposer of msg
+	content.TickSize = sdk.MustNewDecFromStr("0.01")
+	proposal = govTypes.NewMsgSubmitProposal(content, deposit, testInput.TestAddrs[0])
+	require.Error(t, testInput.DexKeeper.CheckMsgSubmitProposal(ctx, proposal))
+
+	// the trading rules proposal shares the deposit params with the delist proposal
+	require.True(t, testInput.DexKeeper.GetMinDeposit(ctx, content).IsEqual(types.DefaultParams().DelistMinDeposit))
+}
+
 func TestKeeper_RejectedHandler(t *testing.T) {
 	testInput := createTestInputWithBalance(t, 1, 10000)
 	ctx := testInput.Ctx
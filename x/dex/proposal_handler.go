@@ -15,6 +15,8 @@ func NewProposalHandler(k *Keeper) govTypes.Handler {
 		switch c := proposal.Content.(type) {
 		case types.DelistProposal:
 			return handleDelistProposal(ctx, k, proposal)
+		case types.TradingRulesProposal:
+			return handleTradingRulesProposal(ctx, k, c)
 		default:
 			return common.ErrUnknownProposalType(DefaultCodespace, fmt.Sprintf("%T", c))
 		}
@@ -54,3 +56,23 @@ func handleDelistProposal(ctx sdk.Context, keeper *Keeper, proposal *govTypes.Pr
 		))
 	return nil
 }
+
+func handleTradingRulesProposal(ctx sdk.Context, keeper *Keeper, p types.TradingRulesProposal) (err sdk.Error) {
+	tokenPair := keeper.GetTokenPair(ctx, p.Product)
+	if tokenPair == nil {
+		return ErrTokenPairNotFound(p.Product)
+	}
+
+	tokenPair.TickSize = p.TickSize
+	tokenPair.LotSize = p.LotSize
+	keeper.UpdateTokenPair(ctx, p.Product, tokenPair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute("token-pair-updated", p.Product),
+			sdk.NewAttribute("tick-size", p.TickSize.String()),
+			sdk.NewAttribute("lot-size", p.LotSize.String()),
+		))
+	return nil
+}
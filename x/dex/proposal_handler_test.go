@@ -67,3 +67,32 @@ func TestProposal_NewProposalHandler(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestProposal_TradingRulesProposal(t *testing.T) {
+	fakeTokenKeeper := newMockTokenKeeper()
+	fakeSupplyKeeper := newMockSupplyKeeper()
+
+	mApp, mDexKeeper, err := newMockApp(fakeTokenKeeper, fakeSupplyKeeper, 10)
+	require.True(t, err == nil)
+
+	mApp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposalHandler := NewProposalHandler(mDexKeeper.Keeper)
+	tokenPair := GetBuiltInTokenPair()
+	content := types.NewTradingRulesProposal("trading rules of xxb_okb", "coarser ticks", tokenPair.Owner,
+		tokenPair.Name(), sdk.MustNewDecFromStr("0.01"), sdk.OneDec())
+	proposal := govTypes.Proposal{Content: content}
+
+	// error case : fail to handle proposal because product(token pair) not exist
+	err = proposalHandler(ctx, &proposal)
+	require.Error(t, err)
+
+	// successful case : the tick size and lot size of token pair are updated
+	require.Nil(t, mApp.dexKeeper.SaveTokenPair(ctx, tokenPair))
+	err = proposalHandler(ctx, &proposal)
+	require.Nil(t, err)
+	updated := mDexKeeper.GetTokenPair(ctx, tokenPair.Name())
+	require.Equal(t, content.TickSize, updated.TickSize)
+	require.Equal(t, content.LotSize, updated.LotSize)
+}
@@ -43,6 +43,8 @@ const (
 	CodeIsTransferringOwner         uint32 = 64031
 	CodeTransferOwnerExpired        uint32 = 64032
 	CodeUnauthorizedOperator        uint32 = 64033
+	CodeInvalidTickSize             uint32 = 64034
+	CodeInvalidLotSize              uint32 = 64035
 )
 
 // Addr and Product All Required
@@ -186,3 +188,11 @@ func ErrIsTransferOwnerExpired(time string) sdk.EnvelopedErr {
 func ErrUnauthorizedOperator(operator, owner string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedOperator, fmt.Sprintf("%s is not the owner of operator(%s)", owner, operator))}
 }
+
+func ErrInvalidTickSize(tickSize string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTickSize, fmt.Sprintf("invalid tick size: %s, should be non-negative and within the max price digit", tickSize))}
+}
+
+func ErrInvalidLotSize(lotSize string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidLotSize, fmt.Sprintf("invalid lot size: %s, should be non-negative and within the max size digit", lotSize))}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,8 @@ type TokenPair struct {
 	Owner            sdk.AccAddress `json:"owner"`
 	Deposits         sdk.SysCoin    `json:"deposits"`
 	BlockHeight      int64          `json:"block_height"`
+	TickSize         sdk.Dec        `json:"tick_size"` // the price must be a multiple of it, zero means no limit
+	LotSize          sdk.Dec        `json:"lot_size"`  // the quantity must be a multiple of it, zero means no limit
 }
 
 // Name returns name of token pair
@@ -30,6 +33,24 @@ func (tp *TokenPair) Name() string {
 	return fmt.Sprintf("%s_%s", tp.BaseAssetSymbol, tp.QuoteAssetSymbol)
 }
 
+// IsValidTick returns whether the price is a multiple of the tick size of token pair
+func (tp *TokenPair) IsValidTick(price sdk.Dec) bool {
+	return isMultipleOf(price, tp.TickSize)
+}
+
+// IsValidLot returns whether the quantity is a multiple of the lot size of token pair
+func (tp *TokenPair) IsValidLot(quantity sdk.Dec) bool {
+	return isMultipleOf(quantity, tp.LotSize)
+}
+
+// isMultipleOf returns true if the step is not set, or the value is an integral multiple of the step
+func isMultipleOf(value, step sdk.Dec) bool {
+	if step.IsNil() || !step.IsPositive() {
+		return true
+	}
+	return new(big.Int).Rem(value.BigInt(), step.BigInt()).Sign() == 0
+}
+
 // IsGT returns true if the token pair is greater than the other one
 // 1. compare deposits
 // 2. compare block height
@@ -9,13 +9,15 @@ import (
 )
 
 const (
-	proposalTypeDelist = "Delist"
+	proposalTypeDelist       = "Delist"
+	proposalTypeTradingRules = "TradingRules"
 )
 
 func init() {
 	govtypes.RegisterProposalType(proposalTypeDelist)
 	govtypes.RegisterProposalTypeCodec(DelistProposal{}, "okexchain/dex/DelistProposal")
-
+	govtypes.RegisterProposalType(proposalTypeTradingRules)
+	govtypes.RegisterProposalTypeCodec(TradingRulesProposal{}, "okexchain/dex/TradingRulesProposal")
 }
 
 // Assert DelistProposal implements govtypes.Content at compile-time
@@ -107,3 +109,105 @@ func (drp DelistProposal) String() string {
 		drp.BaseAsset, drp.QuoteAsset,
 	)
 }
+
+// Assert TradingRulesProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = (*TradingRulesProposal)(nil)
+
+// TradingRulesProposal represents the proposal object to change the tick size and lot size of a token pair
+type TradingRulesProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Product     string         `json:"product" yaml:"product"`
+	TickSize    sdk.Dec        `json:"tick_size" yaml:"tick_size"`
+	LotSize     sdk.Dec        `json:"lot_size" yaml:"lot_size"`
+}
+
+// NewTradingRulesProposal creates a new trading rules proposal object
+func NewTradingRulesProposal(title, description string, proposer sdk.AccAddress, product string,
+	tickSize, lotSize sdk.Dec) TradingRulesProposal {
+	return TradingRulesProposal{
+		Title:       title,
+		Description: description,
+		Proposer:    proposer,
+		Product:     product,
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+	}
+}
+
+// GetTitle returns title of trading rules proposal object
+func (trp TradingRulesProposal) GetTitle() string {
+	return trp.Title
+}
+
+// GetDescription returns description of trading rules proposal object
+func (trp TradingRulesProposal) GetDescription() string {
+	return trp.Description
+}
+
+// ProposalRoute returns route key of trading rules proposal object
+func (TradingRulesProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of trading rules proposal object
+func (TradingRulesProposal) ProposalType() string {
+	return proposalTypeTradingRules
+}
+
+// ValidateBasic validates trading rules proposal
+func (trp TradingRulesProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(trp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(trp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the max")
+	}
+
+	if len(trp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(trp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the max")
+	}
+
+	if trp.ProposalType() != proposalTypeTradingRules {
+		return govtypes.ErrInvalidProposalType(trp.ProposalType())
+	}
+
+	if trp.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(trp.Proposer.String())
+	}
+
+	if len(strings.Split(trp.Product, "_")) != 2 {
+		return ErrTokenPairNotFound(trp.Product)
+	}
+
+	if trp.TickSize.IsNil() || trp.TickSize.IsNegative() {
+		return ErrInvalidTickSize(trp.TickSize.String())
+	}
+
+	if trp.LotSize.IsNil() || trp.LotSize.IsNegative() {
+		return ErrInvalidLotSize(trp.LotSize.String())
+	}
+
+	return nil
+}
+
+// String converts trading rules proposal object to string
+func (trp TradingRulesProposal) String() string {
+	return fmt.Sprintf(`TradingRulesProposal:
+ Title:               %s
+ Description:         %s
+ Type:                %s
+ Proposer:            %s
+ Product:             %s
+ TickSize:            %s
+ LotSize:             %s
+`, trp.Title, trp.Description,
+		trp.ProposalType(), trp.Proposer,
+		trp.Product, trp.TickSize, trp.LotSize,
+	)
+}
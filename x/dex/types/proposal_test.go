@@ -57,3 +57,54 @@ func getLongString(n int) (s string) {
 	fmt.Println(len(s))
 	return s
 }
+
+func TestTradingRulesProposal_ValidateBasic(t *testing.T) {
+	common.InitConfig()
+	addr, err := sdk.AccAddressFromBech32(TestTokenPairOwner)
+	require.Nil(t, err)
+
+	tickSize, lotSize := sdk.MustNewDecFromStr("0.01"), sdk.OneDec()
+	proposal := NewTradingRulesProposal("proposal", "right trading rules proposal", addr, "eth_btc", tickSize, lotSize)
+	require.Equal(t, "proposal", proposal.GetTitle())
+	require.Equal(t, "right trading rules proposal", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeTradingRules, proposal.ProposalType())
+
+	tests := []struct {
+		name   string
+		trp    TradingRulesProposal
+		result bool
+	}{
+		{"trading-rules-proposal", proposal, true},
+		{"no-limit", TradingRulesProposal{"proposal", "trading rules proposal", addr, "eth_btc", sdk.ZeroDec(), sdk.ZeroDec()}, true},
+
+		{"no-title", TradingRulesProposal{"", "trading rules proposal", addr, "eth_btc", tickSize, lotSize}, false},
+		{"no-description", TradingRulesProposal{"proposal", "", addr, "eth_btc", tickSize, lotSize}, false},
+		{"no-proposer", TradingRulesProposal{"proposal", "trading rules proposal", nil, "eth_btc", tickSize, lotSize}, false},
+		{"no-product", TradingRulesProposal{"proposal", "trading rules proposal", addr, "eth", tickSize, lotSize}, false},
+		{"negative-tick-size", TradingRulesProposal{"proposal", "trading rules proposal", addr, "eth_btc", tickSize.Neg(), lotSize}, false},
+		{"nil-lot-size", TradingRulesProposal{"proposal", "trading rules proposal", addr, "eth_btc", tickSize, sdk.Dec{}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result {
+				require.Nil(t, tt.trp.ValidateBasic(), "test: %v", tt.name)
+			} else {
+				require.NotNil(t, tt.trp.ValidateBasic(), "test: %v", tt.name)
+			}
+		})
+	}
+}
+
+func TestTokenPair_IsValidTickAndLot(t *testing.T) {
+	tokenPair := TokenPair{}
+	require.True(t, tokenPair.IsValidTick(sdk.MustNewDecFromStr("1.2345")))
+	require.True(t, tokenPair.IsValidLot(sdk.MustNewDecFromStr("1.2345")))
+
+	tokenPair.TickSize = sdk.MustNewDecFromStr("0.05")
+	tokenPair.LotSize = sdk.MustNewDecFromStr("10")
+	require.True(t, tokenPair.IsValidTick(sdk.MustNewDecFromStr("1.25")))
+	require.False(t, tokenPair.IsValidTick(sdk.MustNewDecFromStr("1.26")))
+	require.True(t, tokenPair.IsValidLot(sdk.MustNewDecFromStr("30")))
+	require.False(t, tokenPair.IsValidLot(sdk.MustNewDecFromStr("35")))
+}
@@ -20,6 +20,7 @@ const (
 	QueryParams                 = types.QueryParams
 	QueryValidatorCommission    = types.QueryValidatorCommission
 	QueryWithdrawAddr           = types.QueryWithdrawAddr
+	QueryDelegationRewards      = types.QueryDelegationRewards
 	ParamWithdrawAddrEnabled    = types.ParamWithdrawAddrEnabled
 	DefaultParamspace           = types.DefaultParamspace
 )
@@ -43,6 +44,8 @@ var (
 	ValidateGenesis                          = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                 = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawValidatorCommission        = types.NewMsgWithdrawValidatorCommission
+	NewMsgWithdrawDelegatorReward            = types.NewMsgWithdrawDelegatorReward
+	NewQueryDelegationRewardsParams          = types.NewQueryDelegationRewardsParams
 	NewQueryValidatorCommissionParams        = types.NewQueryValidatorCommissionParams
 	NewQueryDelegatorWithdrawAddrParams      = types.NewQueryDelegatorWithdrawAddrParams
 	InitialValidatorAccumulatedCommission    = types.InitialValidatorAccumulatedCommission
@@ -71,6 +74,8 @@ type (
 	GenesisState                         = types.GenesisState
 	MsgSetWithdrawAddress                = types.MsgSetWithdrawAddress
 	MsgWithdrawValidatorCommission       = types.MsgWithdrawValidatorCommission
+	MsgWithdrawDelegatorReward           = types.MsgWithdrawDelegatorReward
+	DelegatorStartingInfo                = types.DelegatorStartingInfo
 	QueryValidatorCommissionParams       = types.QueryValidatorCommissionParams
 	QueryDelegatorWithdrawAddrParams     = types.QueryDelegatorWithdrawAddrParams
 	ValidatorAccumulatedCommission       = types.ValidatorAccumulatedCommission
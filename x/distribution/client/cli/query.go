@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -120,3 +121,60 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorRewards implements the query delegator rewards command.
+func GetCmdQueryDelegatorRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [delegator-addr] [validator-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the rewards of a delegator on all the validators or a specific validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards of the shares a delegator added to all the validators or a specific validator.
+
+Example:
+$ %s query distr rewards okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+$ %s query distr rewards okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0 okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// query for the rewards on a specific validator
+			if len(args) == 2 {
+				valAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+
+				res, err := common.QueryDelegationRewards(cliCtx, queryRoute, delAddr, valAddr)
+				if err != nil {
+					return err
+				}
+
+				var result sdk.SysCoins
+				if err := cdc.UnmarshalJSON(res, &result); err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(result)
+			}
+
+			res, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorTotalRewardsResponse
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
@@ -34,6 +34,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	distTxCmd.AddCommand(flags.PostCommands(
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
+		GetCmdWithdrawDelegationRewards(cdc),
 	)...)
 
 	return distTxCmd
@@ -104,6 +105,37 @@ $ %s tx distr withdraw-rewards okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lc
 	return cmd
 }
 
+// GetCmdWithdrawDelegationRewards command to withdraw the rewards of a delegator from a validator
+func GetCmdWithdrawDelegationRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-delegation-rewards [validator-addr]",
+		Short: "withdraw the rewards of the shares added to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the shares the delegator added to a validator.
+
+Example:
+$ %s tx distr withdraw-delegation-rewards okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDelegatorReward(cliCtx.GetFromAddress(), valAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -50,3 +50,23 @@ func WithdrawValidatorRewardsAndCommission(validatorAddr sdk.ValAddress) ([]sdk.
 
 	return []sdk.Msg{commissionMsg}, nil
 }
+
+// QueryDelegationRewards returns the rewards of a delegator on a validator
+func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute string, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delAddr, valAddr)),
+	)
+	return res, err
+}
+
+// QueryDelegatorTotalRewards returns the rewards of a delegator on all the validators it added shares to
+func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute string, delAddr sdk.AccAddress) (
+	[]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorTotalRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delAddr)),
+	)
+	return res, err
+}
@@ -24,6 +24,19 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		keeper.SetValidatorAccumulatedCommission(ctx, acc.ValidatorAddress, acc.Accumulated)
 		moduleHoldings = moduleHoldings.Add(acc.Accumulated...)
 	}
+	for _, rew := range data.OutstandingRewards {
+		keeper.SetValidatorOutstandingRewards(ctx, rew.ValidatorAddress, rew.OutstandingRewards)
+		moduleHoldings = moduleHoldings.Add(rew.OutstandingRewards...)
+	}
+	for _, his := range data.ValidatorHistoricalRewards {
+		keeper.SetValidatorHistoricalRewards(ctx, his.ValidatorAddress, his.Period, his.Rewards)
+	}
+	for _, cur := range data.ValidatorCurrentRewards {
+		keeper.SetValidatorCurrentRewards(ctx, cur.ValidatorAddress, cur.Rewards)
+	}
+	for _, del := range data.DelegatorStartingInfos {
+		keeper.SetDelegatorStartingInfo(ctx, del.ValidatorAddress, del.DelegatorAddress, del.StartingInfo)
+	}
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 
 	// check if the module account exists
@@ -64,5 +77,49 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, acc)
+	genesisState := types.NewGenesisState(params, feePool, dwi, pp, acc)
+	keeper.IterateValidatorOutstandingRewards(ctx,
+		func(addr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+			genesisState.OutstandingRewards = append(genesisState.OutstandingRewards,
+				types.ValidatorOutstandingRewardsRecord{
+					ValidatorAddress:   addr,
+					OutstandingRewards: rewards,
+				})
+			return false
+		},
+	)
+	keeper.IterateValidatorHistoricalRewards(ctx,
+		func(addr sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+			genesisState.ValidatorHistoricalRewards = append(genesisState.ValidatorHistoricalRewards,
+				types.ValidatorHistoricalRewardsRecord{
+					ValidatorAddress: addr,
+					Period:           period,
+					Rewards:          rewards,
+				})
+			return false
+		},
+	)
+	keeper.IterateValidatorCurrentRewards(ctx,
+		func(addr sdk.ValAddress, rewards types.ValidatorCurrentRewards) (stop bool) {
+			genesisState.ValidatorCurrentRewards = append(genesisState.ValidatorCurrentRewards,
+				types.ValidatorCurrentRewardsRecord{
+					ValidatorAddress: addr,
+					Rewards:          rewards,
+				})
+			return false
+		},
+	)
+	keeper.IterateDelegatorStartingInfos(ctx,
+		func(val sdk.ValAddress, del sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool) {
+			genesisState.DelegatorStartingInfos = append(genesisState.DelegatorStartingInfos,
+				types.DelegatorStartingInfoRecord{
+					DelegatorAddress: del,
+					ValidatorAddress: val,
+					StartingInfo:     info,
+				})
+			return false
+		},
+	)
+
+	return genesisState
 }
@@ -145,16 +145,42 @@ func (k Keeper) allocateByShares(ctx sdk.Context, rewards sdk.SysCoins) sdk.SysC
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commissions
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val exported.ValidatorI, tokens sdk.SysCoins) {
 	// split tokens between validator and delegators according to commissions
-	// commissions is always 1.0, so tokens.MulDec(val.GetCommission()) = tokens
-	// only update current commissions
-	commission := k.GetValidatorAccumulatedCommission(ctx, val.GetOperator())
-	commission = commission.Add(tokens...)
-	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), commission)
+	// the delegators share the tokens out of the commission by the shares they added,
+	// and the part of the shares of msd goes to the commission
+	valAddr := val.GetOperator()
+	shared := tokens.Sub(tokens.MulDecTruncate(val.GetCommission()))
+	toDelegators := sdk.SysCoins{}
+	if delegatorShares := val.GetSharesAddedByDelegators(); delegatorShares.IsPositive() && !shared.IsZero() {
+		toDelegators = shared.MulDecTruncate(delegatorShares.QuoTruncate(val.GetDelegatorShares()))
+	}
+	commission := tokens.Sub(toDelegators)
+
+	// update current commissions
+	accumCommission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, accumCommission.Add(commission...))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+	if toDelegators.IsZero() {
+		return
+	}
+
+	// update current rewards and outstanding rewards of the delegators
+	k.checkValidatorRewards(ctx, valAddr)
+	currentRewards := k.GetValidatorCurrentRewards(ctx, valAddr)
+	currentRewards.Rewards = currentRewards.Rewards.Add(toDelegators...)
+	k.SetValidatorCurrentRewards(ctx, valAddr, currentRewards)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Add(toDelegators...))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, toDelegators.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
 		),
 	)
 }
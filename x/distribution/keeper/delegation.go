@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/distribution/types"
+	"github.com/okex/okexchain/x/staking/exported"
+)
+
+// initializeDelegation initializes the starting info of a delegator on a validator with the shares added
+func (k Keeper) initializeDelegation(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	shares, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr)
+	if !found || !shares.IsPositive() {
+		return
+	}
+
+	// period has already been incremented - we want to store the period ended by this delegation action
+	previousPeriod := k.GetValidatorCurrentRewards(ctx, valAddr).Period - 1
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, valAddr, previousPeriod)
+	k.SetDelegatorStartingInfo(ctx, valAddr, delAddr,
+		types.NewDelegatorStartingInfo(previousPeriod, shares, uint64(ctx.BlockHeight())))
+}
+
+// InitializeDelegations initializes the starting infos of the shares added before the delegator rewards, which
+// accrue the rewards from now on
+func (k Keeper) InitializeDelegations(ctx sdk.Context) {
+	type delegation struct {
+		delAddr sdk.AccAddress
+		valAddr sdk.ValAddress
+	}
+	var delegations []delegation
+	k.stakingKeeper.IterateShares(ctx, func(_ int64, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+		shares sdk.Dec) (stop bool) {
+		if shares.IsPositive() && !k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+			delegations = append(delegations, delegation{delAddr, valAddr})
+		}
+		return false
+	})
+
+	for _, del := range delegations {
+		val := k.stakingKeeper.Validator(ctx, del.valAddr)
+		if val == nil {
+			continue
+		}
+		// end the current period before each delegation as the hooks do, so that a historical rewards is
+		// referenced by a single delegator at most
+		k.IncrementValidatorPeriod(ctx, val)
+		k.initializeDelegation(ctx, del.valAddr, del.delAddr)
+	}
+}
+
+// calculateDelegationRewardsBetween calculates the rewards of the shares between two periods
+func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, valAddr sdk.ValAddress, startingPeriod,
+	endingPeriod uint64, shares sdk.Dec) (rewards sdk.SysCoins) {
+	// sanity check
+	if startingPeriod > endingPeriod {
+		panic("startingPeriod cannot be greater than endingPeriod")
+	}
+	if shares.IsNegative() {
+		panic("shares should not be negative")
+	}
+
+	// return shares * (ending - starting)
+	starting := k.GetValidatorHistoricalRewards(ctx, valAddr, startingPeriod)
+	ending := k.GetValidatorHistoricalRewards(ctx, valAddr, endingPeriod)
+	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+	// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+	return difference.MulDecTruncate(shares)
+}
+
+// calculateDelegationRewards calculates the rewards of a delegator on a validator up to the ending period
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress,
+	endingPeriod uint64) sdk.SysCoins {
+	startingInfo := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+	return k.calculateDelegationRewardsBetween(ctx, valAddr, startingInfo.PreviousPeriod, endingPeriod,
+		startingInfo.Shares)
+}
+
+// withdrawDelegationRewards settles the rewards of a delegator on a validator and sends them to the withdraw address,
+// the starting info of the delegator is removed and must be initialized again if the delegator keeps its shares
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, delAddr sdk.AccAddress) (
+	sdk.Coins, error) {
+	valAddr := val.GetOperator()
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	// end the current period and calculate the rewards
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	rewardsRaw := k.calculateDelegationRewards(ctx, valAddr, delAddr, endingPeriod)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+
+	// defensive edge case may happen on the very final digits of the decCoins due to operation order of the
+	// distribution mechanism
+	rewards := rewardsRaw.Intersect(outstanding)
+	if !rewards.IsEqual(rewardsRaw) {
+		k.Logger(ctx).Info(fmt.Sprintf("rounding error withdrawing rewards from validator %s, delegator %s, "+
+			"got %s, expected %s", valAddr, delAddr, rewards, rewardsRaw))
+	}
+
+	// truncate coins, return remainder to community pool
+	coins, remainder := rewards.TruncateDecimal()
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+		}
+	}
+
+	// update the outstanding rewards and the community pool
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Sub(rewards))
+	if !remainder.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+		k.SetFeePool(ctx, feePool)
+	}
+
+	// decrement the reference count of the starting period and remove the starting info
+	startingPeriod := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr).PreviousPeriod
+	k.decrementReferenceCount(ctx, valAddr, startingPeriod)
+	k.DeleteDelegatorStartingInfo(ctx, valAddr, delAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return coins, nil
+}
+
+// WithdrawDelegationRewards withdraws the rewards of a delegator on a validator
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.Coins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrEmptyValidatorDistInfo()
+	}
+	if _, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr); !found {
+		return nil, types.ErrNoDelegationShares(delAddr, valAddr)
+	}
+
+	// the delegator added shares before the delegator rewards starts to accrue from now on
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+		k.IncrementValidatorPeriod(ctx, val)
+		k.initializeDelegation(ctx, valAddr, delAddr)
+		return sdk.Coins{}, nil
+	}
+
+	rewards, err := k.withdrawDelegationRewards(ctx, val, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+	return rewards, nil
+}
+
+// CalculateDelegationRewards calculates the rewards of a delegator on a validator without any state change
+// outside the context, which is used for querying
+func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrEmptyValidatorDistInfo()
+	}
+	if !k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+		if _, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr); !found {
+			return nil, types.ErrNoDelegationShares(delAddr, valAddr)
+		}
+		return sdk.SysCoins{}, nil
+	}
+
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	return k.calculateDelegationRewards(ctx, valAddr, delAddr, endingPeriod), nil
+}
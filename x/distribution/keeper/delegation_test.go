@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/distribution/types"
+	"github.com/okex/okexchain/x/staking"
+)
+
+func TestDelegationRewards(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	h := staking.NewHandler(sk)
+
+	// the validator lowers the commission rate, the delegators share a half of the rewards out of the commission
+	ctx = ctx.WithBlockTime(time.Now())
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.Nil(t, err)
+
+	// the delegator adds shares to the validator, which initializes the starting info
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr1, []sdk.ValAddress{valOpAddr1}))
+	require.Nil(t, err)
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr1))
+
+	// allocate tokens to the validator and fund the module account with them
+	tokens := NewTestSysCoins(100, 0)
+	acc := ak.GetAccount(ctx, supplyKeeper.GetModuleAddress(types.ModuleName))
+	require.Nil(t, acc.SetCoins(tokens))
+	ak.SetAccount(ctx, acc)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valOpAddr1)
+	require.True(t, outstanding.IsAllPositive())
+	require.Equal(t, outstanding, k.GetValidatorCurrentRewards(ctx, valOpAddr1).Rewards)
+	commission := k.GetValidatorAccumulatedCommission(ctx, valOpAddr1)
+	require.Equal(t, tokens, commission.Add(outstanding...))
+	require.True(t, commission.IsAllGTE(NewTestSysCoins(50, 0)))
+
+	// the delegator adds shares after the allocation gets no rewards of it
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr2, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr2, []sdk.ValAddress{valOpAddr1}))
+	require.Nil(t, err)
+	cacheCtx, _ := ctx.CacheContext()
+	rewards, err := k.CalculateDelegationRewards(cacheCtx, delAddr2, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	// query the rewards of the first delegator
+	querier := NewQuerier(k)
+	bz, err := k.cdc.MarshalJSON(types.NewQueryDelegationRewardsParams(delAddr1, valOpAddr1))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QueryDelegationRewards}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var queried sdk.SysCoins
+	k.cdc.MustUnmarshalJSON(res, &queried)
+	require.True(t, queried.IsAllPositive())
+	require.True(t, outstanding.IsAllGTE(queried))
+
+	// withdraw the rewards, the integral part goes to the delegator and the starting info is reinitialized
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, withdrawn.IsAllPositive())
+	require.Equal(t, balance.Add(withdrawn...), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr1))
+
+	// nothing left to withdraw
+	rewards, err = k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the delegator without shares can't withdraw
+	_, err = k.WithdrawDelegationRewards(ctx, delAddr3, valOpAddr1)
+	require.NotNil(t, err)
+}
+
+func TestInitializeDelegations(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	h := staking.NewHandler(sk)
+	ctx = ctx.WithBlockTime(time.Now())
+	_, err := h(ctx, staking.NewMsgEditValidatorCommissionRate(valOpAddr1, sdk.NewDecWithPrec(5, 1)))
+	require.Nil(t, err)
+
+	// the delegators added shares before the delegator rewards have no starting infos
+	delAddrs := []sdk.AccAddress{delAddr1, delAddr2, delAddr3, delAddr4}
+	for _, delAddr := range delAddrs {
+		_, err = h(ctx, staking.NewMsgDeposit(delAddr, NewTestSysCoin(100, 0)))
+		require.Nil(t, err)
+		_, err = h(ctx, staking.NewMsgAddShares(delAddr, []sdk.ValAddress{valOpAddr1}))
+		require.Nil(t, err)
+	}
+	for _, delAddr := range delAddrs {
+		startingPeriod := k.GetDelegatorStartingInfo(ctx, valOpAddr1, delAddr).PreviousPeriod
+		k.decrementReferenceCount(ctx, valOpAddr1, startingPeriod)
+		k.DeleteDelegatorStartingInfo(ctx, valOpAddr1, delAddr)
+		require.False(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr))
+	}
+
+	// the delegators on the same validator are initialized without exceeding the reference counts
+	require.NotPanics(t, func() { k.InitializeDelegations(ctx) })
+	for _, delAddr := range delAddrs {
+		require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr))
+		startingPeriod := k.GetDelegatorStartingInfo(ctx, valOpAddr1, delAddr).PreviousPeriod
+		require.True(t, k.GetValidatorHistoricalRewards(ctx, valOpAddr1, startingPeriod).ReferenceCount <= 2)
+	}
+
+	// the delegators accrue the rewards allocated afterwards
+	tokens := NewTestSysCoins(100, 0)
+	acc := ak.GetAccount(ctx, supplyKeeper.GetModuleAddress(types.ModuleName))
+	require.Nil(t, acc.SetCoins(tokens))
+	ak.SetAccount(ctx, acc)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	for _, delAddr := range delAddrs {
+		rewards, err := k.CalculateDelegationRewards(ctx, delAddr, valOpAddr1)
+		require.Nil(t, err)
+		require.True(t, rewards.IsAllPositive())
+	}
+}
@@ -49,6 +49,55 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// remove commission record
 	h.k.deleteValidatorAccumulatedCommission(ctx, valAddr)
+
+	// the rewards left in the outstanding are the rounding dust of the delegators, send them to community pool
+	outstanding := h.k.GetValidatorOutstandingRewards(ctx, valAddr)
+	if !outstanding.IsZero() {
+		feePool := h.k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(outstanding...)
+		h.k.SetFeePool(ctx, feePool)
+	}
+
+	// remove the records of the delegator rewards
+	h.k.deleteValidatorOutstandingRewards(ctx, valAddr)
+	h.k.DeleteValidatorHistoricalRewards(ctx, valAddr)
+	h.k.deleteValidatorCurrentRewards(ctx, valAddr)
+	var delAddrs []sdk.AccAddress
+	h.k.IterateDelegatorStartingInfos(ctx,
+		func(val sdk.ValAddress, del sdk.AccAddress, _ types.DelegatorStartingInfo) (stop bool) {
+			if val.Equals(valAddr) {
+				delAddrs = append(delAddrs, del)
+			}
+			return false
+		})
+	for _, delAddr := range delAddrs {
+		h.k.DeleteDelegatorStartingInfo(ctx, valAddr, delAddr)
+	}
+}
+
+// BeforeDelegationSharesModified settles the rewards of the delegator on the validators before the shares are modified
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) {
+	for _, valAddr := range valAddrs {
+		val := h.k.stakingKeeper.Validator(ctx, valAddr)
+		if val == nil {
+			continue
+		}
+		if !h.k.HasDelegatorStartingInfo(ctx, valAddr, delAddr) {
+			// end the current period for the coming delegation
+			h.k.IncrementValidatorPeriod(ctx, val)
+			continue
+		}
+		if _, err := h.k.withdrawDelegationRewards(ctx, val, delAddr); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// AfterDelegationModified tracks the shares of the delegator on the validators after the shares are modified
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) {
+	for _, valAddr := range valAddrs {
+		h.k.initializeDelegation(ctx, valAddr, delAddr)
+	}
 }
 
 // AfterValidatorDestroyed nothing to do
@@ -107,3 +107,16 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 
 	return commission, nil
 }
+
+// FundCommunityPoolFromModule sends coins from the module account to the distribution module account
+// and adds them to the community pool
+func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.SysCoins, senderModule string) error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(amount...)
+	k.SetFeePool(ctx, feePool)
+	return nil
+}
@@ -25,6 +25,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryValidatorOutstandingRewards:
+			return queryValidatorOutstandingRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegationRewards:
+			return queryDelegationRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegatorTotalRewards:
+			return queryDelegatorTotalRewards(ctx, path[1:], req, k)
+
 		default:
 			return nil, types.ErrUnknownDistributionQueryType()
 		}
@@ -103,3 +112,78 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 
 	return bz, nil
 }
+
+func queryValidatorOutstandingRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorOutstandingRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	rewards := k.GetValidatorOutstandingRewards(ctx, params.ValidatorAddress)
+	if rewards == nil {
+		rewards = types.ValidatorOutstandingRewards{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegationRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+	rewards, err := k.CalculateDelegationRewards(ctx, params.DelegatorAddress, params.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+	delegator := k.stakingKeeper.Delegator(ctx, params.DelegatorAddress)
+	if delegator == nil {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	delRewards := make([]types.DelegationDelegatorReward, 0)
+	total := sdk.SysCoins{}
+	for _, valAddr := range delegator.GetShareAddedValidatorAddresses() {
+		rewards, err := k.CalculateDelegationRewards(ctx, params.DelegatorAddress, valAddr)
+		if err != nil {
+			continue
+		}
+		delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, rewards))
+		total = total.Add(rewards...)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryDelegatorTotalRewardsResponse(delRewards, total))
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
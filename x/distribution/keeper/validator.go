@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/okex/okexchain/x/distribution/types"
@@ -11,4 +13,83 @@ import (
 func (k Keeper) initializeValidator(ctx sdk.Context, val exported.ValidatorI) {
 	// set accumulated commissions
 	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), types.InitialValidatorAccumulatedCommission())
+	k.initializeValidatorRewards(ctx, val.GetOperator())
+}
+
+// initializeValidatorRewards initializes the records for the rewards of the delegators on a validator
+func (k Keeper) initializeValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	// set initial historical rewards (period 0) with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, valAddr, 0, types.NewValidatorHistoricalRewards(sdk.SysCoins{}, 1))
+	// set current rewards (starting at period 1)
+	k.SetValidatorCurrentRewards(ctx, valAddr, types.NewValidatorCurrentRewards(sdk.SysCoins{}, 1))
+	// set outstanding rewards
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{})
+}
+
+// checkValidatorRewards initializes the rewards records of a validator created before the delegator rewards
+func (k Keeper) checkValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	if k.GetValidatorCurrentRewards(ctx, valAddr).Period == 0 {
+		k.initializeValidatorRewards(ctx, valAddr)
+	}
+}
+
+// IncrementValidatorPeriod increments the period of a validator, returning the period just ended
+func (k Keeper) IncrementValidatorPeriod(ctx sdk.Context, val exported.ValidatorI) uint64 {
+	valAddr := val.GetOperator()
+	k.checkValidatorRewards(ctx, valAddr)
+	rewards := k.GetValidatorCurrentRewards(ctx, valAddr)
+
+	// calculate the current ratio
+	var current sdk.SysCoins
+	shares := val.GetSharesAddedByDelegators()
+	if !shares.IsPositive() {
+		// can't calculate the ratio without any shares of the delegators, the rewards go to the commission
+		if !rewards.Rewards.IsZero() {
+			outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+			k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Sub(rewards.Rewards))
+			commission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+			k.SetValidatorAccumulatedCommission(ctx, valAddr, commission.Add(rewards.Rewards...))
+		}
+		current = sdk.SysCoins{}
+	} else {
+		// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+		current = rewards.Rewards.QuoDecTruncate(shares)
+	}
+
+	// fetch the historical rewards for the last period
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, rewards.Period-1).CumulativeRewardRatio
+	// decrement the reference count
+	k.decrementReferenceCount(ctx, valAddr, rewards.Period-1)
+	// set the new historical rewards with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, valAddr, rewards.Period,
+		types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
+	// set the current rewards, incrementing the period by 1
+	k.SetValidatorCurrentRewards(ctx, valAddr, types.NewValidatorCurrentRewards(sdk.SysCoins{}, rewards.Period+1))
+
+	return rewards.Period
+}
+
+// incrementReferenceCount increments the reference count for the historical rewards of a validator
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if historical.ReferenceCount > 2 {
+		panic(fmt.Sprintf("reference count of validator %s at period %d should never exceed 2", valAddr, period))
+	}
+	historical.ReferenceCount++
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+}
+
+// decrementReferenceCount decrements the reference count for the historical rewards of a validator,
+// the historical rewards are deleted when it's no longer referenced
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if historical.ReferenceCount == 0 {
+		panic(fmt.Sprintf("reference count of validator %s at period %d can't be negative", valAddr, period))
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.DeleteValidatorHistoricalReward(ctx, valAddr, period)
+	} else {
+		k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+	}
 }
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorHistoricalRewards is the cumulative reward ratio of a validator (the rewards per share) at a period,
+// which is kept as long as it is referenced by the delegators' starting info or the current rewards
+type ValidatorHistoricalRewards struct {
+	CumulativeRewardRatio sdk.SysCoins `json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
+	ReferenceCount        uint16       `json:"reference_count" yaml:"reference_count"`
+}
+
+// NewValidatorHistoricalRewards creates a new instance of ValidatorHistoricalRewards
+func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.SysCoins, referenceCount uint16) ValidatorHistoricalRewards {
+	return ValidatorHistoricalRewards{
+		CumulativeRewardRatio: cumulativeRewardRatio,
+		ReferenceCount:        referenceCount,
+	}
+}
+
+// ValidatorCurrentRewards is the rewards of the delegators accumulated on a validator in the current period
+type ValidatorCurrentRewards struct {
+	Rewards sdk.SysCoins `json:"rewards" yaml:"rewards"`
+	Period  uint64       `json:"period" yaml:"period"`
+}
+
+// NewValidatorCurrentRewards creates a new instance of ValidatorCurrentRewards
+func NewValidatorCurrentRewards(rewards sdk.SysCoins, period uint64) ValidatorCurrentRewards {
+	return ValidatorCurrentRewards{
+		Rewards: rewards,
+		Period:  period,
+	}
+}
+
+// ValidatorOutstandingRewards is the rewards of the delegators allocated to a validator but not withdrawn yet
+type ValidatorOutstandingRewards = sdk.SysCoins
+
+// DelegatorStartingInfo is the starting point of the shares a delegator added to a validator, the rewards are
+// calculated by the shares and the cumulative reward ratios between the previous period and the ending period
+type DelegatorStartingInfo struct {
+	PreviousPeriod uint64  `json:"previous_period" yaml:"previous_period"`
+	Shares         sdk.Dec `json:"shares" yaml:"shares"`
+	Height         uint64  `json:"height" yaml:"height"`
+}
+
+// NewDelegatorStartingInfo creates a new instance of DelegatorStartingInfo
+func NewDelegatorStartingInfo(previousPeriod uint64, shares sdk.Dec, height uint64) DelegatorStartingInfo {
+	return DelegatorStartingInfo{
+		PreviousPeriod: previousPeriod,
+		Shares:         shares,
+		Height:         height,
+	}
+}
+
+// DelegationDelegatorReward is the rewards of a delegator on a validator for querying
+type DelegationDelegatorReward struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Reward           sdk.SysCoins   `json:"reward" yaml:"reward"`
+}
+
+// NewDelegationDelegatorReward creates a new instance of DelegationDelegatorReward
+func NewDelegationDelegatorReward(valAddr sdk.ValAddress, reward sdk.SysCoins) DelegationDelegatorReward {
+	return DelegationDelegatorReward{
+		ValidatorAddress: valAddr,
+		Reward:           reward,
+	}
+}
+
+// String returns a human readable string representation of DelegationDelegatorReward
+func (ddr DelegationDelegatorReward) String() string {
+	return fmt.Sprintf("%s: %s", ddr.ValidatorAddress, ddr.Reward)
+}
+
+// QueryDelegatorTotalRewardsResponse is the rewards of a delegator on all the validators for querying
+type QueryDelegatorTotalRewardsResponse struct {
+	Rewards []DelegationDelegatorReward `json:"rewards" yaml:"rewards"`
+	Total   sdk.SysCoins                `json:"total" yaml:"total"`
+}
+
+// NewQueryDelegatorTotalRewardsResponse creates a new instance of QueryDelegatorTotalRewardsResponse
+func NewQueryDelegatorTotalRewardsResponse(rewards []DelegationDelegatorReward,
+	total sdk.SysCoins) QueryDelegatorTotalRewardsResponse {
+	return QueryDelegatorTotalRewardsResponse{
+		Rewards: rewards,
+		Total:   total,
+	}
+}
+
+// String returns a human readable string representation of QueryDelegatorTotalRewardsResponse
+func (res QueryDelegatorTotalRewardsResponse) String() string {
+	out := "Delegator Total Rewards:\n"
+	out += "  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf("\n    %s", reward)
+	}
+	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
+	return out
+}
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -28,6 +30,9 @@ const (
 	CodeBadDistribution                             uint32 = 67816
 	CodeInvalidProposalAmount                       uint32 = 67817
 	CodeEmptyProposalRecipient                      uint32 = 67818
+	CodeEmptyDelegationDistInfo                     uint32 = 67819
+	CodeNoDelegationShares                          uint32 = 67820
+	CodeEmptyValidatorDistInfo                      uint32 = 67821
 )
 
 func ErrNilDelegatorAddr() sdk.Error {
@@ -89,3 +94,16 @@ func ErrInvalidProposalAmount() sdk.Error {
 func ErrEmptyProposalRecipient() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeEmptyProposalRecipient, "invalid community pool spend proposal recipient")
 }
+
+func ErrEmptyDelegationDistInfo() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeEmptyDelegationDistInfo, "no delegation distribution info")
+}
+
+func ErrNoDelegationShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoDelegationShares,
+		fmt.Sprintf("delegator %s hasn't added shares to validator %s", delAddr, valAddr))
+}
+
+func ErrEmptyValidatorDistInfo() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeEmptyValidatorDistInfo, "no validator distribution info")
+}
@@ -35,6 +35,14 @@ type StakingKeeper interface {
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+
+	// get a particular delegator by address
+	Delegator(sdk.Context, sdk.AccAddress) stakingexported.DelegatorI
+	// get the shares a delegator added to a validator
+	GetShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Dec, bool)
+	// iterate through all the shares added by the delegators
+	IterateShares(ctx sdk.Context, fn func(index int64, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+		shares sdk.Dec) (stop bool))
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -46,8 +54,8 @@ type StakingHooks interface {
 	// Must be called when a delegation is created
 	BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	// Must be called when a delegation's shares are modified
-	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
-	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
+	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress)
+	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
 
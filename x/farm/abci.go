@@ -9,9 +9,34 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// BeginBlocker allocates the native token to the pools in PoolsYieldNativeToken
-// according to the value of locked token in pool
+// BeginBlocker drops the boost of the expired locks, allocates the native token to the pools in
+// PoolsYieldNativeToken according to the value of locked token in pool, and harvests the vaults
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	settleExpiredLocks(ctx, k)
+	allocateNativeToken(ctx, k)
+	harvestVaults(ctx, k)
+}
+
+// settleExpiredLocks claims the rewards of the boosted lock infos whose unlock time has passed, which ends
+// their boost so that it isn't applied to the rewards yielded after the unlock time. The boost is dropped without
+// the rewards if the claim fails, otherwise it stays in the total weighted value locked forever
+func settleExpiredLocks(ctx sdk.Context, k keeper.Keeper) {
+	logger := k.Logger(ctx)
+	for _, lockInfo := range k.DequeueExpiredLockInfos(ctx, ctx.BlockTime()) {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := handleMsgClaim(cacheCtx, k, types.NewMsgClaim(lockInfo.PoolName, lockInfo.Owner)); err != nil {
+			logger.Error(fmt.Sprintf("failed to settle the expired lock of %s in pool %s, "+
+				"its boost is dropped without the rewards: %s", lockInfo.Owner, lockInfo.PoolName, err.Error()))
+			k.DropLockBoost(ctx, lockInfo)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// allocateNativeToken allocates the native token minted for yield farming to the pools in PoolsYieldNativeToken
+func allocateNativeToken(ctx sdk.Context, k keeper.Keeper) {
 	logger := k.Logger(ctx)
 
 	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, MintFarmingAccount)
@@ -94,6 +119,9 @@ func calculateAllocateInfo(ctx sdk.Context, k keeper.Keeper) (map[string]sdk.Dec
 		if !found {
 			panic("should not happen")
 		}
+		if pool.IsYieldPaused() {
+			continue
+		}
 		poolValue := k.GetPoolLockedValue(ctx, pool)
 		if poolValue.LTE(sdk.ZeroDec()) {
 			continue
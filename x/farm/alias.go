@@ -12,12 +12,14 @@ const (
 	ModuleName          = types.ModuleName
 	MintFarmingAccount  = types.MintFarmingAccount
 	YieldFarmingAccount = types.YieldFarmingAccount
+	VaultAccount        = types.VaultAccount
 	RouterKey           = types.RouterKey
 )
 
 var (
 	NewKeeper          = keeper.NewKeeper
 	RegisterInvariants = keeper.RegisterInvariants
+	DefaultParams      = types.DefaultParams
 )
 
 type (
@@ -33,6 +33,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryAccount(queryRoute, cdc),
 			GetCmdQueryAccountsLockedTo(queryRoute, cdc),
 			GetCmdQueryWhitelist(queryRoute, cdc),
+			GetCmdQueryVault(queryRoute, cdc),
+			GetCmdQueryVaultShares(queryRoute, cdc),
+			GetCmdQueryOwnershipTransfer(queryRoute, cdc),
 			GetCmdQueryParams(queryRoute, cdc),
 		)...,
 	)
@@ -359,3 +362,115 @@ $ %s query farm lock-info pool-eth-xxb okexchain1hw4r48aww06ldrfeuq2v438ujnl6als
 		},
 	}
 }
+
+// GetCmdQueryVault gets the vault query command.
+func GetCmdQueryVault(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vault [pool-name]",
+		Short: "query the auto-compounding vault of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total shares, the last harvest height and the locked pool tokens of the vault of a pool.
+
+Example:
+$ %s query farm vault pool-eth-xxb
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			jsonBytes, err := cdc.MarshalJSON(types.NewQueryPoolParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryVault)
+			bz, _, err := cliCtx.QueryWithData(route, jsonBytes)
+			if err != nil {
+				return err
+			}
+
+			var vault types.VaultDetail
+			cdc.MustUnmarshalJSON(bz, &vault)
+			return cliCtx.PrintOutput(vault)
+		},
+	}
+}
+
+// GetCmdQueryOwnershipTransfer gets the pending ownership transfer query command.
+func GetCmdQueryOwnershipTransfer(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ownership-transfer [pool-name]",
+		Short: "query the pending ownership transfer of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending ownership transfer of a pool, which waits for the confirmation of the new owner.
+
+Example:
+$ %s query farm ownership-transfer pool-eth-xxb
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryPoolParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryOwnershipTransfer)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var transfer types.PoolOwnershipTransfer
+			cdc.MustUnmarshalJSON(resp, &transfer)
+			return cliCtx.PrintOutput(transfer)
+		},
+	}
+}
+
+// GetCmdQueryVaultShares gets the vault shares query command.
+func GetCmdQueryVaultShares(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vault-shares [address]",
+		Short: "query the vault shares of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the shares of an account in all the vaults.
+
+Example:
+$ %s query farm vault-shares okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			jsonBytes, err := cdc.MarshalJSON(types.NewQueryAccountParams(accAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryVaultShares)
+			bz, _, err := cliCtx.QueryWithData(route, jsonBytes)
+			if err != nil {
+				return err
+			}
+
+			var shares []types.VaultShare
+			cdc.MustUnmarshalJSON(bz, &shares)
+			return cliCtx.PrintOutput(shares)
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +21,8 @@ import (
 	"github.com/okex/okexchain/x/farm/types"
 )
 
+const flagLockWeeks = "lock-weeks"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	farmTxCmd := &cobra.Command{
@@ -35,6 +38,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdLock(cdc),
 		GetCmdUnlock(cdc),
 		GetCmdClaim(cdc),
+		GetCmdVaultDeposit(cdc),
+		GetCmdVaultWithdraw(cdc),
+		GetCmdSetMinLockAmount(cdc),
+		GetCmdTransferOwnership(cdc),
+		GetCmdConfirmOwnership(cdc),
 	)...)
 	return farmTxCmd
 }
@@ -101,6 +109,8 @@ func GetCmdProvide(cdc *codec.Codec) *cobra.Command {
 		Short: "provide a number of yield tokens into a pool",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Provide a number of yield tokens into a pool.
+Several tokens could be yielded at the same time. If the token is still being yielded, the new schedule
+is queued and it must start no earlier than the end height of the last schedule of the token.
 
 Example:
 $ %s tx farm provide pool-eth-xxb 1000xxb 5 10000 --from mykey
@@ -141,10 +151,13 @@ func GetCmdLock(cdc *codec.Codec) *cobra.Command {
 		Short: "lock a number of tokens for yield farming",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Lock a number of tokens for yield farming.
+The tokens could be locked for 1, 4 or 12 weeks with a boosted reward weight of 1.25, 1.5 or 2,
+and they can't be unlocked until the lock expires.
 
 Example:
 $ %s tx farm lock pool-eth-xxb 5eth --from mykey
-`, version.ClientName),
+$ %s tx farm lock pool-eth-xxb 5eth --lock-weeks 4 --from mykey
+`, version.ClientName, version.ClientName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -157,11 +170,17 @@ $ %s tx farm lock pool-eth-xxb 5eth --from mykey
 				return err
 			}
 
+			lockWeeks, err := cmd.Flags().GetUint(flagLockWeeks)
+			if err != nil {
+				return err
+			}
+
 			poolName := args[0]
-			msg := types.NewMsgLock(poolName, cliCtx.GetFromAddress(), amount)
+			msg := types.NewMsgLock(poolName, cliCtx.GetFromAddress(), amount, time.Duration(lockWeeks)*types.LockWeek)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().Uint(flagLockWeeks, 0, "number of weeks to lock the tokens for, one of 0, 1, 4 or 12")
 	return cmd
 }
 
@@ -220,6 +239,154 @@ $ %s tx farm claim --from mykey
 	return cmd
 }
 
+func GetCmdVaultDeposit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-deposit [pool-name] [amount]",
+		Short: "deposit pool tokens into the auto-compounding vault of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit ammswap pool tokens into the vault of a farm pool in exchange for vault shares.
+The vault harvests the rewards periodically, swaps them into the constituents of the ammswap pool,
+adds liquidity and locks the minted pool tokens again.
+
+Example:
+$ %s tx farm vault-deposit pool-eth-xxb 5ammswap_eth_xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgVaultDeposit(poolName, cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdVaultWithdraw(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-withdraw [pool-name] [shares]",
+		Short: "redeem vault shares for the compounded pool tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem a number of vault shares for the pool tokens compounded by the vault of a farm pool.
+
+Example:
+$ %s tx farm vault-withdraw pool-eth-xxb 1.5 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			shares, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgVaultWithdraw(poolName, cliCtx.GetFromAddress(), shares)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdSetMinLockAmount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-min-lock-amount [pool-name] [min-lock-amount]",
+		Short: "set the min lock amount of a farm pool by its owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the min lock amount of a farm pool by its owner.
+The denom could only be changed when nothing is locked in the pool.
+
+Example:
+$ %s tx farm set-min-lock-amount pool-eth-xxb 5eth --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			minLockAmount, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgSetMinLockAmount(poolName, cliCtx.GetFromAddress(), minLockAmount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [pool-name] [to-address]",
+		Short: "transfer the ownership of a farm pool to another address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a farm pool to another address.
+The new owner must confirm the transfer within the ownership confirm window.
+
+Example:
+$ %s tx farm transfer-ownership pool-eth-xxb okexchain1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgTransferPoolOwnership(poolName, cliCtx.GetFromAddress(), to)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-ownership [pool-name]",
+		Short: "confirm the ownership transfer of a farm pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Confirm the pending ownership transfer of a farm pool by the new owner.
+
+Example:
+$ %s tx farm confirm-ownership pool-eth-xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgConfirmPoolOwnership(args[0], cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
 // GetCmdManageWhiteListProposal implements a command handler for submitting a farm manage white list proposal transaction
 func GetCmdManageWhiteListProposal(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -266,3 +433,52 @@ Where proposal.json contains:
 		},
 	}
 }
+
+// GetCmdPausePoolProposal implements a command handler for submitting a farm pause pool proposal transaction
+func GetCmdPausePoolProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause-pool [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pause pool proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pause pool proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Setting both pause_lock and pause_yield to false resumes the pool.
+
+Example:
+$ %s tx gov submit-proposal pause-pool <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "pause a farm pool",
+ "description": "stop locking and yielding of the pool in emergency",
+ "pool_name": "pool-eth-xxb",
+ "pause_lock": true,
+ "pause_yield": true,
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := farmutils.ParsePausePoolProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewPausePoolProposal(proposal.Title, proposal.Description, proposal.PoolName,
+				proposal.PauseLock, proposal.PauseYield)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -9,4 +9,6 @@ import (
 var (
 	// ManageWhiteListProposalHandler alias gov NewProposalHandler
 	ManageWhiteListProposalHandler = govcli.NewProposalHandler(cli.GetCmdManageWhiteListProposal, rest.ManageWhiteListProposalRESTHandler)
+	// PausePoolProposalHandler alias gov NewProposalHandler
+	PausePoolProposalHandler = govcli.NewProposalHandler(cli.GetCmdPausePoolProposal, rest.PausePoolProposalRESTHandler)
 )
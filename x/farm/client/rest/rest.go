@@ -17,3 +17,8 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 func ManageWhiteListProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
 	return govRest.ProposalRESTHandler{}
 }
+
+// PausePoolProposalRESTHandler defines farm pause pool proposal handler
+func PausePoolProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -27,3 +27,26 @@ func ParseManageWhiteListProposalJSON(cdc *codec.Codec, proposalFilePath string)
 	cdc.MustUnmarshalJSON(contents, &proposal)
 	return
 }
+
+// PausePoolProposalJSON defines a PausePoolProposalJSON with a deposit used to parse pause pool
+// proposals from a JSON file.
+type PausePoolProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	PoolName    string       `json:"pool_name" yaml:"pool_name"`
+	PauseLock   bool         `json:"pause_lock" yaml:"pause_lock"`
+	PauseYield  bool         `json:"pause_yield" yaml:"pause_yield"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParsePausePoolProposalJSON parse json from proposal file to PausePoolProposalJSON struct
+func ParsePausePoolProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposal PausePoolProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -35,6 +35,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		k.SetWhitelist(ctx, poolName)
 	}
 
+	for _, vault := range data.Vaults {
+		k.SetVault(ctx, vault)
+	}
+
+	for _, share := range data.VaultShares {
+		k.SetVaultShare(ctx, share)
+	}
+
 	k.SetParams(ctx, data.Params)
 
 	// init module account
@@ -64,6 +72,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	if mintModuleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.MintFarmingAccount))
 	}
+
+	vaultModuleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.VaultAccount)
+	if vaultModuleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.VaultAccount))
+	}
 }
 
 // ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
@@ -103,7 +116,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data types.GenesisState) {
 
 	whiteList := k.GetWhitelist(ctx)
 
+	vaults := make(types.Vaults, 0)
+	k.IterateAllVaults(ctx,
+		func(vault types.Vault) (stop bool) {
+			vaults = append(vaults, vault)
+			return false
+		},
+	)
+
+	vaultShares := make([]types.VaultShare, 0)
+	k.IterateAllVaultShares(ctx,
+		func(share types.VaultShare) (stop bool) {
+			vaultShares = append(vaultShares, share)
+			return false
+		},
+	)
+
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(pools, lockInfos, allHistoricalRewards, allCurRewards, whiteList, params)
+	return types.NewGenesisState(pools, lockInfos, allHistoricalRewards, allCurRewards, whiteList, vaults, vaultShares, params)
 }
@@ -50,6 +50,31 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgClaim(ctx, k, msg)
 			}
+		case types.MsgVaultDeposit:
+			name = "handleMsgVaultDeposit"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgVaultDeposit(ctx, k, msg)
+			}
+		case types.MsgVaultWithdraw:
+			name = "handleMsgVaultWithdraw"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgVaultWithdraw(ctx, k, msg)
+			}
+		case types.MsgSetMinLockAmount:
+			name = "handleMsgSetMinLockAmount"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetMinLockAmount(ctx, k, msg)
+			}
+		case types.MsgTransferPoolOwnership:
+			name = "handleMsgTransferPoolOwnership"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTransferPoolOwnership(ctx, k, msg)
+			}
+		case types.MsgConfirmPoolOwnership:
+			name = "handleMsgConfirmPoolOwnership"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConfirmPoolOwnership(ctx, k, msg)
+			}
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return types.ErrUnknownFarmMsgType(errMsg).Result()
@@ -81,38 +106,45 @@ func handleMsgProvide(ctx sdk.Context, k keeper.Keeper, msg types.MsgProvide) (*
 		return types.ErrInvalidPoolOwner(msg.Address.String(), msg.PoolName).Result()
 	}
 
-	// 1.3 Check if the provided coin denom is the same as the locked coin name
-	if len(pool.YieldedTokenInfos) != 1 {
-		panic(fmt.Sprintf("The YieldedTokenInfos length is %d, which should be 1 in current code version",
-			len(pool.YieldedTokenInfos)))
-	}
-	if pool.YieldedTokenInfos[0].RemainingAmount.Denom != msg.Amount.Denom {
-		return types.ErrInvalidDenom(pool.YieldedTokenInfos[0].RemainingAmount.Denom, msg.Amount.Denom).Result()
+	// 1.3 Check if the provided token exists
+	if ok := k.TokenKeeper().TokenExist(ctx, msg.Amount.Denom); !ok {
+		return types.ErrTokenNotExist(msg.Amount.Denom).Result()
 	}
 
-	// 2.1 Calculate how many provided token & native token could be yielded in current period
+	// 2. Calculate how many provided token & native token could be yielded in current period
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
-	// 2.2 Check if remaining amount is already zero
-	remainingAmount := updatedPool.YieldedTokenInfos[0].RemainingAmount
-	if !remainingAmount.IsZero() {
-		return types.ErrRemainingAmountNotZero(remainingAmount.String()).Result()
+	// 3. init a new yielded_token_info struct. It starts yielding directly if there is no yielding schedule
+	// of the token, otherwise it's queued after the last schedule of the token
+	yieldedTokenInfo := types.NewYieldedTokenInfo(msg.Amount, msg.StartHeightToYield, msg.AmountYieldedPerBlock)
+	index := updatedPool.YieldedTokenInfos.IndexOf(msg.Amount.Denom)
+	switch {
+	case index < 0:
+		updatedPool.YieldedTokenInfos = append(updatedPool.YieldedTokenInfos, yieldedTokenInfo)
+	case updatedPool.YieldedTokenInfos[index].IsFinished():
+		updatedPool.YieldedTokenInfos[index] = yieldedTokenInfo
+	default:
+		lastEndBlockHeight := updatedPool.YieldedTokenInfos[index].EndBlockHeightToYield
+		if height := updatedPool.QueuedYieldedTokenInfos.LastEndBlockHeight(msg.Amount.Denom); height > lastEndBlockHeight {
+			lastEndBlockHeight = height
+		}
+		if msg.StartHeightToYield < lastEndBlockHeight {
+			return types.ErrYieldScheduleOverlapped(msg.Amount.Denom, lastEndBlockHeight).Result()
+		}
+		updatedPool.QueuedYieldedTokenInfos = append(updatedPool.QueuedYieldedTokenInfos, yieldedTokenInfo)
 	}
 
-	// 3. Terminate pool current period
-	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+	// 4. Terminate pool current period
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens)
 
-	// 4. Transfer coin to farm module account
+	// 5. Transfer coin to farm module account
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
 		ctx, msg.Address, YieldFarmingAccount, msg.Amount.ToCoins(),
 	); err != nil {
 		return types.ErrSendCoinsFromAccountToModuleFailed(err.Error()).Result()
 	}
 
-	// 5. init a new yielded_token_info struct, then set it into store
-	updatedPool.YieldedTokenInfos[0] = types.NewYieldedTokenInfo(
-		msg.Amount, msg.StartHeightToYield, msg.AmountYieldedPerBlock,
-	)
+	// 6. Update farm pool
 	k.SetFarmPool(ctx, updatedPool)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -122,6 +154,7 @@ func handleMsgProvide(ctx sdk.Context, k keeper.Keeper, msg types.MsgProvide) (*
 		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
 		sdk.NewAttribute(types.AttributeKeyStartHeightToYield, strconv.FormatInt(msg.StartHeightToYield, 10)),
 		sdk.NewAttribute(types.AttributeKeyAmountYieldPerBlock, msg.AmountYieldedPerBlock.String()),
+		sdk.NewAttribute(types.AttributeKeyEndHeightToYield, strconv.FormatInt(yieldedTokenInfo.EndBlockHeightToYield, 10)),
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -137,15 +170,16 @@ func handleMsgClaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaim) (*sdk.
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw rewards
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	// 4. Update the lock_info data
-	k.UpdateLockInfo(ctx, msg.Address, pool.Name, sdk.ZeroDec())
+	weightChanged := k.UpdateLockInfo(ctx, msg.Address, pool.Name, sdk.ZeroDec(), 0)
 
 	// 5. Update farm pool
+	updatedPool.TotalWeightedValueLocked.Amount = updatedPool.TotalWeightedValueLocked.Amount.Add(weightChanged)
 	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
 		panic("should not happen")
 	}
@@ -12,6 +12,9 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	if !found {
 		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
 	}
+	if pool.LockPaused {
+		return types.ErrLockPaused(msg.PoolName).Result()
+	}
 	if pool.MinLockAmount.Denom != msg.Amount.Denom {
 		return types.ErrInvalidDenom(pool.MinLockAmount.Denom, msg.Amount.Denom).Result()
 	}
@@ -30,7 +33,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	if hasLocked {
 		// If it exists, withdraw money
 		var err error
-		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens, msg.Address)
 		if err != nil {
 			return nil, err
 		}
@@ -41,7 +44,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	} else {
 		// If it doesn't exist, only increase period
-		k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+		k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens)
 
 		// Create new lock info
 		lockInfo := types.NewLockInfo(
@@ -53,7 +56,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	}
 
 	// 4. Update lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount)
+	weightChanged := k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount, msg.LockDuration)
 
 	// 5. Send the locked-tokens from its own account to farm module account
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
@@ -64,6 +67,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Add(msg.Amount)
+	updatedPool.TotalWeightedValueLocked.Amount = updatedPool.TotalWeightedValueLocked.Amount.Add(weightChanged)
 	k.SetFarmPool(ctx, updatedPool)
 
 	// 7. notify backend
@@ -76,6 +80,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
 		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
 		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyLockDuration, msg.LockDuration.String()),
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -95,6 +100,10 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 		return types.ErrInsufficientAmount(lockInfo.Amount.String(), msg.Amount.String()).Result()
 	}
 
+	if lockInfo.IsLocked(ctx.BlockTime()) {
+		return types.ErrLockNotExpired(msg.PoolName, lockInfo.UnlockTime.String()).Result()
+	}
+
 	// 1.2 Get the pool info
 	pool, poolFound := k.GetFarmPool(ctx, msg.PoolName)
 	if !poolFound {
@@ -112,13 +121,13 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw money
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	// 4. Update the lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg())
+	weightChanged := k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg(), 0)
 
 	// 5. Send the locked-tokens from farm module account to its own account
 	if err = k.SupplyKeeper().SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Address, msg.Amount.ToCoins()); err != nil {
@@ -127,6 +136,7 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Sub(msg.Amount)
+	updatedPool.TotalWeightedValueLocked.Amount = updatedPool.TotalWeightedValueLocked.Amount.Add(weightChanged)
 	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
 		panic("should not happen")
 	}
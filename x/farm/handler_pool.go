@@ -114,6 +114,9 @@ func handleMsgDestroyPool(ctx sdk.Context, k keeper.Keeper, msg types.MsgDestroy
 	)
 	k.DeletePoolCurrentRewards(ctx, msg.PoolName)
 
+	// 7. delete the pending ownership transfer
+	k.DeletePoolOwnershipTransfer(ctx, msg.PoolName)
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeDestroyPool,
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Owner.String()),
@@ -122,3 +125,100 @@ func handleMsgDestroyPool(ctx sdk.Context, k keeper.Keeper, msg types.MsgDestroy
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetMinLockAmount(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetMinLockAmount) (*sdk.Result, error) {
+	// 0. check pool and owner
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+
+	if !pool.Owner.Equals(msg.Address) {
+		return types.ErrInvalidPoolOwner(msg.Address.String(), msg.PoolName).Result()
+	}
+
+	// 1. the denom to lock can only be changed when nothing is locked in the pool
+	if pool.MinLockAmount.Denom != msg.MinLockAmount.Denom {
+		if !pool.TotalValueLocked.IsZero() {
+			return types.ErrInvalidMinLockAmount(msg.PoolName, pool.TotalValueLocked.String()).Result()
+		}
+		if ok := k.TokenKeeper().TokenExist(ctx, msg.MinLockAmount.Denom); !ok {
+			return types.ErrTokenNotExist(msg.MinLockAmount.Denom).Result()
+		}
+		pool.TotalValueLocked = sdk.NewDecCoin(msg.MinLockAmount.Denom, sdk.ZeroInt())
+		pool.TotalWeightedValueLocked = sdk.NewDecCoin(msg.MinLockAmount.Denom, sdk.ZeroInt())
+	}
+
+	// 2. update pool
+	pool.MinLockAmount = msg.MinLockAmount
+	k.SetFarmPool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetMinLockAmount,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(types.AttributeKeyMinLockAmount, msg.MinLockAmount.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferPoolOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferPoolOwnership) (*sdk.Result, error) {
+	// 0. check pool and owner
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+
+	if !pool.Owner.Equals(msg.FromAddress) {
+		return types.ErrInvalidPoolOwner(msg.FromAddress.String(), msg.PoolName).Result()
+	}
+
+	// 1. only one transfer could be pending before it expires
+	if transfer, found := k.GetPoolOwnershipTransfer(ctx, msg.PoolName); found && !ctx.BlockTime().After(transfer.Expire) {
+		return types.ErrRepeatedOwnershipTransfer(msg.PoolName).Result()
+	}
+
+	// 2. wait for the confirmation of the new owner
+	expire := ctx.BlockTime().Add(k.GetParams(ctx).OwnershipConfirmWindow)
+	k.SetPoolOwnershipTransfer(ctx, types.NewPoolOwnershipTransfer(msg.PoolName, msg.FromAddress, msg.ToAddress, expire))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferPoolOwnership,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.FromAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(types.AttributeKeyToAddress, msg.ToAddress.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConfirmPoolOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgConfirmPoolOwnership) (*sdk.Result, error) {
+	// 0. check the pending transfer
+	transfer, found := k.GetPoolOwnershipTransfer(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoOwnershipTransferFound(msg.PoolName).Result()
+	}
+	if ctx.BlockTime().After(transfer.Expire) {
+		return types.ErrOwnershipTransferExpired(msg.PoolName, transfer.Expire.String()).Result()
+	}
+	if !transfer.ToAddress.Equals(msg.Address) {
+		return types.ErrInvalidPoolOwner(msg.Address.String(), msg.PoolName).Result()
+	}
+
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+
+	// 1. transfer the ownership
+	pool.Owner = msg.Address
+	k.SetFarmPool(ctx, pool)
+	k.DeletePoolOwnershipTransfer(ctx, msg.PoolName)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConfirmPoolOwnership,
+		sdk.NewAttribute(types.AttributeKeyAddress, transfer.FromAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(types.AttributeKeyToAddress, msg.Address.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/okex/okexchain/x/common"
 
@@ -157,7 +158,7 @@ var normalGetLockMsg getMsgFunc = func(tCtx *testContext, preData interface{}) s
 	poolName := createPoolMsg.PoolName
 	address := createPoolMsg.Owner
 	amount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
-	lockMsg := types.NewMsgLock(poolName, address, amount)
+	lockMsg := types.NewMsgLock(poolName, address, amount, 0)
 	return lockMsg
 }
 
@@ -518,7 +519,7 @@ func TestHandlerMsgProvide(t *testing.T) {
 			expectedErr:  types.ErrNoFarmPoolFound("abc"),
 		},
 		{
-			caseName: "failed. The coin name should be %s, not %s",
+			caseName: "failed. token does not exist",
 			preExec:  preExec,
 			getMsg: func(tCtx *testContext, preData interface{}) sdk.Msg {
 				provideMsg := normalGetProvideMsg(tCtx, preData).(types.MsgProvide)
@@ -526,10 +527,10 @@ func TestHandlerMsgProvide(t *testing.T) {
 				return provideMsg
 			},
 			verification: verification,
-			expectedErr:  types.ErrInvalidDenom("aab", "fff"),
+			expectedErr:  types.ErrTokenNotExist("fff"),
 		},
 		{
-			caseName: "failed. The remaining amount is %s, so it's not enable to provide token repeatedly util amount become zero",
+			caseName: "failed. the new schedule overlaps the yielding one",
 			preExec: func(t *testing.T, tCtx *testContext) interface{} {
 				// create pool
 				createPoolMsg := createPool(t, tCtx)
@@ -540,7 +541,58 @@ func TestHandlerMsgProvide(t *testing.T) {
 			},
 			getMsg:       normalGetProvideMsg,
 			verification: verification,
-			expectedErr:  types.ErrRemainingAmountNotZero("10.000000000000000000aab"),
+			expectedErr:  types.ErrYieldScheduleOverlapped("aab", 21),
+		},
+		{
+			caseName: "success. queue the new schedule after the yielding one",
+			preExec: func(t *testing.T, tCtx *testContext) interface{} {
+				// create pool
+				createPoolMsg := createPool(t, tCtx)
+
+				// provide
+				provide(t, tCtx, createPoolMsg)
+				return createPoolMsg
+			},
+			getMsg: func(tCtx *testContext, preData interface{}) sdk.Msg {
+				provideMsg := normalGetProvideMsg(tCtx, preData).(types.MsgProvide)
+				provideMsg.StartHeightToYield = 21
+				return provideMsg
+			},
+			verification: func(t *testing.T, tCtx *testContext, err sdk.Error, testCase testCaseItem, preCoins, afterCoins sdk.SysCoins, preData interface{}) {
+				verification(t, tCtx, err, testCase, preCoins, afterCoins, preData)
+				createPoolMsg := preData.(types.MsgCreatePool)
+				pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+				require.True(t, found)
+				require.Equal(t, 1, len(pool.YieldedTokenInfos))
+				require.Equal(t, 1, len(pool.QueuedYieldedTokenInfos))
+				require.Equal(t, int64(31), pool.QueuedYieldedTokenInfos[0].EndBlockHeightToYield)
+			},
+			expectedErr: nil,
+		},
+		{
+			caseName: "success. yield another token at the same time",
+			preExec: func(t *testing.T, tCtx *testContext) interface{} {
+				// create pool
+				createPoolMsg := createPool(t, tCtx)
+
+				// provide
+				provide(t, tCtx, createPoolMsg)
+				return createPoolMsg
+			},
+			getMsg: func(tCtx *testContext, preData interface{}) sdk.Msg {
+				provideMsg := normalGetProvideMsg(tCtx, preData).(types.MsgProvide)
+				provideMsg.Amount.Denom = tCtx.swapTokenPairs[0].QuotePooledCoin.Denom
+				return provideMsg
+			},
+			verification: func(t *testing.T, tCtx *testContext, err sdk.Error, testCase testCaseItem, preCoins, afterCoins sdk.SysCoins, preData interface{}) {
+				verification(t, tCtx, err, testCase, preCoins, afterCoins, preData)
+				createPoolMsg := preData.(types.MsgCreatePool)
+				pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+				require.True(t, found)
+				require.Equal(t, 2, len(pool.YieldedTokenInfos))
+				require.Equal(t, 0, len(pool.QueuedYieldedTokenInfos))
+			},
+			expectedErr: nil,
 		},
 		{
 			caseName: "insufficient amount",
@@ -867,11 +919,394 @@ func TestHandlerMsgUnlock(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			caseName: "failed. the lock has not expired",
+			preExec: func(t *testing.T, tCtx *testContext) interface{} {
+				// create pool
+				createPoolMsg := createPool(t, tCtx)
+
+				// provide
+				provide(t, tCtx, createPoolMsg)
+
+				// lock for 4 weeks
+				lockMsg := normalGetLockMsg(tCtx, createPoolMsg).(types.MsgLock)
+				lockMsg.LockDuration = 4 * types.LockWeek
+				_, err := tCtx.handler(tCtx.ctx, lockMsg)
+				require.Nil(t, err)
+
+				tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1).
+					WithBlockTime(tCtx.ctx.BlockTime().Add(3 * types.LockWeek))
+				return createPoolMsg
+			},
+			getMsg: normalGetUnlockMsg,
+			verification: func(t *testing.T, tCtx *testContext, err sdk.Error, testCase testCaseItem, preCoins, afterCoins sdk.SysCoins, preData interface{}) {
+				createPoolMsg := preData.(types.MsgCreatePool)
+				lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, createPoolMsg.Owner, createPoolMsg.PoolName)
+				require.True(t, found)
+				require.NotNil(t, err)
+				require.Equal(t, types.ErrLockNotExpired(createPoolMsg.PoolName, lockInfo.UnlockTime.String()).Error(), err.Error())
+			},
+		},
+		{
+			caseName: "success. unlock after the lock expires",
+			preExec: func(t *testing.T, tCtx *testContext) interface{} {
+				// create pool
+				createPoolMsg := createPool(t, tCtx)
+
+				// provide
+				provide(t, tCtx, createPoolMsg)
+
+				// lock for 4 weeks
+				lockMsg := normalGetLockMsg(tCtx, createPoolMsg).(types.MsgLock)
+				lockMsg.LockDuration = 4 * types.LockWeek
+				_, err := tCtx.handler(tCtx.ctx, lockMsg)
+				require.Nil(t, err)
+
+				tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1).
+					WithBlockTime(tCtx.ctx.BlockTime().Add(4 * types.LockWeek))
+				return createPoolMsg
+			},
+			getMsg: normalGetUnlockMsg,
+			verification: func(t *testing.T, tCtx *testContext, err sdk.Error, testCase testCaseItem, preCoins, afterCoins sdk.SysCoins, preData interface{}) {
+				verification(t, tCtx, err, testCase, preCoins, afterCoins, preData)
+				createPoolMsg := preData.(types.MsgCreatePool)
+				pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+				require.True(t, found)
+				require.True(t, pool.TotalValueLocked.IsZero())
+				require.True(t, pool.TotalWeightedValueLocked.IsZero())
+			},
+			expectedErr: nil,
+		},
 	}
 
 	testCaseTest(t, tests)
 }
 
+func TestHandlerLockWithDuration(t *testing.T) {
+	tCtx := initEnvironment(t)
+
+	// create pool
+	createPoolMsg := createPool(t, tCtx)
+
+	// provide, 1 token will be yielded per block from the next block
+	provide(t, tCtx, createPoolMsg)
+
+	// lock 1 token for 12 weeks with the weight of 2
+	boostedLockMsg := normalGetLockMsg(tCtx, createPoolMsg).(types.MsgLock)
+	boostedLockMsg.LockDuration = 12 * types.LockWeek
+	_, err := tCtx.handler(tCtx.ctx, boostedLockMsg)
+	require.Nil(t, err)
+
+	// lock 1 token without lock duration with the weight of 1
+	createPoolMsg.Owner = tCtx.addrList[0]
+	lock(t, tCtx, createPoolMsg)
+
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), pool.TotalValueLocked.Amount)
+	require.Equal(t, sdk.NewDec(3), pool.TotalWeightedValueLocked.Amount)
+
+	// 3 tokens are yielded, which are shared by the weighted amounts
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 4)
+	cacheCtx, _ := tCtx.ctx.CacheContext()
+	earnings, err := tCtx.k.GetEarnings(cacheCtx, createPoolMsg.PoolName, boostedLockMsg.Address)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(2), earnings.AmountYielded.AmountOf(createPoolMsg.YieldedSymbol))
+
+	cacheCtx, _ = tCtx.ctx.CacheContext()
+	earnings, err = tCtx.k.GetEarnings(cacheCtx, createPoolMsg.PoolName, tCtx.addrList[0])
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(1), earnings.AmountYielded.AmountOf(createPoolMsg.YieldedSymbol))
+
+	// the boost is dropped at the first block after the lock expires, with the boosted rewards claimed
+	tCtx.ctx = tCtx.ctx.WithBlockTime(tCtx.ctx.BlockTime().Add(12 * types.LockWeek))
+	balanceBefore := tCtx.mockKeeper.AccKeeper.GetAccount(tCtx.ctx, boostedLockMsg.Address).GetCoins()
+	BeginBlocker(tCtx.ctx, abci.RequestBeginBlock{Header: abci.Header{Height: tCtx.ctx.BlockHeight()}}, tCtx.k)
+	balanceAfter := tCtx.mockKeeper.AccKeeper.GetAccount(tCtx.ctx, boostedLockMsg.Address).GetCoins()
+	require.Equal(t, sdk.NewDec(2), balanceAfter.AmountOf(createPoolMsg.YieldedSymbol).
+		Sub(balanceBefore.AmountOf(createPoolMsg.YieldedSymbol)))
+
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, boostedLockMsg.Address, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, time.Duration(0), lockInfo.LockDuration)
+	pool, found = tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), pool.TotalWeightedValueLocked.Amount)
+	require.Empty(t, tCtx.k.DequeueExpiredLockInfos(tCtx.ctx, tCtx.ctx.BlockTime()))
+
+	createPoolMsg.Owner = boostedLockMsg.Address
+	unlock(t, tCtx, createPoolMsg)
+}
+
+func TestSettleExpiredLockFailed(t *testing.T) {
+	tCtx := initEnvironment(t)
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+
+	// lock 1 token for 12 weeks with the weight of 2, and 1 token without lock duration
+	boostedLockMsg := normalGetLockMsg(tCtx, createPoolMsg).(types.MsgLock)
+	boostedLockMsg.LockDuration = 12 * types.LockWeek
+	_, err := tCtx.handler(tCtx.ctx, boostedLockMsg)
+	require.Nil(t, err)
+	createPoolMsg.Owner = tCtx.addrList[0]
+	lock(t, tCtx, createPoolMsg)
+
+	// the rewards can't be paid since the yield farming account is drained
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 4)
+	supplyKeeper := tCtx.k.SupplyKeeper()
+	yieldedCoins := supplyKeeper.GetModuleAccount(tCtx.ctx, types.YieldFarmingAccount).GetCoins()
+	require.False(t, yieldedCoins.IsZero())
+	require.Nil(t, supplyKeeper.SendCoinsFromModuleToAccount(tCtx.ctx, types.YieldFarmingAccount, tCtx.tokenOwner, yieldedCoins))
+
+	// the boost is dropped without the rewards, and the lock isn't queued any more
+	tCtx.ctx = tCtx.ctx.WithBlockTime(tCtx.ctx.BlockTime().Add(12 * types.LockWeek))
+	balanceBefore := tCtx.mockKeeper.AccKeeper.GetAccount(tCtx.ctx, boostedLockMsg.Address).GetCoins()
+	BeginBlocker(tCtx.ctx, abci.RequestBeginBlock{Header: abci.Header{Height: tCtx.ctx.BlockHeight()}}, tCtx.k)
+	balanceAfter := tCtx.mockKeeper.AccKeeper.GetAccount(tCtx.ctx, boostedLockMsg.Address).GetCoins()
+	require.Equal(t, balanceBefore, balanceAfter)
+
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, boostedLockMsg.Address, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, time.Duration(0), lockInfo.LockDuration)
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), pool.TotalWeightedValueLocked.Amount)
+	require.Empty(t, tCtx.k.DequeueExpiredLockInfos(tCtx.ctx, tCtx.ctx.BlockTime()))
+
+	// the boost of the expired lock in a pool which can't be found is dropped as well
+	lockInfo = types.NewLockInfo(tCtx.addrList[0], "nonexistent",
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.OneDec()), tCtx.ctx.BlockHeight(), 0)
+	lockInfo.LockDuration = 4 * types.LockWeek
+	lockInfo.UnlockTime = tCtx.ctx.BlockTime()
+	tCtx.k.SetLockInfo(tCtx.ctx, lockInfo)
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1).WithBlockTime(tCtx.ctx.BlockTime().Add(time.Hour))
+	BeginBlocker(tCtx.ctx, abci.RequestBeginBlock{Header: abci.Header{Height: tCtx.ctx.BlockHeight()}}, tCtx.k)
+	lockInfo, found = tCtx.k.GetLockInfo(tCtx.ctx, lockInfo.Owner, lockInfo.PoolName)
+	require.True(t, found)
+	require.Equal(t, time.Duration(0), lockInfo.LockDuration)
+	require.Empty(t, tCtx.k.DequeueExpiredLockInfos(tCtx.ctx, tCtx.ctx.BlockTime()))
+}
+
+func TestHandlerTopUpBoostedLock(t *testing.T) {
+	tCtx := initEnvironment(t)
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+
+	// lock 1 token for 12 weeks
+	lockMsg := normalGetLockMsg(tCtx, createPoolMsg).(types.MsgLock)
+	lockMsg.LockDuration = 12 * types.LockWeek
+	_, err := tCtx.handler(tCtx.ctx, lockMsg)
+	require.Nil(t, err)
+
+	// top up the lock without lock duration just before it expires
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1).
+		WithBlockTime(tCtx.ctx.BlockTime().Add(12*types.LockWeek - time.Hour))
+	lockMsg.LockDuration = 0
+	_, err = tCtx.handler(tCtx.ctx, lockMsg)
+	require.Nil(t, err)
+
+	// the whole lock keeps the boost and is locked for 12 weeks again
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, lockMsg.Address, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, 12*types.LockWeek, lockInfo.LockDuration)
+	require.Equal(t, tCtx.ctx.BlockTime().Add(12*types.LockWeek), lockInfo.UnlockTime)
+
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1).
+		WithBlockTime(tCtx.ctx.BlockTime().Add(2 * time.Hour))
+	BeginBlocker(tCtx.ctx, abci.RequestBeginBlock{Header: abci.Header{Height: tCtx.ctx.BlockHeight()}}, tCtx.k)
+	lockInfo, found = tCtx.k.GetLockInfo(tCtx.ctx, lockMsg.Address, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, 12*types.LockWeek, lockInfo.LockDuration)
+	unlockMsg := types.NewMsgUnlock(createPoolMsg.PoolName, lockMsg.Address, lockMsg.Amount)
+	_, err = tCtx.handler(tCtx.ctx, unlockMsg)
+	require.NotNil(t, err)
+}
+
+func TestHandlerVault(t *testing.T) {
+	tCtx := initEnvironment(t)
+
+	// create pool
+	createPoolMsg := createPool(t, tCtx)
+
+	// provide, 1 token will be yielded per block from the next block
+	provide(t, tCtx, createPoolMsg)
+
+	// deposit 1 pool token into the vault, and 1 share is issued for the first deposit
+	owner := createPoolMsg.Owner
+	amount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgVaultDeposit(createPoolMsg.PoolName, owner, amount))
+	require.Nil(t, err)
+
+	share, found := tCtx.k.GetVaultShare(tCtx.ctx, owner, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1), share.Shares)
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, amount.String(), pool.TotalValueLocked.String())
+	require.Equal(t, amount.String(), tCtx.k.GetVaultLockedAmount(tCtx.ctx, pool).String())
+
+	// the harvest is skipped if its swap receives less than the time-weighted average price allows
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 4).WithBlockTime(tCtx.ctx.BlockTime().Add(20 * time.Second))
+	vault, found := tCtx.k.GetVault(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	cacheCtx, _ := tCtx.ctx.CacheContext()
+	params := tCtx.k.GetParams(cacheCtx)
+	params.VaultMaxSlippage = sdk.NewDecWithPrec(1, 2)
+	tCtx.k.SetParams(cacheCtx, params)
+	harvestVault(cacheCtx, tCtx.k, vault)
+	pool, found = tCtx.k.GetFarmPool(cacheCtx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, amount.String(), tCtx.k.GetVaultLockedAmount(cacheCtx, pool).String())
+
+	// the yielded tokens are compounded into the pool tokens locked by the vault
+	harvestVault(tCtx.ctx, tCtx.k, vault)
+
+	vault, found = tCtx.k.GetVault(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, tCtx.ctx.BlockHeight(), vault.LastHarvestHeight)
+	pool, found = tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	lockedAmount := tCtx.k.GetVaultLockedAmount(tCtx.ctx, pool)
+	require.True(t, lockedAmount.Amount.GT(amount.Amount))
+	require.Equal(t, lockedAmount.String(), pool.TotalValueLocked.String())
+
+	// the later depositor gets less shares for the same amount
+	depositor := tCtx.addrList[0]
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgVaultDeposit(createPoolMsg.PoolName, depositor, amount))
+	require.Nil(t, err)
+	share, found = tCtx.k.GetVaultShare(tCtx.ctx, depositor, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.True(t, share.Shares.LT(sdk.NewDec(1)))
+
+	// withdraw more shares than held
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgVaultWithdraw(createPoolMsg.PoolName, owner, sdk.NewDec(2)))
+	require.Equal(t, types.ErrInsufficientVaultShares(sdk.NewDec(1).String(), sdk.NewDec(2).String()).Error(), err.Error())
+
+	// withdraw all the shares, and the compounded pool tokens are redeemed
+	preCoins := tCtx.k.TokenKeeper().GetCoins(tCtx.ctx, owner)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgVaultWithdraw(createPoolMsg.PoolName, owner, sdk.NewDec(1)))
+	require.Nil(t, err)
+	afterCoins := tCtx.k.TokenKeeper().GetCoins(tCtx.ctx, owner)
+	redeemed := afterCoins.AmountOf(amount.Denom).Sub(preCoins.AmountOf(amount.Denom))
+	require.True(t, redeemed.GT(amount.Amount))
+	_, found = tCtx.k.GetVaultShare(tCtx.ctx, owner, createPoolMsg.PoolName)
+	require.False(t, found)
+
+	// only the pool locking pool tokens supports vault
+	invalidPoolMsg := types.NewMsgCreatePool(owner, "xyz",
+		sdk.NewDecCoinFromDec(createPoolMsg.YieldedSymbol, sdk.ZeroDec()), createPoolMsg.YieldedSymbol)
+	_, err = tCtx.handler(tCtx.ctx, invalidPoolMsg)
+	require.Nil(t, err)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgVaultDeposit(invalidPoolMsg.PoolName, owner,
+		sdk.NewDecCoinFromDec(createPoolMsg.YieldedSymbol, sdk.NewDec(1))))
+	require.Equal(t, types.ErrInvalidVaultPool(invalidPoolMsg.PoolName, createPoolMsg.YieldedSymbol).Error(), err.Error())
+}
+
+func TestHandlerVaultPendingRewards(t *testing.T) {
+	tCtx := initEnvironment(t)
+
+	// create pool, and provide a token which has no swap token pair with the quote token besides the yielded one
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	provideMsg := normalGetProvideMsg(tCtx, createPoolMsg).(types.MsgProvide)
+	provideMsg.Amount = sdk.NewDecCoinFromDec(tCtx.nonPairTokenName[0], sdk.NewDec(10))
+	_, err := tCtx.handler(tCtx.ctx, provideMsg)
+	require.Nil(t, err)
+
+	owner := createPoolMsg.Owner
+	amount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgVaultDeposit(createPoolMsg.PoolName, owner, amount))
+	require.Nil(t, err)
+
+	// the reward token failing to be swapped is kept pending, and the other rewards are still compounded
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 4).WithBlockTime(tCtx.ctx.BlockTime().Add(20 * time.Second))
+	vault, found := tCtx.k.GetVault(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	harvestVault(tCtx.ctx, tCtx.k, vault)
+
+	vault, found = tCtx.k.GetVault(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, tCtx.ctx.BlockHeight(), vault.LastHarvestHeight)
+	pendingAmount := vault.PendingRewards.AmountOf(tCtx.nonPairTokenName[0])
+	require.True(t, pendingAmount.IsPositive())
+	require.Equal(t, 1, len(vault.PendingRewards))
+	require.Equal(t, pendingAmount, tCtx.k.TokenKeeper().GetCoins(tCtx.ctx, types.VaultAddress()).
+		AmountOf(tCtx.nonPairTokenName[0]))
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	lockedAmount := tCtx.k.GetVaultLockedAmount(tCtx.ctx, pool)
+	require.True(t, lockedAmount.Amount.GT(amount.Amount))
+
+	// the pending rewards are accumulated with the ones of the next harvest
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 2).WithBlockTime(tCtx.ctx.BlockTime().Add(10 * time.Second))
+	harvestVault(tCtx.ctx, tCtx.k, vault)
+
+	vault, found = tCtx.k.GetVault(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.True(t, vault.PendingRewards.AmountOf(tCtx.nonPairTokenName[0]).GT(pendingAmount))
+	pool, found = tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.True(t, tCtx.k.GetVaultLockedAmount(tCtx.ctx, pool).Amount.GT(lockedAmount.Amount))
+}
+
+func TestHandlerPoolOwner(t *testing.T) {
+	tCtx := initEnvironment(t)
+
+	// create pool and lock 1 token
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	lock(t, tCtx, createPoolMsg)
+	owner, newOwner := createPoolMsg.Owner, tCtx.addrList[0]
+	poolName := createPoolMsg.PoolName
+
+	// only the owner could set the min lock amount
+	minLockAmount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(2))
+	_, err := tCtx.handler(tCtx.ctx, types.NewMsgSetMinLockAmount(poolName, newOwner, minLockAmount))
+	require.Equal(t, types.ErrInvalidPoolOwner(newOwner.String(), poolName).Error(), err.Error())
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgSetMinLockAmount(poolName, owner, minLockAmount))
+	require.Nil(t, err)
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, poolName)
+	require.True(t, found)
+	require.Equal(t, minLockAmount, pool.MinLockAmount)
+
+	// the denom could not be changed while tokens are locked
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgSetMinLockAmount(poolName, owner,
+		sdk.NewDecCoinFromDec(createPoolMsg.YieldedSymbol, sdk.NewDec(1))))
+	require.Equal(t, types.ErrInvalidMinLockAmount(poolName, pool.TotalValueLocked.String()).Error(), err.Error())
+
+	// transfer the ownership, and only one transfer could be pending
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgTransferPoolOwnership(poolName, owner, newOwner))
+	require.Nil(t, err)
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgTransferPoolOwnership(poolName, owner, tCtx.addrList[1]))
+	require.Equal(t, types.ErrRepeatedOwnershipTransfer(poolName).Error(), err.Error())
+
+	// only the new owner could confirm the transfer
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgConfirmPoolOwnership(poolName, tCtx.addrList[1]))
+	require.Equal(t, types.ErrInvalidPoolOwner(tCtx.addrList[1].String(), poolName).Error(), err.Error())
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgConfirmPoolOwnership(poolName, newOwner))
+	require.Nil(t, err)
+	pool, found = tCtx.k.GetFarmPool(tCtx.ctx, poolName)
+	require.True(t, found)
+	require.Equal(t, newOwner, pool.Owner)
+	_, found = tCtx.k.GetPoolOwnershipTransfer(tCtx.ctx, poolName)
+	require.False(t, found)
+
+	// the transfer could not be confirmed after it expires
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgTransferPoolOwnership(poolName, newOwner, owner))
+	require.Nil(t, err)
+	transfer, found := tCtx.k.GetPoolOwnershipTransfer(tCtx.ctx, poolName)
+	require.True(t, found)
+	tCtx.ctx = tCtx.ctx.WithBlockTime(transfer.Expire.Add(time.Second))
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgConfirmPoolOwnership(poolName, owner))
+	require.Equal(t, types.ErrOwnershipTransferExpired(poolName, transfer.Expire.String()).Error(), err.Error())
+
+	// no tokens could be locked into a paused pool
+	pool.LockPaused = true
+	tCtx.k.SetFarmPool(tCtx.ctx, pool)
+	_, err = tCtx.handler(tCtx.ctx, normalGetLockMsg(tCtx, createPoolMsg))
+	require.Equal(t, types.ErrLockPaused(poolName).Error(), err.Error())
+}
+
 func TestHandlerMsgClaim(t *testing.T) {
 	var preExec preExecFunc = func(t *testing.T, tCtx *testContext) interface{} {
 		// create pool
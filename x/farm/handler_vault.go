@@ -0,0 +1,130 @@
+package farm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	swaptypes "github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/farm/keeper"
+	"github.com/okex/okexchain/x/farm/types"
+)
+
+func handleMsgVaultDeposit(ctx sdk.Context, k keeper.Keeper, msg types.MsgVaultDeposit) (*sdk.Result, error) {
+	// 1. Check the farm pool, only the pool locking ammswap pool tokens supports vault
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+	if !swaptypes.IsPoolToken(pool.MinLockAmount.Denom) {
+		return types.ErrInvalidVaultPool(pool.Name, pool.MinLockAmount.Denom).Result()
+	}
+	if pool.MinLockAmount.Denom != msg.Amount.Denom {
+		return types.ErrInvalidDenom(pool.MinLockAmount.Denom, msg.Amount.Denom).Result()
+	}
+
+	// 2. Compound the rewards before the deposit, so that the new shares don't share the rewards before
+	vault, found := k.GetVault(ctx, msg.PoolName)
+	if found {
+		harvestVault(ctx, k, vault)
+		vault, _ = k.GetVault(ctx, msg.PoolName)
+	} else {
+		vault = types.NewVault(msg.PoolName, sdk.ZeroDec(), ctx.BlockHeight())
+	}
+
+	// 3. Calculate the shares issued to the depositor
+	shares := msg.Amount.Amount
+	lockedAmount := k.GetVaultLockedAmount(ctx, pool).Amount
+	if vault.TotalShares.IsPositive() && lockedAmount.IsPositive() {
+		shares = msg.Amount.Amount.MulTruncate(vault.TotalShares).QuoTruncate(lockedAmount)
+	}
+	if !shares.IsPositive() {
+		return types.ErrInvalidInputAmount(msg.Amount.String()).Result()
+	}
+
+	// 4. Send the pool tokens to the vault account, and lock them for the vault
+	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
+		ctx, msg.Address, types.VaultAccount, msg.Amount.ToCoins(),
+	); err != nil {
+		return types.ErrSendCoinsFromAccountToModuleFailed(err.Error()).Result()
+	}
+	if _, err := handleMsgLock(ctx, k, types.NewMsgLock(msg.PoolName, types.VaultAddress(), msg.Amount, 0)); err != nil {
+		return nil, err
+	}
+
+	// 5. Update the vault and the shares
+	vault.TotalShares = vault.TotalShares.Add(shares)
+	k.SetVault(ctx, vault)
+	share, found := k.GetVaultShare(ctx, msg.Address, msg.PoolName)
+	if !found {
+		share = types.NewVaultShare(msg.Address, msg.PoolName, sdk.ZeroDec())
+	}
+	share.Shares = share.Shares.Add(shares)
+	k.SetVaultShare(ctx, share)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVaultDeposit,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgVaultWithdraw(ctx sdk.Context, k keeper.Keeper, msg types.MsgVaultWithdraw) (*sdk.Result, error) {
+	// 1. Check the shares
+	share, found := k.GetVaultShare(ctx, msg.Address, msg.PoolName)
+	if !found {
+		return types.ErrInsufficientVaultShares(sdk.ZeroDec().String(), msg.Shares.String()).Result()
+	}
+	if share.Shares.LT(msg.Shares) {
+		return types.ErrInsufficientVaultShares(share.Shares.String(), msg.Shares.String()).Result()
+	}
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+	vault, found := k.GetVault(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoVaultFound(msg.PoolName).Result()
+	}
+
+	// 2. Compound the rewards before the withdrawal, so that the shares redeem the rewards too
+	harvestVault(ctx, k, vault)
+	vault, _ = k.GetVault(ctx, msg.PoolName)
+
+	// 3. Calculate the pool tokens redeemed by the shares
+	lockedAmount := k.GetVaultLockedAmount(ctx, pool)
+	amount := sdk.NewDecCoinFromDec(lockedAmount.Denom,
+		msg.Shares.MulTruncate(lockedAmount.Amount).QuoTruncate(vault.TotalShares))
+	if !amount.IsPositive() {
+		return types.ErrInvalidInputAmount(amount.String()).Result()
+	}
+
+	// 4. Unlock the pool tokens of the vault, and send them to the depositor
+	if _, err := handleMsgUnlock(ctx, k, types.NewMsgUnlock(msg.PoolName, types.VaultAddress(), amount)); err != nil {
+		return nil, err
+	}
+	if err := k.SupplyKeeper().SendCoinsFromModuleToAccount(
+		ctx, types.VaultAccount, msg.Address, amount.ToCoins(),
+	); err != nil {
+		return types.ErrSendCoinsFromModuleToAccountFailed(err.Error()).Result()
+	}
+
+	// 5. Update the vault and the shares
+	vault.TotalShares = vault.TotalShares.Sub(msg.Shares)
+	k.SetVault(ctx, vault)
+	share.Shares = share.Shares.Sub(msg.Shares)
+	if share.Shares.IsZero() {
+		k.DeleteVaultShare(ctx, msg.Address, msg.PoolName)
+	} else {
+		k.SetVaultShare(ctx, share)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVaultWithdraw,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -1,53 +1,113 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/farm/types"
 )
 
 // CalculateAmountYieldedBetween is used for calculating how many tokens haven been yielded from
 // startBlockHeight to endBlockHeight. And return the amount.
+// Each yielded token is calculated by its own schedule, and once a schedule finishes, the next queued
+// schedule of the same token takes its place.
 func (k Keeper) CalculateAmountYieldedBetween(ctx sdk.Context, pool types.FarmPool) (types.FarmPool, sdk.SysCoins) {
+	// nothing is yielded while yielding is paused
+	if pool.IsYieldPaused() {
+		return pool, sdk.SysCoins{}
+	}
+
 	currentPeriod := k.GetPoolCurrentRewards(ctx, pool.Name)
 	endBlockHeight := ctx.BlockHeight()
 
 	totalYieldedTokens := sdk.SysCoins{}
 	for i := 0; i < len(pool.YieldedTokenInfos); i++ {
-		startBlockHeightToYield := pool.YieldedTokenInfos[i].StartBlockHeightToYield
-		var startBlockHeight int64
-		if currentPeriod.StartBlockHeight <= startBlockHeightToYield {
-			startBlockHeight = startBlockHeightToYield
-		} else {
-			startBlockHeight = currentPeriod.StartBlockHeight
-		}
+		for {
+			yieldedTokens := yieldBetween(&pool.YieldedTokenInfos[i], currentPeriod.StartBlockHeight, endBlockHeight)
+			pool.TotalAccumulatedRewards = pool.TotalAccumulatedRewards.Add2(yieldedTokens)
+			totalYieldedTokens = totalYieldedTokens.Add2(yieldedTokens)
 
-		// no tokens to yield
-		if startBlockHeightToYield == 0 || startBlockHeight >= endBlockHeight {
-			continue
+			if !pool.YieldedTokenInfos[i].IsFinished() {
+				break
+			}
+			// dequeue the next schedule of the token
+			queued := pool.QueuedYieldedTokenInfos
+			j := queued.IndexOf(pool.YieldedTokenInfos[i].RemainingAmount.Denom)
+			if j < 0 {
+				break
+			}
+			pool.YieldedTokenInfos[i] = queued[j]
+			pool.QueuedYieldedTokenInfos = append(queued[:j:j], queued[j+1:]...)
 		}
+	}
+	return pool, totalYieldedTokens
+}
+
+// yieldBetween calculates how many tokens of a yielded token info haven been yielded from
+// startBlockHeight to endBlockHeight, and updates its remaining amount
+func yieldBetween(yieldedTokenInfo *types.YieldedTokenInfo, startBlockHeight, endBlockHeight int64) sdk.SysCoins {
+	startBlockHeightToYield := yieldedTokenInfo.StartBlockHeightToYield
+	if startBlockHeight <= startBlockHeightToYield {
+		startBlockHeight = startBlockHeightToYield
+	}
+
+	// no tokens to yield
+	if startBlockHeightToYield == 0 || startBlockHeight >= endBlockHeight {
+		return sdk.SysCoins{}
+	}
+
+	// calculate how many tokens to be yielded between startBlockHeight and endBlockHeight
+	blockInterval := sdk.NewDec(endBlockHeight - startBlockHeight)
+	amount := blockInterval.MulTruncate(yieldedTokenInfo.AmountYieldedPerBlock)
+	remaining := yieldedTokenInfo.RemainingAmount
+	if amount.LT(remaining.Amount) {
+		yieldedTokenInfo.RemainingAmount.Amount = remaining.Amount.Sub(amount)
+		return sdk.NewDecCoinsFromDec(remaining.Denom, amount)
+	}
+	*yieldedTokenInfo = types.NewYieldedTokenInfo(sdk.NewDecCoin(remaining.Denom, sdk.ZeroInt()), 0, sdk.ZeroDec())
+	return sdk.NewDecCoinsFromDec(remaining.Denom, remaining.Amount)
+}
+
+// PausePoolYield ends the current period with the tokens yielded until now, and stops the pool yielding
+func (k Keeper) PausePoolYield(ctx sdk.Context, pool types.FarmPool) types.FarmPool {
+	if pool.IsYieldPaused() {
+		return pool
+	}
+	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens)
+	updatedPool.YieldPausedHeight = ctx.BlockHeight()
+	return updatedPool
+}
+
+// ResumePoolYield starts a new period from now, and postpones the yielding schedules by the paused blocks.
+// The schedules yielding before the pause continue yielding their remaining amount from now
+func (k Keeper) ResumePoolYield(ctx sdk.Context, pool types.FarmPool) types.FarmPool {
+	if !pool.IsYieldPaused() {
+		return pool
+	}
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalWeightedValueLocked, sdk.SysCoins{})
 
-		yieldedTokens := sdk.SysCoins{}
-		// calculate how many tokens to be yielded between startBlockHeight and endBlockHeight
-		blockInterval := sdk.NewDec(endBlockHeight - startBlockHeight)
-		amount := blockInterval.MulTruncate(pool.YieldedTokenInfos[i].AmountYieldedPerBlock)
-		remaining := pool.YieldedTokenInfos[i].RemainingAmount
-		if amount.LT(remaining.Amount) {
-			pool.YieldedTokenInfos[i].RemainingAmount.Amount = remaining.Amount.Sub(amount)
-			yieldedTokens = sdk.NewDecCoinsFromDec(remaining.Denom, amount)
-		} else {
-			pool.YieldedTokenInfos[i] = types.NewYieldedTokenInfo(
-				sdk.NewDecCoin(remaining.Denom, sdk.ZeroInt()), 0, sdk.ZeroDec(),
-			)
-			yieldedTokens = sdk.NewDecCoinsFromDec(remaining.Denom, remaining.Amount)
+	pausedBlocks := ctx.BlockHeight() - pool.YieldPausedHeight
+	reschedule := func(yieldedTokenInfos types.YieldedTokenInfos) {
+		for i, info := range yieldedTokenInfos {
+			if info.StartBlockHeightToYield == 0 {
+				continue
+			}
+			startBlockHeight := info.StartBlockHeightToYield + pausedBlocks
+			if info.StartBlockHeightToYield < pool.YieldPausedHeight {
+				startBlockHeight = ctx.BlockHeight()
+			}
+			yieldedTokenInfos[i] = types.NewYieldedTokenInfo(info.RemainingAmount, startBlockHeight, info.AmountYieldedPerBlock)
 		}
-		pool.TotalAccumulatedRewards = pool.TotalAccumulatedRewards.Add2(yieldedTokens)
-		totalYieldedTokens = totalYieldedTokens.Add2(yieldedTokens)
 	}
-	return pool, totalYieldedTokens
+	reschedule(pool.YieldedTokenInfos)
+	reschedule(pool.QueuedYieldedTokenInfos)
+	pool.YieldPausedHeight = 0
+	return pool
 }
 
 func (k Keeper) WithdrawRewards(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
+	ctx sdk.Context, poolName string, totalWeightedValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
 ) (sdk.SysCoins, sdk.Error) {
 	// 0. check existence of lock info
 	lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
@@ -56,7 +116,7 @@ func (k Keeper) WithdrawRewards(
 	}
 
 	// 1. end current period and calculate rewards
-	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalValueLocked, yieldedTokens)
+	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalWeightedValueLocked, yieldedTokens)
 	rewards := k.calculateRewards(ctx, poolName, addr, endingPeriod, lockInfo)
 
 	// 2. transfer rewards to user account
@@ -73,19 +133,20 @@ func (k Keeper) WithdrawRewards(
 	return rewards, nil
 }
 
-// IncrementPoolPeriod increments pool period, returning the period just ended
+// IncrementPoolPeriod increments pool period, returning the period just ended.
+// The reward ratio is calculated per weighted value locked, so that boosted lock infos earn more.
 func (k Keeper) IncrementPoolPeriod(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins,
+	ctx sdk.Context, poolName string, totalWeightedValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins,
 ) uint64 {
 	// 1. fetch current period rewards
 	rewards := k.GetPoolCurrentRewards(ctx, poolName)
 	// 2. calculate current reward ratio
 	rewards.Rewards = rewards.Rewards.Add2(yieldedTokens)
 	var currentRatio sdk.SysCoins
-	if totalValueLocked.IsZero() {
+	if totalWeightedValueLocked.IsZero() {
 		currentRatio = sdk.SysCoins{}
 	} else {
-		currentRatio = rewards.Rewards.QuoDecTruncate(totalValueLocked.Amount)
+		currentRatio = rewards.Rewards.QuoDecTruncate(totalWeightedValueLocked.Amount)
 	}
 
 	// 3.1 get the previous pool historical rewards
@@ -137,11 +198,12 @@ func (k Keeper) calculateRewards(
 	}
 
 	startingPeriod := lockInfo.ReferencePeriod
-	// calculate rewards for final period
-	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.Amount)
+	// calculate rewards for final period, weighted by the multiplier of the lock duration
+	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.WeightedAmount())
 }
 
-// calculateLockRewardsBetween calculate the rewards accrued by a pool between two periods
+// calculateLockRewardsBetween calculate the rewards accrued by a pool between two periods,
+// amount is the weighted amount of the lock info
 func (k Keeper) calculateLockRewardsBetween(ctx sdk.Context, poolName string, startingPeriod, endingPeriod uint64,
 	amount sdk.SysCoin) (rewards sdk.SysCoins) {
 
@@ -162,8 +224,12 @@ func (k Keeper) calculateLockRewardsBetween(ctx sdk.Context, poolName string, st
 	return
 }
 
-// UpdateLockInfo updates lock info for the modified lock info
-func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec) {
+// UpdateLockInfo updates lock info for the modified lock info, and returns how much the weighted amount
+// of the lock info is changed. The lock duration is extended if a longer one is given, and the boost of
+// an expired lock is dropped once the lock info is updated.
+func (k Keeper) UpdateLockInfo(
+	ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec, lockDuration time.Duration,
+) (weightChanged sdk.Dec) {
 	// period has already been incremented - we want to store the period ended by this lock action
 	previousPeriod := k.GetPoolCurrentRewards(ctx, poolName).Period - 1
 
@@ -172,18 +238,35 @@ func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName st
 	if !found {
 		panic("the lock info can't be found")
 	}
+	previousWeight := lockInfo.WeightedAmount().Amount
+
 	lockInfo.StartBlockHeight = ctx.BlockHeight()
 	lockInfo.ReferencePeriod = previousPeriod
 	lockInfo.Amount.Amount = lockInfo.Amount.Amount.Add(changedAmount)
+
+	blockTime := ctx.BlockTime()
+	if !lockInfo.IsLocked(blockTime) {
+		lockInfo.LockDuration = 0
+	}
+	if lockDuration > lockInfo.LockDuration {
+		lockInfo.LockDuration = lockDuration
+	}
+	// the tokens added to a boosted lock earn its boost, so the whole lock is locked for its duration again
+	if changedAmount.IsPositive() && lockInfo.LockDuration > 0 {
+		lockInfo.UnlockTime = blockTime.Add(lockInfo.LockDuration)
+	}
+
 	if lockInfo.Amount.IsZero() {
 		k.DeleteLockInfo(ctx, lockInfo.Owner, lockInfo.PoolName)
 		k.DeleteAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
-	} else {
-		// increment reference count for the period we're going to track
-		k.incrementReferenceCount(ctx, poolName, previousPeriod)
-
-		// set the updated lock info
-		k.SetLockInfo(ctx, lockInfo)
-		k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+		return previousWeight.Neg()
 	}
+
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, poolName, previousPeriod)
+
+	// set the updated lock info
+	k.SetLockInfo(ctx, lockInfo)
+	k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+	return lockInfo.WeightedAmount().Amount.Sub(previousWeight)
 }
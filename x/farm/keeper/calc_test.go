@@ -108,6 +108,38 @@ func TestCalculateAmountYieldedBetween(t *testing.T) {
 	}
 }
 
+func TestCalculateAmountYieldedBetweenWithSchedules(t *testing.T) {
+	ctx, keeper := GetKeeper(t)
+	poolName := "poolName"
+	ctx = ctx.WithBlockHeight(30)
+	keeper.SetPoolCurrentRewards(ctx, poolName, types.NewPoolCurrentRewards(10, 1, sdk.SysCoins{}))
+
+	pool := types.FarmPool{
+		Name: poolName,
+		YieldedTokenInfos: types.YieldedTokenInfos{
+			types.NewYieldedTokenInfo(sdk.NewDecCoin("xxb", sdk.NewInt(100)), 10, sdk.NewDec(10)),
+			types.NewYieldedTokenInfo(sdk.NewDecCoin("yyb", sdk.NewInt(30)), 10, sdk.NewDec(1)),
+		},
+		QueuedYieldedTokenInfos: types.YieldedTokenInfos{
+			types.NewYieldedTokenInfo(sdk.NewDecCoin("xxb", sdk.NewInt(50)), 25, sdk.NewDec(5)),
+		},
+	}
+	require.Equal(t, int64(20), pool.YieldedTokenInfos[0].EndBlockHeightToYield)
+	require.Equal(t, int64(40), pool.YieldedTokenInfos[1].EndBlockHeightToYield)
+	require.Equal(t, int64(35), pool.QueuedYieldedTokenInfos[0].EndBlockHeightToYield)
+
+	// xxb: 100 from the finished schedule, and 25 from the queued one since height 25
+	// yyb: 20 from height 10 to 30
+	updatedPool, yieldedTokens := keeper.CalculateAmountYieldedBetween(ctx, pool)
+	require.Equal(t, sdk.NewDec(125), yieldedTokens.AmountOf("xxb"))
+	require.Equal(t, sdk.NewDec(20), yieldedTokens.AmountOf("yyb"))
+	require.Equal(t, sdk.NewDec(125), updatedPool.TotalAccumulatedRewards.AmountOf("xxb"))
+	require.Equal(t, 0, len(updatedPool.QueuedYieldedTokenInfos))
+	require.Equal(t, sdk.NewDec(25), updatedPool.YieldedTokenInfos[0].RemainingAmount.Amount)
+	require.Equal(t, int64(25), updatedPool.YieldedTokenInfos[0].StartBlockHeightToYield)
+	require.Equal(t, sdk.NewDec(10), updatedPool.YieldedTokenInfos[1].RemainingAmount.Amount)
+}
+
 func TestIncrementReferenceCount(t *testing.T) {
 	ctx, keeper := GetKeeper(t)
 	poolName := "poolName"
@@ -431,7 +463,7 @@ func TestUpdateLockInfo(t *testing.T) {
 			keeper.SetLockInfo(ctx, test.lockInfo)
 		}
 		wrappedTestFunc := func() {
-			keeper.UpdateLockInfo(ctx, test.lockInfo.Owner, poolName, test.changeAmount)
+			keeper.UpdateLockInfo(ctx, test.lockInfo.Owner, poolName, test.changeAmount, 0)
 		}
 		test.expectedFunc(test, wrappedTestFunc)
 	}
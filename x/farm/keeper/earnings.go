@@ -24,7 +24,7 @@ func (k Keeper) GetEarnings(
 	// between start block height and current height
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
-	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, updatedPool.TotalValueLocked, yieldedTokens)
+	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, updatedPool.TotalWeightedValueLocked, yieldedTokens)
 	rewards := k.calculateRewards(ctx, poolName, accAddr, endingPeriod, lockInfo)
 
 	earnings = types.NewEarnings(ctx.BlockHeight(), lockInfo.Amount, rewards)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	swaptypes "github.com/okex/okexchain/x/ammswap/types"
 	"github.com/okex/okexchain/x/farm/types"
@@ -105,9 +107,14 @@ func (k Keeper) DeleteAddressInFarmPool(ctx sdk.Context, poolName string, addr s
 	store.Delete(types.GetAddressInFarmPoolKey(poolName, addr))
 }
 
+// SetLockInfo sets the lock info into store, and queues the boosted one by its unlock time to drop the boost
+// once it expires. The queued keys left by the previous unlock times are dropped when they are dequeued
 func (k Keeper) SetLockInfo(ctx sdk.Context, lockInfo types.LockInfo) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLockInfoKey(lockInfo.Owner, lockInfo.PoolName), k.cdc.MustMarshalBinaryLengthPrefixed(lockInfo))
+	if lockInfo.LockDuration > 0 {
+		store.Set(types.GetLockUnlockTimeKey(lockInfo.UnlockTime, lockInfo.Owner, lockInfo.PoolName), []byte{})
+	}
 }
 
 func (k Keeper) GetLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName string) (info types.LockInfo, found bool) {
@@ -131,6 +138,62 @@ func (k Keeper) DeleteLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName st
 	store.Delete(types.GetLockInfoKey(addr, poolName))
 }
 
+// DequeueExpiredLockInfos removes the lock infos queued by the unlock time until the block time from the queue,
+// and returns the ones which are still boosted
+func (k Keeper) DequeueExpiredLockInfos(ctx sdk.Context, blockTime time.Time) (lockInfos []types.LockInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LockUnlockTimePrefix, sdk.PrefixEndBytes(types.GetLockUnlockTimePrefix(blockTime)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+		addr, poolName := types.SplitLockUnlockTimeKey(key)
+		lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
+		if !found || lockInfo.LockDuration == 0 || lockInfo.IsLocked(blockTime) {
+			continue
+		}
+		lockInfos = append(lockInfos, lockInfo)
+	}
+	return
+}
+
+// DropLockBoost drops the boost of the expired lock info without withdrawing its rewards, which is used when the
+// rewards can't be withdrawn. It ends the current period and updates the weighted value locked like a claim does,
+// and the rewards of the lock info are forfeited and left in the pool
+func (k Keeper) DropLockBoost(ctx sdk.Context, lockInfo types.LockInfo) {
+	pool, found := k.GetFarmPool(ctx, lockInfo.PoolName)
+	if !found {
+		// no weighted value locked is left to update
+		lockInfo.LockDuration = 0
+		k.SetLockInfo(ctx, lockInfo)
+		return
+	}
+
+	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalWeightedValueLocked, yieldedTokens)
+	k.decrementReferenceCount(ctx, pool.Name, lockInfo.ReferencePeriod)
+	weightChanged := k.UpdateLockInfo(ctx, lockInfo.Owner, pool.Name, sdk.ZeroDec(), 0)
+	updatedPool.TotalWeightedValueLocked.Amount = updatedPool.TotalWeightedValueLocked.Amount.Add(weightChanged)
+	k.SetFarmPool(ctx, updatedPool)
+}
+
+// MigrateTotalWeightedValueLocked sets the total weighted value locked of the pools created before the lock
+// durations are supported, whose lock infos are all weighted by their locked amount
+func (k Keeper) MigrateTotalWeightedValueLocked(ctx sdk.Context) {
+	for _, pool := range k.GetFarmPools(ctx) {
+		if pool.TotalWeightedValueLocked.Denom == pool.TotalValueLocked.Denom {
+			continue
+		}
+		pool.TotalWeightedValueLocked = pool.TotalValueLocked
+		k.SetFarmPool(ctx, pool)
+	}
+}
+
 // GetPoolLockedValue gets the value of locked tokens in pool priced in quote symbol
 func (k Keeper) GetPoolLockedValue(ctx sdk.Context, pool types.FarmPool) sdk.Dec {
 	if pool.TotalValueLocked.Amount.LTE(sdk.ZeroDec()) {
@@ -132,3 +132,21 @@ func TestReadWriteFarmPool(t *testing.T) {
 	_, found = keeper.Keeper.GetFarmPool(ctx, poolName)
 	require.False(t, found)
 }
+
+func TestMigrateTotalWeightedValueLocked(t *testing.T) {
+	ctx, keeper := GetKeeper(t)
+	totalValueLocked := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100))
+	oldPool := types.FarmPool{Name: "old-pool", TotalValueLocked: totalValueLocked}
+	newPool := types.FarmPool{Name: "new-pool", TotalValueLocked: totalValueLocked,
+		TotalWeightedValueLocked: sdk.NewDecCoinFromDec("xxb", sdk.NewDec(150))}
+	keeper.Keeper.SetFarmPool(ctx, oldPool)
+	keeper.Keeper.SetFarmPool(ctx, newPool)
+
+	keeper.Keeper.MigrateTotalWeightedValueLocked(ctx)
+	pool, found := keeper.Keeper.GetFarmPool(ctx, oldPool.Name)
+	require.True(t, found)
+	require.Equal(t, totalValueLocked, pool.TotalWeightedValueLocked)
+	pool, found = keeper.Keeper.GetFarmPool(ctx, newPool.Name)
+	require.True(t, found)
+	require.Equal(t, newPool.TotalWeightedValueLocked, pool.TotalWeightedValueLocked)
+}
@@ -55,6 +55,9 @@ func yieldFarmingAccountInvariant(k Keeper) sdk.Invariant {
 			for _, yieldInfo := range pool.YieldedTokenInfos {
 				expectedYieldModuleAccAmount = expectedYieldModuleAccAmount.Add2(sdk.SysCoins{yieldInfo.RemainingAmount})
 			}
+			for _, yieldInfo := range pool.QueuedYieldedTokenInfos {
+				expectedYieldModuleAccAmount = expectedYieldModuleAccAmount.Add2(sdk.SysCoins{yieldInfo.RemainingAmount})
+			}
 		}
 
 		// get yield_farming_account module account
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/farm/types"
+)
+
+// GetPoolOwnershipTransfer gets the pending ownership transfer of a pool
+func (k Keeper) GetPoolOwnershipTransfer(ctx sdk.Context, poolName string) (transfer types.PoolOwnershipTransfer, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPoolOwnershipTransferKey(poolName))
+	if bz == nil {
+		return transfer, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &transfer)
+	return transfer, true
+}
+
+// SetPoolOwnershipTransfer sets the pending ownership transfer of a pool
+func (k Keeper) SetPoolOwnershipTransfer(ctx sdk.Context, transfer types.PoolOwnershipTransfer) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(transfer)
+	ctx.KVStore(k.storeKey).Set(types.GetPoolOwnershipTransferKey(transfer.PoolName), bz)
+}
+
+// DeletePoolOwnershipTransfer deletes the pending ownership transfer of a pool
+func (k Keeper) DeletePoolOwnershipTransfer(ctx sdk.Context, poolName string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPoolOwnershipTransferKey(poolName))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/params"
 )
 
 // SetParams sets the farm parameters to the param space.
@@ -10,8 +11,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams returns the total set of farm parameters.
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
-	return
+// GetParams returns the total set of farm parameters. The params unset before the upgrade get the default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	farmParams := types.DefaultParams()
+	params.GetParamSetIfExists(ctx, k.paramSubspace, &farmParams)
+	return farmParams
 }
@@ -15,7 +15,8 @@ var _ govKeeper.ProposalHandler = (*Keeper)(nil)
 
 // GetMinDeposit returns min deposit
 func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
-	if _, ok := content.(types.ManageWhiteListProposal); ok {
+	switch content.(type) {
+	case types.ManageWhiteListProposal, types.PausePoolProposal:
 		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
 	}
 
@@ -24,7 +25,8 @@ func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDepos
 
 // GetMaxDepositPeriod returns max deposit period
 func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
-	if _, ok := content.(types.ManageWhiteListProposal); ok {
+	switch content.(type) {
+	case types.ManageWhiteListProposal, types.PausePoolProposal:
 		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
 	}
 
@@ -33,7 +35,8 @@ func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (ma
 
 // GetVotingPeriod returns voting period
 func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
-	if _, ok := content.(types.ManageWhiteListProposal); ok {
+	switch content.(type) {
+	case types.ManageWhiteListProposal, types.PausePoolProposal:
 		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
 	}
 
@@ -45,6 +48,8 @@ func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitPr
 	switch content := msg.Content.(type) {
 	case types.ManageWhiteListProposal:
 		return k.CheckMsgManageWhiteListProposal(ctx, content)
+	case types.PausePoolProposal:
+		return k.CheckPausePoolProposal(ctx, content)
 	default:
 		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized dex proposal content type: %T", content))
 	}
@@ -83,3 +88,11 @@ func (k Keeper) CheckMsgManageWhiteListProposal(ctx sdk.Context, proposal types.
 
 	return nil
 }
+
+// CheckPausePoolProposal checks the pause pool proposal
+func (k Keeper) CheckPausePoolProposal(ctx sdk.Context, proposal types.PausePoolProposal) sdk.Error {
+	if !k.HasFarmPool(ctx, proposal.PoolName) {
+		return types.ErrNoFarmPoolFound(proposal.PoolName)
+	}
+	return nil
+}
@@ -36,6 +36,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccountsLockedTo(ctx, req, k)
 		case types.QueryPoolNum:
 			return queryPoolNum(ctx, k)
+		case types.QueryVault:
+			return queryVault(ctx, req, k)
+		case types.QueryVaultShares:
+			return queryVaultShares(ctx, req, k)
+		case types.QueryOwnershipTransfer:
+			return queryOwnershipTransfer(ctx, req, k)
 		default:
 			return nil, types.ErrUnknownFarmQueryType("failed. unknown farm query endpoint")
 		}
@@ -192,6 +198,67 @@ func queryPoolNum(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func queryVault(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPoolParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	pool, found := k.GetFarmPool(ctx, params.PoolName)
+	if !found {
+		return nil, types.ErrNoFarmPoolFound(params.PoolName)
+	}
+
+	vault, found := k.GetVault(ctx, params.PoolName)
+	if !found {
+		return nil, types.ErrNoVaultFound(params.PoolName)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewVaultDetail(vault, k.GetVaultLockedAmount(ctx, pool)))
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+
+	return res, nil
+}
+
+func queryVaultShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	shares := k.GetVaultSharesForAccount(ctx, params.AccAddress)
+	if shares == nil {
+		shares = []types.VaultShare{}
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, shares)
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+
+	return res, nil
+}
+
+func queryOwnershipTransfer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPoolParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	transfer, found := k.GetPoolOwnershipTransfer(ctx, params.PoolName)
+	if !found {
+		return nil, types.ErrNoOwnershipTransferFound(params.PoolName)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, transfer)
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+
+	return res, nil
+}
+
 func defaultQueryErrJSONMarshal(err error) sdk.Error {
 	return common.ErrMarshalJSONFailed(err.Error())
 }
@@ -146,6 +146,7 @@ func GetKeeper(t *testing.T) (sdk.Context, MockFarmKeeper) {
 	farmAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Burner, supply.Minter)
 	yieldFarmingAccount := supply.NewEmptyModuleAccount(types.YieldFarmingAccount, supply.Burner, supply.Minter)
 	mintFarmingAccount := supply.NewEmptyModuleAccount(types.MintFarmingAccount, supply.Burner, supply.Minter)
+	vaultAccount := supply.NewEmptyModuleAccount(types.VaultAccount)
 	swapModuleAccount := supply.NewEmptyModuleAccount(swap.ModuleName, supply.Burner, supply.Minter)
 
 	blacklistedAddrs := make(map[string]bool)
@@ -168,6 +169,7 @@ func GetKeeper(t *testing.T) (sdk.Context, MockFarmKeeper) {
 		types.ModuleName:          nil,
 		types.YieldFarmingAccount: nil,
 		types.MintFarmingAccount:  nil,
+		types.VaultAccount:        nil,
 		swap.ModuleName:           {supply.Burner, supply.Minter},
 		govtypes.ModuleName:       nil,
 	}
@@ -177,6 +179,7 @@ func GetKeeper(t *testing.T) (sdk.Context, MockFarmKeeper) {
 	sk.SetModuleAccount(ctx, farmAcc)
 	sk.SetModuleAccount(ctx, yieldFarmingAccount)
 	sk.SetModuleAccount(ctx, mintFarmingAccount)
+	sk.SetModuleAccount(ctx, vaultAccount)
 	sk.SetModuleAccount(ctx, swapModuleAccount)
 
 	// 1.5 init token keeper
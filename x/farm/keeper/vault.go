@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/farm/types"
+)
+
+// GetVault gets the vault of a pool
+func (k Keeper) GetVault(ctx sdk.Context, poolName string) (vault types.Vault, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVaultKey(poolName))
+	if bz == nil {
+		return vault, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vault)
+	return vault, true
+}
+
+// SetVault sets the vault of a pool
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(vault)
+	ctx.KVStore(k.storeKey).Set(types.GetVaultKey(vault.PoolName), bz)
+}
+
+// GetVaults gets all the vaults
+func (k Keeper) GetVaults(ctx sdk.Context) (vaults types.Vaults) {
+	k.IterateAllVaults(ctx, func(vault types.Vault) (stop bool) {
+		vaults = append(vaults, vault)
+		return false
+	})
+	return
+}
+
+// IterateAllVaults iterates over all the vaults
+func (k Keeper) IterateAllVaults(ctx sdk.Context, handler func(vault types.Vault) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VaultPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vault types.Vault
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &vault)
+		if handler(vault) {
+			break
+		}
+	}
+}
+
+// GetVaultLockedAmount gets the pool tokens locked by the vault of a pool
+func (k Keeper) GetVaultLockedAmount(ctx sdk.Context, pool types.FarmPool) sdk.SysCoin {
+	lockInfo, found := k.GetLockInfo(ctx, types.VaultAddress(), pool.Name)
+	if !found {
+		return sdk.NewDecCoinFromDec(pool.MinLockAmount.Denom, sdk.ZeroDec())
+	}
+	return lockInfo.Amount
+}
+
+// GetVaultShare gets the vault shares of an address
+func (k Keeper) GetVaultShare(ctx sdk.Context, addr sdk.AccAddress, poolName string) (share types.VaultShare, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVaultShareKey(addr, poolName))
+	if bz == nil {
+		return share, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &share)
+	return share, true
+}
+
+// SetVaultShare sets the vault shares of an address
+func (k Keeper) SetVaultShare(ctx sdk.Context, share types.VaultShare) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(share)
+	ctx.KVStore(k.storeKey).Set(types.GetVaultShareKey(share.Owner, share.PoolName), bz)
+}
+
+// DeleteVaultShare deletes the vault shares of an address
+func (k Keeper) DeleteVaultShare(ctx sdk.Context, addr sdk.AccAddress, poolName string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetVaultShareKey(addr, poolName))
+}
+
+// GetVaultSharesForAccount gets the vault shares of an address in all the vaults
+func (k Keeper) GetVaultSharesForAccount(ctx sdk.Context, addr sdk.AccAddress) (shares []types.VaultShare) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetVaultSharePrefix(addr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var share types.VaultShare
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &share)
+		shares = append(shares, share)
+	}
+	return
+}
+
+// IterateAllVaultShares iterates over all the vault shares
+func (k Keeper) IterateAllVaultShares(ctx sdk.Context, handler func(share types.VaultShare) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VaultSharePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var share types.VaultShare
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &share)
+		if handler(share) {
+			break
+		}
+	}
+}
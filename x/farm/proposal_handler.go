@@ -1,18 +1,22 @@
 package farm
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
 	"github.com/okex/okexchain/x/farm/types"
 	govTypes "github.com/okex/okexchain/x/gov/types"
 )
 
-// NewManageWhiteListProposalHandler handles "gov" type message in "farm"
+// NewManageWhiteListProposalHandler handles "gov" type message in "farm", including the white list and pause proposals
 func NewManageWhiteListProposalHandler(k *Keeper) govTypes.Handler {
 	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
 		switch content := proposal.Content.(type) {
 		case types.ManageWhiteListProposal:
 			return handleManageWhiteListProposal(ctx, k, proposal)
+		case types.PausePoolProposal:
+			return handlePausePoolProposal(ctx, k, proposal)
 		default:
 			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
 		}
@@ -39,3 +43,32 @@ func handleManageWhiteListProposal(ctx sdk.Context, k *Keeper, proposal *govType
 	k.DeleteWhiteList(ctx, manageWhiteListProposal.PoolName)
 	return nil
 }
+
+func handlePausePoolProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	pausePoolProposal, ok := proposal.Content.(types.PausePoolProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+	if sdkErr := k.CheckPausePoolProposal(ctx, pausePoolProposal); sdkErr != nil {
+		return sdkErr
+	}
+
+	pool, _ := k.GetFarmPool(ctx, pausePoolProposal.PoolName)
+	// settle the yields before pausing, or shift the yield schedules when resuming
+	if pausePoolProposal.PauseYield {
+		pool = k.PausePoolYield(ctx, pool)
+	} else {
+		pool = k.ResumePoolYield(ctx, pool)
+	}
+	pool.LockPaused = pausePoolProposal.PauseLock
+	k.SetFarmPool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePausePool,
+		sdk.NewAttribute(types.AttributeKeyPool, pool.Name),
+		sdk.NewAttribute(types.AttributeKeyLockPaused, strconv.FormatBool(pool.LockPaused)),
+		sdk.NewAttribute(types.AttributeKeyYieldPaused, strconv.FormatBool(pool.IsYieldPaused())),
+	))
+	return nil
+}
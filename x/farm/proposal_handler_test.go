@@ -69,6 +69,43 @@ func TestProposalHandlerPassed(t *testing.T) {
 	require.True(t, inWhiteList(k.GetWhitelist(ctx), pool.Name))
 }
 
+func TestPausePoolProposalHandler(t *testing.T) {
+	tCtx := initEnvironment(t)
+	hdlr := NewManageWhiteListProposalHandler(&tCtx.k)
+
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	lock(t, tCtx, createPoolMsg)
+	poolName := createPoolMsg.PoolName
+
+	// the pool to pause must exist
+	proposal := govtypes.Proposal{Content: types.NewPausePoolProposal("Test", "description", "nonexistent", true, true)}
+	require.NotNil(t, hdlr(tCtx.ctx, &proposal))
+
+	// pause locking and yielding
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 2)
+	proposal = govtypes.Proposal{Content: types.NewPausePoolProposal("Test", "description", poolName, true, true)}
+	require.Nil(t, hdlr(tCtx.ctx, &proposal))
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, poolName)
+	require.True(t, found)
+	require.True(t, pool.LockPaused)
+	require.Equal(t, tCtx.ctx.BlockHeight(), pool.YieldPausedHeight)
+
+	// nothing is yielded while the pool is paused
+	pausedCtx := tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 5)
+	_, yieldedTokens := tCtx.k.CalculateAmountYieldedBetween(pausedCtx, pool)
+	require.True(t, yieldedTokens.IsZero())
+
+	// resume the pool, and the remaining tokens are yielded from now
+	proposal = govtypes.Proposal{Content: types.NewPausePoolProposal("Test", "description", poolName, false, false)}
+	require.Nil(t, hdlr(pausedCtx, &proposal))
+	pool, found = tCtx.k.GetFarmPool(pausedCtx, poolName)
+	require.True(t, found)
+	require.False(t, pool.LockPaused)
+	require.False(t, pool.IsYieldPaused())
+	require.Equal(t, pausedCtx.BlockHeight(), pool.YieldedTokenInfos[0].StartBlockHeightToYield)
+}
+
 func inWhiteList(list types.PoolNameList, name string) bool {
 	for _, poolName := range list {
 		if poolName == name {
@@ -12,7 +12,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnlock{}, "okexchain/farm/MsgUnlock", nil)
 	cdc.RegisterConcrete(MsgClaim{}, "okexchain/farm/MsgClaim", nil)
 	cdc.RegisterConcrete(MsgProvide{}, "okexchain/farm/MsgProvide", nil)
+	cdc.RegisterConcrete(MsgVaultDeposit{}, "okexchain/farm/MsgVaultDeposit", nil)
+	cdc.RegisterConcrete(MsgVaultWithdraw{}, "okexchain/farm/MsgVaultWithdraw", nil)
+	cdc.RegisterConcrete(MsgSetMinLockAmount{}, "okexchain/farm/MsgSetMinLockAmount", nil)
+	cdc.RegisterConcrete(MsgTransferPoolOwnership{}, "okexchain/farm/MsgTransferPoolOwnership", nil)
+	cdc.RegisterConcrete(MsgConfirmPoolOwnership{}, "okexchain/farm/MsgConfirmPoolOwnership", nil)
 	cdc.RegisterConcrete(ManageWhiteListProposal{}, "okexchain/farm/ManageWhiteListProposal", nil)
+	cdc.RegisterConcrete(PausePoolProposal{}, "okexchain/farm/PausePoolProposal", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -35,6 +35,17 @@ const (
 	CodeLockAmountBelowMinimum             uint32 = 66019
 	CodeSendCoinsFromModuleToAccountFailed uint32 = 66020
 	CodeSwapTokenPairNotExist              uint32 = 66021
+	CodeInvalidLockDuration                uint32 = 66022
+	CodeLockNotExpired                     uint32 = 66023
+	CodeYieldScheduleOverlapped            uint32 = 66024
+	CodeInvalidVaultPool                   uint32 = 66025
+	CodeNoVaultFound                       uint32 = 66026
+	CodeInsufficientVaultShares            uint32 = 66027
+	CodeLockPaused                         uint32 = 66028
+	CodeRepeatedOwnershipTransfer          uint32 = 66029
+	CodeNoOwnershipTransferFound           uint32 = 66030
+	CodeOwnershipTransferExpired           uint32 = 66031
+	CodeInvalidMinLockAmount               uint32 = 66032
 )
 
 // ErrInvalidInput returns an error when an input parameter is invalid
@@ -148,4 +159,68 @@ func ErrSendCoinsFromModuleToAccountFailed(content string) sdk.EnvelopedErr {
 // ErrSwapTokenPairNotExist returns an error when a swap token pair not exists
 func ErrSwapTokenPairNotExist(tokenName string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeSwapTokenPairNotExist, fmt.Sprintf("failed. swap token pair %s does not exist", tokenName))}
-}
\ No newline at end of file
+}
+
+// ErrInvalidLockDuration returns an error when the lock duration is not supported
+func ErrInvalidLockDuration(duration string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidLockDuration,
+		fmt.Sprintf("failed. lock duration %s is not supported, it should be one of 0, 1, 4 or 12 weeks", duration))}
+}
+
+// ErrLockNotExpired returns an error when unlocking tokens before the lock expires
+func ErrLockNotExpired(poolName string, unlockTime string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockNotExpired,
+		fmt.Sprintf("failed. the tokens locked in pool %s can not be unlocked until %s", poolName, unlockTime))}
+}
+
+// ErrYieldScheduleOverlapped returns an error when a new yield schedule starts before the last one of the token ends
+func ErrYieldScheduleOverlapped(denom string, endHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeYieldScheduleOverlapped,
+		fmt.Sprintf("failed. the start height to yield %s must not be less than %d, when the last schedule ends", denom, endHeight))}
+}
+
+// ErrInvalidVaultPool returns an error when the tokens locked in a pool are not the pool tokens of ammswap
+func ErrInvalidVaultPool(poolName string, denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidVaultPool,
+		fmt.Sprintf("failed. pool %s locks %s, only the pool locking ammswap pool tokens supports vault", poolName, denom))}
+}
+
+// ErrNoVaultFound returns an error when the vault of a pool doesn't exist
+func ErrNoVaultFound(poolName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeNoVaultFound, fmt.Sprintf("failed. vault of pool %s does not exist", poolName))}
+}
+
+// ErrInsufficientVaultShares returns an error when there is no enough vault shares to withdraw
+func ErrInsufficientVaultShares(shares string, inputShares string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInsufficientVaultShares,
+		fmt.Sprintf("failed. the vault shares %s is less than %s", shares, inputShares))}
+}
+
+// ErrLockPaused returns an error when locking of the pool is paused
+func ErrLockPaused(poolName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockPaused, fmt.Sprintf("failed. locking of pool %s is paused", poolName))}
+}
+
+// ErrRepeatedOwnershipTransfer returns an error when the ownership of a pool is being transferred
+func ErrRepeatedOwnershipTransfer(poolName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeRepeatedOwnershipTransfer,
+		fmt.Sprintf("failed. the ownership of pool %s is being transferred", poolName))}
+}
+
+// ErrNoOwnershipTransferFound returns an error when the pending ownership transfer of a pool doesn't exist
+func ErrNoOwnershipTransferFound(poolName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeNoOwnershipTransferFound,
+		fmt.Sprintf("failed. no ownership transfer of pool %s is found", poolName))}
+}
+
+// ErrOwnershipTransferExpired returns an error when the pending ownership transfer of a pool is expired
+func ErrOwnershipTransferExpired(poolName string, expire string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeOwnershipTransferExpired,
+		fmt.Sprintf("failed. the ownership transfer of pool %s expired at %s", poolName, expire))}
+}
+
+// ErrInvalidMinLockAmount returns an error when the denom of the min lock amount is changed with tokens locked
+func ErrInvalidMinLockAmount(poolName string, totalValueLocked string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidMinLockAmount,
+		fmt.Sprintf("failed. the denom of min lock amount can't be changed, when %s is locked in pool %s", totalValueLocked, poolName))}
+}
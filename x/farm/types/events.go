@@ -9,15 +9,31 @@ const (
 	EventTypeUnlock      = "unlock"
 	EventTypeClaim       = "claim"
 
+	EventTypeVaultDeposit  = "vault-deposit"
+	EventTypeVaultWithdraw = "vault-withdraw"
+	EventTypeVaultHarvest  = "vault-harvest"
+
+	EventTypeSetMinLockAmount      = "set-min-lock-amount"
+	EventTypeTransferPoolOwnership = "transfer-pool-ownership"
+	EventTypeConfirmPoolOwnership  = "confirm-pool-ownership"
+	EventTypePausePool             = "pause-pool"
+
 	AttributeKeyAddress             = "address"
 	AttributeKeyPool                = "pool"
 	AttributeKeyStartHeightToYield  = "start_height_to_yield"
 	AttributeKeyAmountYieldPerBlock = "amount_yield_per_block"
+	AttributeKeyEndHeightToYield    = "end_height_to_yield"
 	AttributeKeyMinLockAmount       = "min_lock_amount"
 	AttributeKeyYieldToken          = "yield_token"
 	AttributeKeyDeposit             = "deposit"
 	AttributeKeyWithdraw            = "withdraw"
 	AttributeKeyClaimed             = "claimed"
+	AttributeKeyLockDuration        = "lock_duration"
+	AttributeKeyShares              = "shares"
+	AttributeKeyCompounded          = "compounded"
+	AttributeKeyToAddress           = "to_address"
+	AttributeKeyLockPaused          = "lock_paused"
+	AttributeKeyYieldPaused         = "yield_paused"
 
 	AttributeValueCategory = ModuleName
 )
@@ -11,6 +11,7 @@ type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 }
 
 type BackendKeeper interface {
@@ -14,9 +14,18 @@ type FarmPool struct {
 	MinLockAmount sdk.SysCoin    `json:"min_lock_amount"`
 	DepositAmount sdk.SysCoin    `json:"deposit_amount"`
 	// sum of LockInfo.Amount
-	TotalValueLocked        sdk.SysCoin       `json:"total_value_locked"`
-	YieldedTokenInfos       YieldedTokenInfos `json:"yielded_token_infos"`
+	TotalValueLocked sdk.SysCoin `json:"total_value_locked"`
+	// sum of LockInfo.WeightedAmount, which the pool rewards are shared by
+	TotalWeightedValueLocked sdk.SysCoin `json:"total_weighted_value_locked"`
+	// the yielding schedules, one for each yielded token
+	YieldedTokenInfos YieldedTokenInfos `json:"yielded_token_infos"`
+	// the future schedules, which start yielding in order after the schedule of the same token finishes
+	QueuedYieldedTokenInfos YieldedTokenInfos `json:"queued_yielded_token_infos"`
 	TotalAccumulatedRewards sdk.SysCoins      `json:"total_accumulated_rewards"`
+	// locking is rejected while it's paused by governance
+	LockPaused bool `json:"lock_paused"`
+	// the height when yielding is paused by governance, zero if it's not paused
+	YieldPausedHeight int64 `json:"yield_paused_height"`
 }
 
 // NewFarmPool creates a new instance of FarmPool
@@ -25,17 +34,26 @@ func NewFarmPool(
 	yieldedTokenInfos YieldedTokenInfos, accumulatedRewards sdk.SysCoins,
 ) FarmPool {
 	return FarmPool{
-		Owner:                   owner,
-		Name:                    name,
-		MinLockAmount:           minLockAmount,
-		DepositAmount:           depositAmount,
-		TotalValueLocked:        totalValueLocked,
-		YieldedTokenInfos:       yieldedTokenInfos,
-		TotalAccumulatedRewards: accumulatedRewards,
+		Owner:                    owner,
+		Name:                     name,
+		MinLockAmount:            minLockAmount,
+		DepositAmount:            depositAmount,
+		TotalValueLocked:         totalValueLocked,
+		TotalWeightedValueLocked: totalValueLocked,
+		YieldedTokenInfos:        yieldedTokenInfos,
+		TotalAccumulatedRewards:  accumulatedRewards,
 	}
 }
 
+// IsYieldPaused returns whether yielding of the pool is paused
+func (fp FarmPool) IsYieldPaused() bool {
+	return fp.YieldPausedHeight > 0
+}
+
 func (fp FarmPool) Finished() bool {
+	if len(fp.QueuedYieldedTokenInfos) != 0 {
+		return false
+	}
 	for _, yieldedTokenInfo := range fp.YieldedTokenInfos {
 		if yieldedTokenInfo.RemainingAmount.IsPositive() {
 			return false
@@ -52,9 +70,15 @@ func (fp FarmPool) String() string {
   Min Lock Amount:      			    %s
   Deposit Amount:                   %s
   Total Value Locked:               %s
+  Total Weighted Value Locked:      %s
   Yielded Token Infos:			    %s
-  Total Accumulated Rewards:        %s`,
-		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked, fp.YieldedTokenInfos, fp.TotalAccumulatedRewards)
+  Queued Yielded Token Infos:       %s
+  Total Accumulated Rewards:        %s
+  Lock Paused:                      %t
+  Yield Paused Height:              %d`,
+		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked,
+		fp.TotalWeightedValueLocked, fp.YieldedTokenInfos, fp.QueuedYieldedTokenInfos, fp.TotalAccumulatedRewards,
+		fp.LockPaused, fp.YieldPausedHeight)
 }
 
 // FarmPools is a collection of FarmPool
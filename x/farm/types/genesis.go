@@ -32,12 +32,14 @@ type GenesisState struct {
 	PoolHistoricalRewards []PoolHistoricalRewardsRecord `json:"historical_rewards" yaml:"historical_rewards"`
 	PoolCurrentRewards    []PoolCurrentRewardsRecord    `json:"current_rewards" yaml:"current_rewards"`
 	WhiteList             PoolNameList                  `json:"pools_yield_native_token" yaml:"pools_yield_native_token"`
+	Vaults                Vaults                        `json:"vaults" yaml:"vaults"`
+	VaultShares           []VaultShare                  `json:"vault_shares" yaml:"vault_shares"`
 	Params                Params                        `json:"params" yaml:"params"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(pools FarmPools, lockInfos []LockInfo, histories []PoolHistoricalRewardsRecord,
-	currents []PoolCurrentRewardsRecord, whiteList PoolNameList, params Params,
+	currents []PoolCurrentRewardsRecord, whiteList PoolNameList, vaults Vaults, vaultShares []VaultShare, params Params,
 ) GenesisState {
 	return GenesisState{
 		Pools:                 pools,
@@ -45,6 +47,8 @@ func NewGenesisState(pools FarmPools, lockInfos []LockInfo, histories []PoolHist
 		PoolHistoricalRewards: histories,
 		PoolCurrentRewards:    currents,
 		WhiteList:             whiteList,
+		Vaults:                vaults,
+		VaultShares:           vaultShares,
 		Params:                params,
 	}
 }
@@ -56,6 +60,8 @@ func DefaultGenesisState() GenesisState {
 		LockInfos:             []LockInfo{},
 		PoolHistoricalRewards: []PoolHistoricalRewardsRecord{},
 		PoolCurrentRewards:    []PoolCurrentRewardsRecord{},
+		Vaults:                Vaults{},
+		VaultShares:           []VaultShare{},
 		Params:                DefaultParams(),
 	}
 }
@@ -77,5 +83,23 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("actual reference count(%d) is not equal to expected reference count(%d)",
 			actualReferenceCount, expectedReferenceCount)
 	}
+
+	vaultShares := make(map[string]sdk.Dec)
+	for _, share := range data.VaultShares {
+		if _, ok := vaultShares[share.PoolName]; !ok {
+			vaultShares[share.PoolName] = sdk.ZeroDec()
+		}
+		vaultShares[share.PoolName] = vaultShares[share.PoolName].Add(share.Shares)
+	}
+	for _, vault := range data.Vaults {
+		totalShares, ok := vaultShares[vault.PoolName]
+		if !ok {
+			totalShares = sdk.ZeroDec()
+		}
+		if !totalShares.Equal(vault.TotalShares) {
+			return fmt.Errorf("total shares(%s) of vault %s is not equal to the sum of shares(%s)",
+				vault.TotalShares, vault.PoolName, totalShares)
+		}
+	}
 	return nil
 }
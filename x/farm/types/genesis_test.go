@@ -4,18 +4,21 @@ import (
 	"errors"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState(t *testing.T) {
 	tests := []struct {
-		pools     FarmPools
-		lockInfos []LockInfo
-		histories []PoolHistoricalRewardsRecord
-		currents  []PoolCurrentRewardsRecord
-		whiteList PoolNameList
-		params    Params
-		err       error
+		pools       FarmPools
+		lockInfos   []LockInfo
+		histories   []PoolHistoricalRewardsRecord
+		currents    []PoolCurrentRewardsRecord
+		whiteList   PoolNameList
+		vaults      Vaults
+		vaultShares []VaultShare
+		params      Params
+		err         error
 	}{
 		{
 			pools:     FarmPools{FarmPool{}, FarmPool{}},
@@ -47,11 +50,24 @@ func TestGenesisState(t *testing.T) {
 			currents: []PoolCurrentRewardsRecord{PoolCurrentRewardsRecord{}, PoolCurrentRewardsRecord{}},
 			err:      errors.New(""),
 		},
+		{
+			vaults: Vaults{NewVault("pool", sdk.NewDec(3), 0)},
+			vaultShares: []VaultShare{
+				NewVaultShare(sdk.AccAddress("addr1"), "pool", sdk.NewDec(1)),
+				NewVaultShare(sdk.AccAddress("addr2"), "pool", sdk.NewDec(2)),
+			},
+			err: nil,
+		},
+		{
+			vaults:      Vaults{NewVault("pool", sdk.NewDec(3), 0)},
+			vaultShares: []VaultShare{NewVaultShare(sdk.AccAddress("addr1"), "pool", sdk.NewDec(1))},
+			err:         errors.New(""),
+		},
 	}
 
 	for _, test := range tests {
 		genesis := NewGenesisState(
-			test.pools, test.lockInfos, test.histories, test.currents, test.whiteList, test.params,
+			test.pools, test.lockInfos, test.histories, test.currents, test.whiteList, test.vaults, test.vaultShares, test.params,
 		)
 		if test.err != nil {
 			require.Error(t, ValidateGenesis(genesis))
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,9 @@ const (
 	// YieldFarmingAccount as module account to be used for saving all yield farming tokens
 	YieldFarmingAccount = "yield_farming_account"
 
+	// VaultAccount as module account to be used for holding the locked tokens and the rewards of all vaults
+	VaultAccount = "farm_vault_account"
+
 	// QuerierRoute to be used for querier msgs
 	QuerierRoute = ModuleName
 
@@ -39,12 +43,20 @@ var (
 	PoolsYieldNativeTokenPrefix = []byte{0x04}
 	PoolHistoricalRewardsPrefix = []byte{0x05}
 	PoolCurrentRewardsPrefix    = []byte{0x06}
+	VaultPrefix                 = []byte{0x07}
+	VaultSharePrefix            = []byte{0x08}
+	PoolOwnershipTransferPrefix = []byte{0x09}
+	LockUnlockTimePrefix        = []byte{0x0A}
 )
 
 const (
 	poolNameFromLockInfoKeyIndex = sdk.AddrLen + 1
 )
 
+var (
+	lockInfoFromUnlockTimeKeyIndex = len(LockUnlockTimePrefix) + len(sdk.FormatTimeBytes(time.Time{}))
+)
+
 func GetFarmPoolKey(poolName string) []byte {
 	return append(FarmPoolPrefix, []byte(poolName)...)
 }
@@ -87,3 +99,39 @@ func GetPoolHistoricalRewardsPrefix(poolName string) []byte {
 func GetPoolCurrentRewardsKey(poolName string) []byte {
 	return append(PoolCurrentRewardsPrefix, []byte(poolName)...)
 }
+
+// GetVaultKey gets the key for the vault of a pool
+func GetVaultKey(poolName string) []byte {
+	return append(VaultPrefix, []byte(poolName)...)
+}
+
+// GetVaultShareKey gets the key for the vault shares of an address
+func GetVaultShareKey(addr sdk.AccAddress, poolName string) []byte {
+	return append(VaultSharePrefix, append(addr.Bytes(), []byte(poolName)...)...)
+}
+
+// GetVaultSharePrefix gets the prefix key for all the vault shares of an address
+func GetVaultSharePrefix(addr sdk.AccAddress) []byte {
+	return append(VaultSharePrefix, addr.Bytes()...)
+}
+
+// GetPoolOwnershipTransferKey gets the key for the pending ownership transfer of a pool
+func GetPoolOwnershipTransferKey(poolName string) []byte {
+	return append(PoolOwnershipTransferPrefix, []byte(poolName)...)
+}
+
+// GetLockUnlockTimePrefix gets the prefix key for the boosted lock infos which expire at the unlock time
+func GetLockUnlockTimePrefix(unlockTime time.Time) []byte {
+	return append(LockUnlockTimePrefix, sdk.FormatTimeBytes(unlockTime)...)
+}
+
+// GetLockUnlockTimeKey gets the key for the unlock time index of a boosted lock info
+func GetLockUnlockTimeKey(unlockTime time.Time, addr sdk.AccAddress, poolName string) []byte {
+	return append(GetLockUnlockTimePrefix(unlockTime), append(addr.Bytes(), []byte(poolName)...)...)
+}
+
+// SplitLockUnlockTimeKey splits the address and the pool name out from a LockUnlockTimeKey
+func SplitLockUnlockTimeKey(key []byte) (addr sdk.AccAddress, poolName string) {
+	lockInfoKey := key[lockInfoFromUnlockTimeKeyIndex:]
+	return lockInfoKey[:sdk.AddrLen], string(lockInfoKey[sdk.AddrLen:])
+}
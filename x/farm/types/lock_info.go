@@ -2,9 +2,48 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// LockWeek is the time unit of the lock durations supported by farm pools
+const LockWeek = 7 * 24 * time.Hour
+
+// lockTier binds a supported lock duration to its reward multiplier
+type lockTier struct {
+	duration   time.Duration
+	multiplier sdk.Dec
+}
+
+// lockTiers are the supported lock durations, the longer a position is locked the heavier its reward weight is
+var lockTiers = []lockTier{
+	{0, sdk.OneDec()},
+	{LockWeek, sdk.MustNewDecFromStr("1.25")},
+	{4 * LockWeek, sdk.MustNewDecFromStr("1.5")},
+	{12 * LockWeek, sdk.NewDec(2)},
+}
+
+// IsValidLockDuration checks whether the lock duration is supported
+func IsValidLockDuration(duration time.Duration) bool {
+	for _, tier := range lockTiers {
+		if tier.duration == duration {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLockMultiplier returns the reward multiplier of a lock duration
+func GetLockMultiplier(duration time.Duration) sdk.Dec {
+	for _, tier := range lockTiers {
+		if tier.duration == duration {
+			return tier.multiplier
+		}
+	}
+	return sdk.OneDec()
+}
+
 // LockInfo is locked info of an address
 type LockInfo struct {
 	Owner            sdk.AccAddress `json:"owner"`
@@ -12,6 +51,8 @@ type LockInfo struct {
 	Amount           sdk.SysCoin    `json:"amount"`
 	StartBlockHeight int64          `json:"start_block_height"`
 	ReferencePeriod  uint64         `json:"reference_period"`
+	LockDuration     time.Duration  `json:"lock_duration"`
+	UnlockTime       time.Time      `json:"unlock_time"`
 }
 
 // NewLockInfo creates a new instance of LockInfo
@@ -25,6 +66,18 @@ func NewLockInfo(owner sdk.AccAddress, poolName string, amount sdk.SysCoin, star
 	}
 }
 
+// IsLocked checks whether the locked tokens are still not allowed to be unlocked at the given time
+func (li LockInfo) IsLocked(blockTime time.Time) bool {
+	return li.UnlockTime.After(blockTime)
+}
+
+// WeightedAmount returns the locked amount boosted by the multiplier of the lock duration,
+// which is the share of the lock info in the pool rewards. The lock duration is reset once the lock expires,
+// so the boost is dropped from the first block after the unlock time
+func (li LockInfo) WeightedAmount() sdk.SysCoin {
+	return sdk.NewDecCoinFromDec(li.Amount.Denom, li.Amount.Amount.Mul(GetLockMultiplier(li.LockDuration)))
+}
+
 // String returns a human readable string representation of LockInfo
 func (li LockInfo) String() string {
 	return fmt.Sprintf(`Lock Info:
@@ -32,6 +85,8 @@ func (li LockInfo) String() string {
   Pool Name:					%s
   Locked Amount:      			%s
   Start Block Height:           %d
-  Reference Period:             %d`,
-		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod)
+  Reference Period:             %d
+  Lock Duration:                %s
+  Unlock Time:                  %s`,
+		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod, li.LockDuration, li.UnlockTime)
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -168,16 +170,18 @@ func (m MsgProvide) GetSigners() []sdk.AccAddress {
 }
 
 type MsgLock struct {
-	PoolName string         `json:"pool_name" yaml:"pool_name"`
-	Address  sdk.AccAddress `json:"address" yaml:"address"`
-	Amount   sdk.SysCoin    `json:"amount" yaml:"amount"`
+	PoolName     string         `json:"pool_name" yaml:"pool_name"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	Amount       sdk.SysCoin    `json:"amount" yaml:"amount"`
+	LockDuration time.Duration  `json:"lock_duration" yaml:"lock_duration"`
 }
 
-func NewMsgLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin) MsgLock {
+func NewMsgLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin, lockDuration time.Duration) MsgLock {
 	return MsgLock{
-		PoolName: poolName,
-		Address:  address,
-		Amount:   amount,
+		PoolName:     poolName,
+		Address:      address,
+		Amount:       amount,
+		LockDuration: lockDuration,
 	}
 }
 
@@ -201,6 +205,9 @@ func (m MsgLock) ValidateBasic() sdk.Error {
 	if m.Amount.Amount.LTE(sdk.ZeroDec()) || !m.Amount.IsValid() {
 		return ErrInvalidInputAmount(m.Amount.Amount.String())
 	}
+	if !IsValidLockDuration(m.LockDuration) {
+		return ErrInvalidLockDuration(m.LockDuration.String())
+	}
 	return nil
 }
 
@@ -0,0 +1,148 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	setMinLockAmountMsgType      = "set_min_lock_amount"
+	transferPoolOwnershipMsgType = "transfer_pool_ownership"
+	confirmPoolOwnershipMsgType  = "confirm_pool_ownership"
+)
+
+// MsgSetMinLockAmount changes the min lock amount of a pool by its owner.
+// The denom can only be changed when there are no tokens locked in the pool
+type MsgSetMinLockAmount struct {
+	PoolName      string         `json:"pool_name" yaml:"pool_name"`
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	MinLockAmount sdk.SysCoin    `json:"min_lock_amount" yaml:"min_lock_amount"`
+}
+
+func NewMsgSetMinLockAmount(poolName string, address sdk.AccAddress, minLockAmount sdk.SysCoin) MsgSetMinLockAmount {
+	return MsgSetMinLockAmount{
+		PoolName:      poolName,
+		Address:       address,
+		MinLockAmount: minLockAmount,
+	}
+}
+
+var _ sdk.Msg = MsgSetMinLockAmount{}
+
+func (m MsgSetMinLockAmount) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetMinLockAmount) Type() string {
+	return setMinLockAmountMsgType
+}
+
+func (m MsgSetMinLockAmount) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	if m.MinLockAmount.Amount.LT(sdk.ZeroDec()) || !m.MinLockAmount.IsValid() {
+		return ErrInvalidInputAmount(m.MinLockAmount.String())
+	}
+	return nil
+}
+
+func (m MsgSetMinLockAmount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSetMinLockAmount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
+
+// MsgTransferPoolOwnership starts transferring the ownership of a pool, which completes after the new owner confirms it
+type MsgTransferPoolOwnership struct {
+	PoolName    string         `json:"pool_name" yaml:"pool_name"`
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+}
+
+func NewMsgTransferPoolOwnership(poolName string, from, to sdk.AccAddress) MsgTransferPoolOwnership {
+	return MsgTransferPoolOwnership{
+		PoolName:    poolName,
+		FromAddress: from,
+		ToAddress:   to,
+	}
+}
+
+var _ sdk.Msg = MsgTransferPoolOwnership{}
+
+func (m MsgTransferPoolOwnership) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferPoolOwnership) Type() string {
+	return transferPoolOwnershipMsgType
+}
+
+func (m MsgTransferPoolOwnership) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.FromAddress.Empty() || m.ToAddress.Empty() {
+		return ErrNilAddress()
+	}
+	if m.FromAddress.Equals(m.ToAddress) {
+		return ErrInvalidInput("the new owner must be different from the current owner")
+	}
+	return nil
+}
+
+func (m MsgTransferPoolOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgTransferPoolOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.FromAddress}
+}
+
+// MsgConfirmPoolOwnership confirms the pending ownership transfer of a pool by the new owner
+type MsgConfirmPoolOwnership struct {
+	PoolName string         `json:"pool_name" yaml:"pool_name"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewMsgConfirmPoolOwnership(poolName string, newOwner sdk.AccAddress) MsgConfirmPoolOwnership {
+	return MsgConfirmPoolOwnership{
+		PoolName: poolName,
+		Address:  newOwner,
+	}
+}
+
+var _ sdk.Msg = MsgConfirmPoolOwnership{}
+
+func (m MsgConfirmPoolOwnership) Route() string {
+	return RouterKey
+}
+
+func (m MsgConfirmPoolOwnership) Type() string {
+	return confirmPoolOwnershipMsgType
+}
+
+func (m MsgConfirmPoolOwnership) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	return nil
+}
+
+func (m MsgConfirmPoolOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgConfirmPoolOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
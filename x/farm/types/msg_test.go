@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -165,36 +166,55 @@ func TestMsgLock(t *testing.T) {
 		poolName string
 		addr     sdk.AccAddress
 		amount   sdk.SysCoin
+		duration time.Duration
 		errCode  uint32
 	}{
 		{
 			"pool",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
+			sdk.CodeOK,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			12 * LockWeek,
 			sdk.CodeOK,
 		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			2 * LockWeek,
+			CodeInvalidLockDuration,
+		},
 		{
 			"pool",
 			nil,
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
 			CodeInvalidAddress,
 		},
 		{
 			"",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
 			CodeInvalidInput,
 		},
 		{
 			"pool",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(0)),
+			0,
 			CodeInvalidInput,
 		},
 	}
 
 	for _, test := range tests {
-		msg := NewMsgLock(test.poolName, test.addr, test.amount)
+		msg := NewMsgLock(test.poolName, test.addr, test.amount, test.duration)
 		require.Equal(t, lockMsgType, msg.Type())
 		require.Equal(t, ModuleName, msg.Route())
 		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
@@ -294,3 +314,228 @@ func TestMsgClaim(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVaultDeposit(t *testing.T) {
+	tests := []struct {
+		poolName string
+		addr     sdk.AccAddress
+		amount   sdk.SysCoin
+		errCode  uint32
+	}{
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("ammswap_aab_ccb", sdk.NewDec(100)),
+			sdk.CodeOK,
+		},
+		{
+			"",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("ammswap_aab_ccb", sdk.NewDec(100)),
+			CodeInvalidInput,
+		},
+		{
+			"pool",
+			nil,
+			sdk.NewDecCoinFromDec("ammswap_aab_ccb", sdk.NewDec(100)),
+			CodeInvalidAddress,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("ammswap_aab_ccb", sdk.ZeroDec()),
+			CodeInvalidInputAmount,
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgVaultDeposit(test.poolName, test.addr, test.amount)
+		require.Equal(t, vaultDepositMsgType, msg.Type())
+		require.Equal(t, ModuleName, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		err := msg.ValidateBasic()
+		if test.errCode != sdk.CodeOK {
+			require.Error(t, err)
+			testCode(t, err, test.errCode)
+		}
+	}
+}
+
+func TestMsgVaultWithdraw(t *testing.T) {
+	tests := []struct {
+		poolName string
+		addr     sdk.AccAddress
+		shares   sdk.Dec
+		errCode  uint32
+	}{
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDec(1),
+			sdk.CodeOK,
+		},
+		{
+			"",
+			sdk.AccAddress{0x1},
+			sdk.NewDec(1),
+			CodeInvalidInput,
+		},
+		{
+			"pool",
+			nil,
+			sdk.NewDec(1),
+			CodeInvalidAddress,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDec(-1),
+			CodeInvalidInputAmount,
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgVaultWithdraw(test.poolName, test.addr, test.shares)
+		require.Equal(t, vaultWithdrawMsgType, msg.Type())
+		require.Equal(t, ModuleName, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		err := msg.ValidateBasic()
+		if test.errCode != sdk.CodeOK {
+			require.Error(t, err)
+			testCode(t, err, test.errCode)
+		}
+	}
+}
+
+func TestMsgSetMinLockAmount(t *testing.T) {
+	tests := []struct {
+		poolName      string
+		addr          sdk.AccAddress
+		minLockAmount sdk.SysCoin
+		errCode       uint32
+	}{
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(1)),
+			sdk.CodeOK,
+		},
+		{
+			"",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(1)),
+			CodeInvalidInput,
+		},
+		{
+			"pool",
+			nil,
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(1)),
+			CodeInvalidAddress,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.SysCoin{Denom: "xxb", Amount: sdk.NewDec(-1)},
+			CodeInvalidInputAmount,
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgSetMinLockAmount(test.poolName, test.addr, test.minLockAmount)
+		require.Equal(t, setMinLockAmountMsgType, msg.Type())
+		require.Equal(t, ModuleName, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		err := msg.ValidateBasic()
+		if test.errCode != sdk.CodeOK {
+			require.Error(t, err)
+			testCode(t, err, test.errCode)
+		}
+	}
+}
+
+func TestMsgTransferPoolOwnership(t *testing.T) {
+	tests := []struct {
+		poolName string
+		from     sdk.AccAddress
+		to       sdk.AccAddress
+		errCode  uint32
+	}{
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.AccAddress{0x2},
+			sdk.CodeOK,
+		},
+		{
+			"",
+			sdk.AccAddress{0x1},
+			sdk.AccAddress{0x2},
+			CodeInvalidInput,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			nil,
+			CodeInvalidAddress,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.AccAddress{0x1},
+			CodeInvalidInput,
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgTransferPoolOwnership(test.poolName, test.from, test.to)
+		require.Equal(t, transferPoolOwnershipMsgType, msg.Type())
+		require.Equal(t, ModuleName, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.from}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		err := msg.ValidateBasic()
+		if test.errCode != sdk.CodeOK {
+			require.Error(t, err)
+			testCode(t, err, test.errCode)
+		}
+	}
+}
+
+func TestMsgConfirmPoolOwnership(t *testing.T) {
+	tests := []struct {
+		poolName string
+		addr     sdk.AccAddress
+		errCode  uint32
+	}{
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.CodeOK,
+		},
+		{
+			"",
+			sdk.AccAddress{0x1},
+			CodeInvalidInput,
+		},
+		{
+			"pool",
+			nil,
+			CodeInvalidAddress,
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgConfirmPoolOwnership(test.poolName, test.addr)
+		require.Equal(t, confirmPoolOwnershipMsgType, msg.Type())
+		require.Equal(t, ModuleName, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		err := msg.ValidateBasic()
+		if test.errCode != sdk.CodeOK {
+			require.Error(t, err)
+			testCode(t, err, test.errCode)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	vaultDepositMsgType  = "vault_deposit"
+	vaultWithdrawMsgType = "vault_withdraw"
+)
+
+// MsgVaultDeposit deposits pool tokens into the vault of a farm pool in exchange for vault shares
+type MsgVaultDeposit struct {
+	PoolName string         `json:"pool_name" yaml:"pool_name"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Amount   sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+func NewMsgVaultDeposit(poolName string, address sdk.AccAddress, amount sdk.SysCoin) MsgVaultDeposit {
+	return MsgVaultDeposit{
+		PoolName: poolName,
+		Address:  address,
+		Amount:   amount,
+	}
+}
+
+var _ sdk.Msg = MsgVaultDeposit{}
+
+func (m MsgVaultDeposit) Route() string {
+	return RouterKey
+}
+
+func (m MsgVaultDeposit) Type() string {
+	return vaultDepositMsgType
+}
+
+func (m MsgVaultDeposit) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	if m.Amount.Amount.LTE(sdk.ZeroDec()) || !m.Amount.IsValid() {
+		return ErrInvalidInputAmount(m.Amount.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgVaultDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgVaultDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
+
+// MsgVaultWithdraw redeems vault shares for the pool tokens compounded by the vault
+type MsgVaultWithdraw struct {
+	PoolName string         `json:"pool_name" yaml:"pool_name"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Shares   sdk.Dec        `json:"shares" yaml:"shares"`
+}
+
+func NewMsgVaultWithdraw(poolName string, address sdk.AccAddress, shares sdk.Dec) MsgVaultWithdraw {
+	return MsgVaultWithdraw{
+		PoolName: poolName,
+		Address:  address,
+		Shares:   shares,
+	}
+}
+
+var _ sdk.Msg = MsgVaultWithdraw{}
+
+func (m MsgVaultWithdraw) Route() string {
+	return RouterKey
+}
+
+func (m MsgVaultWithdraw) Type() string {
+	return vaultWithdrawMsgType
+}
+
+func (m MsgVaultWithdraw) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	if m.Shares.IsNil() || !m.Shares.IsPositive() {
+		return ErrInvalidInputAmount(m.Shares.String())
+	}
+	return nil
+}
+
+func (m MsgVaultWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgVaultWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
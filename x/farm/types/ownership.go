@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultOwnershipConfirmWindow defines the default window for the new owner to confirm the ownership of a pool
+const DefaultOwnershipConfirmWindow = 24 * time.Hour
+
+// PoolOwnershipTransfer is the pending ownership transfer of a pool, which waits for the confirmation of the new owner
+type PoolOwnershipTransfer struct {
+	PoolName    string         `json:"pool_name"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Expire      time.Time      `json:"expire"`
+}
+
+// NewPoolOwnershipTransfer creates a new instance of PoolOwnershipTransfer
+func NewPoolOwnershipTransfer(poolName string, from, to sdk.AccAddress, expire time.Time) PoolOwnershipTransfer {
+	return PoolOwnershipTransfer{
+		PoolName:    poolName,
+		FromAddress: from,
+		ToAddress:   to,
+		Expire:      expire,
+	}
+}
+
+// String returns a human readable string representation of PoolOwnershipTransfer
+func (t PoolOwnershipTransfer) String() string {
+	return fmt.Sprintf(`Pool Ownership Transfer:
+  Pool Name:                %s
+  From Address:             %s
+  To Address:               %s
+  Expire:                   %s`,
+		t.PoolName, t.FromAddress, t.ToAddress, t.Expire)
+}
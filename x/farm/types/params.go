@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/okex/okexchain/x/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +16,12 @@ const (
 	defaultQuoteSymbol       = "usdk"
 	defaultCreatePoolFee     = "0"
 	defaultCreatePoolDeposit = "10"
+
+	defaultVaultHarvestInterval int64 = 100
+)
+
+var (
+	defaultVaultMaxSlippage = sdk.NewDecWithPrec(3, 2)
 )
 
 // Parameter store keys
@@ -22,6 +30,10 @@ var (
 	KeyCreatePoolFee     = []byte("CreatePoolFee")
 	KeyCreatePoolDeposit = []byte("CreatePoolDeposit")
 	keyYieldNativeToken  = []byte("YieldNativeToken")
+
+	KeyVaultHarvestInterval   = []byte("VaultHarvestInterval")
+	KeyOwnershipConfirmWindow = []byte("OwnershipConfirmWindow")
+	KeyVaultMaxSlippage       = []byte("VaultMaxSlippage")
 )
 
 // ParamKeyTable for farm module
@@ -36,6 +48,12 @@ type Params struct {
 	CreatePoolDeposit sdk.SysCoin `json:"create_pool_deposit"`
 	// proposal params
 	YieldNativeToken bool `json:"yield_native_token"`
+	// the number of blocks between two harvests of a vault
+	VaultHarvestInterval int64 `json:"vault_harvest_interval"`
+	// the window for the new owner to confirm the ownership transfer of a pool
+	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+	// the max fraction the swaps of a vault harvest may receive below the time-weighted average price
+	VaultMaxSlippage sdk.Dec `json:"vault_max_slippage"`
 }
 
 // String implements the stringer interface for Params
@@ -44,8 +62,12 @@ func (p Params) String() string {
   Quote Symbol:								%s
   Create Pool Fee:							%s
   Create Pool Deposit:						%s
-  Yield Native Token Enabled:               %v`,
-		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken)
+  Yield Native Token Enabled:               %v
+  Vault Harvest Interval:                   %d
+  Ownership Confirm Window:                 %s
+  Vault Max Slippage:                       %s`,
+		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken, p.VaultHarvestInterval,
+		p.OwnershipConfirmWindow, p.VaultMaxSlippage)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -55,15 +77,21 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCreatePoolFee, Value: &p.CreatePoolFee, ValidatorFn: common.ValidateSysCoin("create pool fee")},
 		{Key: KeyCreatePoolDeposit, Value: &p.CreatePoolDeposit, ValidatorFn: common.ValidateSysCoin("create pool deposit")},
 		{Key: keyYieldNativeToken, Value: &p.YieldNativeToken, ValidatorFn: common.ValidateBool("yield native token")},
+		{Key: KeyVaultHarvestInterval, Value: &p.VaultHarvestInterval, ValidatorFn: common.ValidateInt64Positive("vault harvest interval")},
+		{Key: KeyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: common.ValidateDurationPositive("ownership confirm window")},
+		{Key: KeyVaultMaxSlippage, Value: &p.VaultMaxSlippage, ValidatorFn: common.ValidateRateNotNeg("vault max slippage")},
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return Params{
-		QuoteSymbol:       defaultQuoteSymbol,
-		CreatePoolFee:     sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolFee)),
-		CreatePoolDeposit: sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolDeposit)),
-		YieldNativeToken:  false,
+		QuoteSymbol:            defaultQuoteSymbol,
+		CreatePoolFee:          sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolFee)),
+		CreatePoolDeposit:      sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolDeposit)),
+		YieldNativeToken:       false,
+		VaultHarvestInterval:   defaultVaultHarvestInterval,
+		OwnershipConfirmWindow: DefaultOwnershipConfirmWindow,
+		VaultMaxSlippage:       defaultVaultMaxSlippage,
 	}
 }
@@ -12,7 +12,10 @@ const (
   Quote Symbol:								usdk
   Create Pool Fee:							0.000000000000000000` + sdk.DefaultBondDenom + `
   Create Pool Deposit:						10.000000000000000000` + sdk.DefaultBondDenom + `
-  Yield Native Token Enabled:               false`
+  Yield Native Token Enabled:               false
+  Vault Harvest Interval:                   100
+  Ownership Confirm Window:                 24h0m0s
+  Vault Max Slippage:                       0.030000000000000000`
 )
 
 func TestParams(t *testing.T) {
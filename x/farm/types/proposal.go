@@ -11,14 +11,21 @@ import (
 const (
 	// proposalTypeManageWhiteList defines the type for a ManageWhiteListProposal
 	proposalTypeManageWhiteList = "ManageWhiteList"
+	// proposalTypePausePool defines the type for a PausePoolProposal
+	proposalTypePausePool = "PausePool"
 )
 
 func init() {
 	govtypes.RegisterProposalType(proposalTypeManageWhiteList)
 	govtypes.RegisterProposalTypeCodec(ManageWhiteListProposal{}, "okexchain/farm/ManageWhiteListProposal")
+	govtypes.RegisterProposalType(proposalTypePausePool)
+	govtypes.RegisterProposalTypeCodec(PausePoolProposal{}, "okexchain/farm/PausePoolProposal")
 }
 
-var _ govtypes.Content = (*ManageWhiteListProposal)(nil)
+var (
+	_ govtypes.Content = (*ManageWhiteListProposal)(nil)
+	_ govtypes.Content = (*PausePoolProposal)(nil)
+)
 
 // ManageWhiteListProposal - structure for the proposal to add or delete a pool name from white list
 type ManageWhiteListProposal struct {
@@ -96,3 +103,83 @@ func (mp ManageWhiteListProposal) String() string {
  IsAdded:				%t`,
 		mp.Title, mp.Description, mp.ProposalType(), mp.PoolName, mp.IsAdded)
 }
+
+// PausePoolProposal - structure for the proposal to pause or resume locking and yielding of a pool in emergency
+type PausePoolProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	PoolName    string `json:"pool_name" yaml:"pool_name"`
+	PauseLock   bool   `json:"pause_lock" yaml:"pause_lock"`
+	PauseYield  bool   `json:"pause_yield" yaml:"pause_yield"`
+}
+
+// NewPausePoolProposal creates a new instance of PausePoolProposal
+func NewPausePoolProposal(title, description, poolName string, pauseLock, pauseYield bool) PausePoolProposal {
+	return PausePoolProposal{
+		Title:       title,
+		Description: description,
+		PoolName:    poolName,
+		PauseLock:   pauseLock,
+		PauseYield:  pauseYield,
+	}
+}
+
+// GetTitle returns title of a pause pool proposal object
+func (pp PausePoolProposal) GetTitle() string {
+	return pp.Title
+}
+
+// GetDescription returns description of a pause pool proposal object
+func (pp PausePoolProposal) GetDescription() string {
+	return pp.Description
+}
+
+// ProposalRoute returns route key of a pause pool proposal object
+func (pp PausePoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a pause pool proposal object
+func (pp PausePoolProposal) ProposalType() string {
+	return proposalTypePausePool
+}
+
+// ValidateBasic validates a pause pool proposal
+func (pp PausePoolProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(pp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(pp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(pp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(pp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if pp.ProposalType() != proposalTypePausePool {
+		return govtypes.ErrInvalidProposalType(pp.ProposalType())
+	}
+
+	if len(pp.PoolName) == 0 {
+		return govtypes.ErrInvalidProposalContent("pool name is required")
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a PausePoolProposal
+func (pp PausePoolProposal) String() string {
+	return fmt.Sprintf(`PausePoolProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ PoolName:				%s
+ PauseLock:				%t
+ PauseYield:			%t`,
+		pp.Title, pp.Description, pp.ProposalType(), pp.PoolName, pp.PauseLock, pp.PauseYield)
+}
@@ -3,19 +3,24 @@ package types
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 const (
-	QueryPool             = "pool"
-	QueryPools            = "pools"
-	QueryEarnings         = "earnings"
-	QueryLockInfo         = "lock-info"
-	QueryParameters       = "parameters"
-	QueryWhitelist        = "whitelist"
-	QueryAccount          = "account"
-	QueryAccountsLockedTo = "accounts-locked-to"
-	QueryPoolNum          = "pool-num"
+	QueryPool              = "pool"
+	QueryPools             = "pools"
+	QueryEarnings          = "earnings"
+	QueryLockInfo          = "lock-info"
+	QueryParameters        = "parameters"
+	QueryWhitelist         = "whitelist"
+	QueryAccount           = "account"
+	QueryAccountsLockedTo  = "accounts-locked-to"
+	QueryPoolNum           = "pool-num"
+	QueryVault             = "vault"
+	QueryVaultShares       = "vault-shares"
+	QueryOwnershipTransfer = "ownership-transfer"
 )
 
 // QueryPoolParams defines the params for the following queries:
 // - 'custom/farm/pool'
+// - 'custom/farm/vault'
+// - 'custom/farm/ownership-transfer'
 type QueryPoolParams struct {
 	PoolName string
 }
@@ -59,6 +64,7 @@ func NewQueryPoolAccountParams(poolName string, accAddr sdk.AccAddress) QueryPoo
 
 // QueryAccountParams defines the params for the following queries:
 // - 'custom/farm/account'
+// - 'custom/farm/vault-shares'
 type QueryAccountParams struct {
 	AccAddress sdk.AccAddress
 }
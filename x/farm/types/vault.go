@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// Vault locks the pool tokens of its depositors in a farm pool, and compounds the rewards into
+// the locked pool tokens periodically
+type Vault struct {
+	PoolName          string  `json:"pool_name"`
+	TotalShares       sdk.Dec `json:"total_shares"`
+	LastHarvestHeight int64   `json:"last_harvest_height"`
+	// the rewards claimed but not compounded yet since they fail to be swapped, which are held by the vault account
+	// and compounded with the rewards of the next harvest
+	PendingRewards sdk.SysCoins `json:"pending_rewards"`
+}
+
+// NewVault creates a new instance of Vault
+func NewVault(poolName string, totalShares sdk.Dec, lastHarvestHeight int64) Vault {
+	return Vault{
+		PoolName:          poolName,
+		TotalShares:       totalShares,
+		LastHarvestHeight: lastHarvestHeight,
+	}
+}
+
+// String returns a human readable string representation of Vault
+func (v Vault) String() string {
+	return fmt.Sprintf(`Vault:
+  Pool Name:                %s
+  Total Shares:             %s
+  Last Harvest Height:      %d
+  Pending Rewards:          %s`,
+		v.PoolName, v.TotalShares, v.LastHarvestHeight, v.PendingRewards)
+}
+
+// Vaults is a collection of Vault
+type Vaults []Vault
+
+// String returns a human readable string representation of Vaults
+func (vs Vaults) String() (out string) {
+	for _, v := range vs {
+		out += v.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// VaultShare is the shares of a vault held by a depositor
+type VaultShare struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	PoolName string         `json:"pool_name"`
+	Shares   sdk.Dec        `json:"shares"`
+}
+
+// NewVaultShare creates a new instance of VaultShare
+func NewVaultShare(owner sdk.AccAddress, poolName string, shares sdk.Dec) VaultShare {
+	return VaultShare{
+		Owner:    owner,
+		PoolName: poolName,
+		Shares:   shares,
+	}
+}
+
+// String returns a human readable string representation of VaultShare
+func (vs VaultShare) String() string {
+	return fmt.Sprintf(`Vault Share:
+  Owner:                    %s
+  Pool Name:                %s
+  Shares:                   %s`,
+		vs.Owner, vs.PoolName, vs.Shares)
+}
+
+// VaultAddress returns the address of the vault account, which is the owner of the lock infos of all the vaults
+func VaultAddress() sdk.AccAddress {
+	return supply.NewModuleAddress(VaultAccount)
+}
+
+// VaultDetail is the vault with the pool tokens locked by it, which is returned by the vault query
+type VaultDetail struct {
+	Vault
+	LockedAmount sdk.SysCoin `json:"locked_amount"`
+}
+
+// NewVaultDetail creates a new instance of VaultDetail
+func NewVaultDetail(vault Vault, lockedAmount sdk.SysCoin) VaultDetail {
+	return VaultDetail{
+		Vault:        vault,
+		LockedAmount: lockedAmount,
+	}
+}
+
+// String returns a human readable string representation of VaultDetail
+func (vd VaultDetail) String() string {
+	return fmt.Sprintf(`%s
+  Locked Amount:            %s`,
+		vd.Vault.String(), vd.LockedAmount)
+}
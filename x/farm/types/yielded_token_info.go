@@ -12,26 +12,44 @@ type YieldedTokenInfo struct {
 	RemainingAmount         sdk.SysCoin `json:"remaining_amount"`
 	StartBlockHeightToYield int64       `json:"start_block_height_to_yield"`
 	AmountYieldedPerBlock   sdk.Dec     `json:"amount_yielded_per_block"`
+	// the height when all of the provided tokens are yielded, 0 means nothing to yield
+	EndBlockHeightToYield int64 `json:"end_block_height_to_yield"`
 }
 
-// NewYieldedTokenInfo creates a new instance of YieldedTokenInfo
+// NewYieldedTokenInfo creates a new instance of YieldedTokenInfo, the end height is calculated by
+// how many blocks it takes to yield the remaining amount
 func NewYieldedTokenInfo(
 	remainingAmount sdk.SysCoin, startBlockHeightToYield int64, amountYieldedPerBlock sdk.Dec,
 ) YieldedTokenInfo {
+	var endBlockHeightToYield int64
+	if startBlockHeightToYield != 0 && amountYieldedPerBlock.IsPositive() {
+		blocks := remainingAmount.Amount.QuoTruncate(amountYieldedPerBlock).TruncateInt64()
+		if amountYieldedPerBlock.MulInt64(blocks).LT(remainingAmount.Amount) {
+			blocks++
+		}
+		endBlockHeightToYield = startBlockHeightToYield + blocks
+	}
 	return YieldedTokenInfo{
 		RemainingAmount:         remainingAmount,
 		StartBlockHeightToYield: startBlockHeightToYield,
 		AmountYieldedPerBlock:   amountYieldedPerBlock,
+		EndBlockHeightToYield:   endBlockHeightToYield,
 	}
 }
 
+// IsFinished checks whether all of the tokens in the yielded token info have been yielded
+func (yti YieldedTokenInfo) IsFinished() bool {
+	return !yti.RemainingAmount.IsPositive()
+}
+
 // String returns a human readable string representation of a YieldedTokenInfo
 func (yti YieldedTokenInfo) String() string {
 	return fmt.Sprintf(`YieldedTokenInfo：
   RemainingAmount:					%s
   Start Block Height To Yield:		%d
+  End Block Height To Yield:		%d
   AmountYieldedPerBlock:			%s`,
-		yti.RemainingAmount, yti.StartBlockHeightToYield, yti.AmountYieldedPerBlock)
+		yti.RemainingAmount, yti.StartBlockHeightToYield, yti.EndBlockHeightToYield, yti.AmountYieldedPerBlock)
 }
 
 // YieldedTokenInfos is a collection of YieldedTokenInfo
@@ -42,6 +60,26 @@ func NewYieldedTokenInfos(yieldedTokenInfos ...YieldedTokenInfo) YieldedTokenInf
 	return yieldedTokenInfos
 }
 
+// IndexOf returns the index of the first yielded token info with the denom, -1 if not found
+func (ytis YieldedTokenInfos) IndexOf(denom string) int {
+	for i, yti := range ytis {
+		if yti.RemainingAmount.Denom == denom {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastEndBlockHeight returns the latest end height of the yielded token infos with the denom
+func (ytis YieldedTokenInfos) LastEndBlockHeight(denom string) (height int64) {
+	for _, yti := range ytis {
+		if yti.RemainingAmount.Denom == denom && yti.EndBlockHeightToYield > height {
+			height = yti.EndBlockHeightToYield
+		}
+	}
+	return height
+}
+
 // String returns a human readable string representation of YieldedTokenInfos
 func (ytis YieldedTokenInfos) String() (out string) {
 	for _, yti := range ytis {
@@ -14,6 +14,17 @@ func TestYieldedTokenInfo(t *testing.T) {
 		sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), 50, sdk.NewDec(20),
 	)
 	yieldInfos := NewYieldedTokenInfos(yieldInfo1, yieldInfo2)
+	require.Equal(t, int64(110), yieldInfo1.EndBlockHeightToYield)
+	require.Equal(t, int64(55), yieldInfo2.EndBlockHeightToYield)
+	require.Equal(t, 0, yieldInfos.IndexOf("xxb"))
+	require.Equal(t, -1, yieldInfos.IndexOf("yyb"))
+	require.Equal(t, int64(110), yieldInfos.LastEndBlockHeight("xxb"))
+
+	// the last block yields less than amount yielded per block
+	yieldInfo3 := NewYieldedTokenInfo(
+		sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), 1, sdk.NewDec(30),
+	)
+	require.Equal(t, int64(5), yieldInfo3.EndBlockHeightToYield)
 
 	require.Equal(t, yieldInfos.String(), yieldInfo1.String()+"\n"+yieldInfo2.String())
 }
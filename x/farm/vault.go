@@ -0,0 +1,198 @@
+package farm
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap"
+	swaptypes "github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/farm/keeper"
+	"github.com/okex/okexchain/x/farm/types"
+)
+
+// harvestVaults harvests every vault whose harvest interval has passed
+func harvestVaults(ctx sdk.Context, k keeper.Keeper) {
+	interval := k.GetParams(ctx).VaultHarvestInterval
+	for _, vault := range k.GetVaults(ctx) {
+		if ctx.BlockHeight()-vault.LastHarvestHeight < interval {
+			continue
+		}
+		harvestVault(ctx, k, vault)
+	}
+}
+
+// harvestVault claims the rewards of the vault, swaps them into the constituents of the ammswap pool,
+// adds liquidity with them and locks the minted pool tokens for the vault again.
+// The reward tokens which fail to be swapped into the quote token are skipped and kept pending to the next harvest.
+// Nothing but the harvest height is changed if any other step fails, e.g. a swap of the constituents receives less
+// than the time-weighted average price allows, and the rewards are compounded next time
+func harvestVault(ctx sdk.Context, k keeper.Keeper, vault types.Vault) {
+	logger := k.Logger(ctx)
+	vault.LastHarvestHeight = ctx.BlockHeight()
+	defer func() { k.SetVault(ctx, vault) }()
+
+	if !k.HasLockInfo(ctx, types.VaultAddress(), vault.PoolName) {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	compounded, pendingRewards, err := compoundVault(cacheCtx, k, vault)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to harvest the vault of pool %s: %s", vault.PoolName, err.Error()))
+		return
+	}
+	write()
+	vault.PendingRewards = pendingRewards
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVaultHarvest,
+		sdk.NewAttribute(types.AttributeKeyPool, vault.PoolName),
+		sdk.NewAttribute(types.AttributeKeyCompounded, compounded.String()),
+	))
+}
+
+// compoundVault compounds the rewards of the vault into the locked pool tokens, and returns the reward tokens
+// skipped since they fail to be swapped
+func compoundVault(ctx sdk.Context, k keeper.Keeper, vault types.Vault) (
+	compounded sdk.SysCoin, pendingRewards sdk.SysCoins, err error) {
+	logger := k.Logger(ctx)
+	vaultAddr := types.VaultAddress()
+	poolName := vault.PoolName
+	pool, found := k.GetFarmPool(ctx, poolName)
+	if !found {
+		return compounded, nil, types.ErrNoFarmPoolFound(poolName)
+	}
+	base, quote := swaptypes.SplitPoolToken(pool.MinLockAmount.Denom)
+
+	// the vault account holds the balances of all the vaults, so only the amount gained since now is compounded
+	initial := k.TokenKeeper().GetCoins(ctx, vaultAddr)
+	gained := func(denom string) sdk.Dec {
+		return k.TokenKeeper().GetCoins(ctx, vaultAddr).AmountOf(denom).Sub(initial.AmountOf(denom))
+	}
+
+	// 1. claim the rewards, which are compounded with the pending ones
+	if _, err = handleMsgClaim(ctx, k, types.NewMsgClaim(poolName, vaultAddr)); err != nil {
+		return compounded, nil, err
+	}
+	rewards := k.TokenKeeper().GetCoins(ctx, vaultAddr).Sub(initial).Add2(vault.PendingRewards)
+	if rewards.IsZero() {
+		return compounded, nil, types.ErrInvalidInputAmount(rewards.String())
+	}
+
+	// 2. swap the rewards which are neither of the constituents into the quote token. a reward token which fails to
+	// be swapped, e.g. it has no swap token pair with the quote token, doesn't stop the others from being compounded
+	swapHandler := ammswap.NewHandler(k.SwapKeeper())
+	for _, reward := range rewards {
+		if reward.Denom == base || reward.Denom == quote {
+			continue
+		}
+		if err := swapVaultReward(ctx, k, swapHandler, reward, quote); err != nil {
+			logger.Error(fmt.Sprintf("failed to swap the reward %s of the vault of pool %s, it's kept pending: %s",
+				reward, poolName, err.Error()))
+			pendingRewards = pendingRewards.Add2(sdk.SysCoins{reward})
+		}
+	}
+
+	// 3. swap half of the surplus of one constituent into the other one, to add liquidity at the pool price
+	swapTokenPair, err := k.SwapKeeper().GetSwapTokenPair(ctx, swaptypes.GetSwapTokenPairName(base, quote))
+	if err != nil {
+		return compounded, nil, err
+	}
+	if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+		return compounded, nil, swaptypes.ErrIsZeroValue("base pooled coin or quote pooled coin")
+	}
+	baseAmount, quoteAmount := gained(base), gained(quote)
+	baseInQuote := baseAmount.MulTruncate(swapTokenPair.QuotePooledCoin.Amount).QuoTruncate(swapTokenPair.BasePooledCoin.Amount)
+	var surplus sdk.SysCoin
+	var target string
+	if baseInQuote.GT(quoteAmount) {
+		quoteInBase := quoteAmount.MulTruncate(swapTokenPair.BasePooledCoin.Amount).QuoTruncate(swapTokenPair.QuotePooledCoin.Amount)
+		surplus = sdk.NewDecCoinFromDec(base, baseAmount.Sub(quoteInBase).QuoInt64(2))
+		target = quote
+	} else {
+		surplus = sdk.NewDecCoinFromDec(quote, quoteAmount.Sub(baseInQuote).QuoInt64(2))
+		target = base
+	}
+	if surplus.IsPositive() {
+		minBoughtTokenAmount, err := getMinBoughtTokenAmount(ctx, k, surplus, target)
+		if err != nil {
+			return compounded, nil, err
+		}
+		msg := swaptypes.NewMsgTokenToToken(surplus, minBoughtTokenAmount, ctx.BlockTime().Unix(), vaultAddr, vaultAddr)
+		if _, err = swapHandler(ctx, msg); err != nil {
+			return compounded, nil, err
+		}
+	}
+
+	// 4. add liquidity with the constituents
+	swapTokenPair, err = k.SwapKeeper().GetSwapTokenPair(ctx, swaptypes.GetSwapTokenPairName(base, quote))
+	if err != nil {
+		return compounded, nil, err
+	}
+	baseAmount, quoteAmount = gained(base), gained(quote)
+	if maxQuote := baseAmount.MulTruncate(swapTokenPair.QuotePooledCoin.Amount).
+		QuoTruncate(swapTokenPair.BasePooledCoin.Amount); quoteAmount.GT(maxQuote) {
+		quoteAmount = maxQuote
+	}
+	addLiquidityMsg := swaptypes.NewMsgAddLiquidity(sdk.ZeroDec(), sdk.NewDecCoinFromDec(base, baseAmount),
+		sdk.NewDecCoinFromDec(quote, quoteAmount), ctx.BlockTime().Unix(), vaultAddr)
+	if _, err = swapHandler(ctx, addLiquidityMsg); err != nil {
+		return compounded, nil, err
+	}
+
+	// 5. lock the minted pool tokens for the vault
+	compounded = sdk.NewDecCoinFromDec(pool.MinLockAmount.Denom, gained(pool.MinLockAmount.Denom))
+	if !compounded.IsPositive() {
+		return compounded, nil, types.ErrInvalidInputAmount(compounded.String())
+	}
+	if _, err = handleMsgLock(ctx, k, types.NewMsgLock(poolName, vaultAddr, compounded, 0)); err != nil {
+		return compounded, nil, err
+	}
+	return compounded, pendingRewards, nil
+}
+
+// swapVaultReward swaps the reward token held by the vault account into the bought token, which is all or nothing
+func swapVaultReward(ctx sdk.Context, k keeper.Keeper, swapHandler sdk.Handler, reward sdk.SysCoin,
+	boughtTokenDenom string) error {
+	vaultAddr := types.VaultAddress()
+	minBoughtTokenAmount, err := getMinBoughtTokenAmount(ctx, k, reward, boughtTokenDenom)
+	if err != nil {
+		return err
+	}
+	cacheCtx, write := ctx.CacheContext()
+	msg := swaptypes.NewMsgTokenToToken(reward, minBoughtTokenAmount, ctx.BlockTime().Unix(), vaultAddr, vaultAddr)
+	if _, err = swapHandler(cacheCtx, msg); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// getMinBoughtTokenAmount bounds the token bought with the sold token by the time-weighted average price of the
+// swap token pair since the last harvest interval, so that a harvest can't be sandwiched by moving the pool price
+func getMinBoughtTokenAmount(ctx sdk.Context, k keeper.Keeper, soldTokenAmount sdk.SysCoin, boughtTokenDenom string) (
+	sdk.SysCoin, error) {
+	params := k.GetParams(ctx)
+	tokenPairName := swaptypes.GetSwapTokenPairName(soldTokenAmount.Denom, boughtTokenDenom)
+	swapTokenPair, err := k.SwapKeeper().GetSwapTokenPair(ctx, tokenPairName)
+	if err != nil {
+		return sdk.SysCoin{}, err
+	}
+	startHeight := ctx.BlockHeight() - params.VaultHarvestInterval
+	if startHeight < 0 {
+		startHeight = 0
+	}
+	twap, err := k.SwapKeeper().GetTWAPByHeight(ctx, tokenPairName, startHeight)
+	if err != nil {
+		return sdk.SysCoin{}, err
+	}
+
+	price := twap.QuoteTokenPrice
+	if soldTokenAmount.Denom == swapTokenPair.BasePooledCoin.Denom {
+		price = twap.BaseTokenPrice
+	}
+	minAmount := soldTokenAmount.Amount.MulTruncate(price).MulTruncate(sdk.OneDec().Sub(params.VaultMaxSlippage))
+	return sdk.NewDecCoinFromDec(boughtTokenDenom, minAmount), nil
+}
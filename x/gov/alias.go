@@ -26,40 +26,49 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec              = types.RegisterCodec
-	RegisterProposalTypeCodec  = types.RegisterProposalTypeCodec
-	ErrInvalidProposer         = types.ErrInvalidProposer
-	ErrInvalidHeight           = types.ErrInvalidHeight
-	ErrInvalidProposalContent  = types.ErrInvalidProposalContent
-	ErrInvalidProposalType     = types.ErrInvalidProposalType
-	ErrInvalidGenesis          = types.ErrInvalidGenesis
-	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
-	ProposalKey                = types.ProposalKey
-	ActiveProposalByTimeKey    = types.ActiveProposalByTimeKey
-	ActiveProposalQueueKey     = types.ActiveProposalQueueKey
-	InactiveProposalByTimeKey  = types.InactiveProposalByTimeKey
-	InactiveProposalQueueKey   = types.InactiveProposalQueueKey
-	DepositKey                 = types.DepositKey
-	VoteKey                    = types.VoteKey
-	NewMsgSubmitProposal       = types.NewMsgSubmitProposal
-	NewMsgDeposit              = types.NewMsgDeposit
-	NewMsgVote                 = types.NewMsgVote
-	ParamKeyTable              = types.ParamKeyTable
-	NewDepositParams           = types.NewDepositParams
-	NewTallyParams             = types.NewTallyParams
-	NewVotingParams            = types.NewVotingParams
-	NewParams                  = types.NewParams
-	NewTallyResultFromMap      = types.NewTallyResultFromMap
-	EmptyTallyResult           = types.EmptyTallyResult
-	NewTextProposal            = types.NewTextProposal
-	RegisterProposalType       = types.RegisterProposalType
-	ContentFromProposalType    = types.ContentFromProposalType
-	IsValidProposalType        = types.IsValidProposalType
-	ProposalHandler            = types.ProposalHandler
-	NewQueryProposalParams     = types.NewQueryProposalParams
-	NewQueryDepositParams      = types.NewQueryDepositParams
-	NewQueryVoteParams         = types.NewQueryVoteParams
-	NewQueryProposalsParams    = types.NewQueryProposalsParams
+	RegisterCodec                      = types.RegisterCodec
+	RegisterProposalTypeCodec          = types.RegisterProposalTypeCodec
+	ErrInvalidProposer                 = types.ErrInvalidProposer
+	ErrInvalidHeight                   = types.ErrInvalidHeight
+	ErrInvalidProposalContent          = types.ErrInvalidProposalContent
+	ErrInvalidProposalType             = types.ErrInvalidProposalType
+	ErrInvalidGenesis                  = types.ErrInvalidGenesis
+	ErrNoProposalHandlerExists         = types.ErrNoProposalHandlerExists
+	ProposalKey                        = types.ProposalKey
+	ActiveProposalByTimeKey            = types.ActiveProposalByTimeKey
+	ActiveProposalQueueKey             = types.ActiveProposalQueueKey
+	InactiveProposalByTimeKey          = types.InactiveProposalByTimeKey
+	InactiveProposalQueueKey           = types.InactiveProposalQueueKey
+	DepositKey                         = types.DepositKey
+	VoteKey                            = types.VoteKey
+	NewMsgSubmitProposal               = types.NewMsgSubmitProposal
+	NewMsgDeposit                      = types.NewMsgDeposit
+	NewMsgVote                         = types.NewMsgVote
+	NewMsgVoteWeighted                 = types.NewMsgVoteWeighted
+	NewMsgDelegateVotingPower          = types.NewMsgDelegateVotingPower
+	NewMsgRevokeVotingPower            = types.NewMsgRevokeVotingPower
+	NewWeightedVoteOption              = types.NewWeightedVoteOption
+	NewWeightedVoteOptions             = types.NewWeightedVoteOptions
+	NewVoteDelegation                  = types.NewVoteDelegation
+	ParamKeyTable                      = types.ParamKeyTable
+	NewDepositParams                   = types.NewDepositParams
+	NewTallyParams                     = types.NewTallyParams
+	NewVotingParams                    = types.NewVotingParams
+	NewParams                          = types.NewParams
+	NewTallyResultFromMap              = types.NewTallyResultFromMap
+	EmptyTallyResult                   = types.EmptyTallyResult
+	NewTextProposal                    = types.NewTextProposal
+	RegisterProposalType               = types.RegisterProposalType
+	ContentFromProposalType            = types.ContentFromProposalType
+	IsValidProposalType                = types.IsValidProposalType
+	ProposalHandler                    = types.ProposalHandler
+	NewProposalHandler                 = types.NewProposalHandler
+	NewUpgradePlan                     = types.NewUpgradePlan
+	NewSoftwareUpgradeProposalWithPlan = types.NewSoftwareUpgradeProposalWithPlan
+	NewQueryProposalParams             = types.NewQueryProposalParams
+	NewQueryDepositParams              = types.NewQueryDepositParams
+	NewQueryVoteParams                 = types.NewQueryVoteParams
+	NewQueryProposalsParams            = types.NewQueryProposalsParams
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -79,22 +88,30 @@ var (
 )
 
 type (
-	Content           = types.Content
-	Handler           = types.Handler
-	Deposit           = types.Deposit
-	Deposits          = types.Deposits
-	MsgSubmitProposal = types.MsgSubmitProposal
-	MsgDeposit        = types.MsgDeposit
-	MsgVote           = types.MsgVote
-	DepositParams     = types.DepositParams
-	TallyParams       = types.TallyParams
-	VotingParams      = types.VotingParams
-	Params            = types.Params
-	Proposal          = types.Proposal
-	Proposals         = types.Proposals
-	ProposalStatus    = types.ProposalStatus
-	TallyResult       = types.TallyResult
-	Vote              = types.Vote
-	Votes             = types.Votes
-	Keeper            = keeper.Keeper
+	Content                = types.Content
+	Handler                = types.Handler
+	Deposit                = types.Deposit
+	Deposits               = types.Deposits
+	MsgSubmitProposal      = types.MsgSubmitProposal
+	MsgDeposit             = types.MsgDeposit
+	MsgVote                = types.MsgVote
+	DepositParams          = types.DepositParams
+	TallyParams            = types.TallyParams
+	VotingParams           = types.VotingParams
+	Params                 = types.Params
+	Proposal               = types.Proposal
+	Proposals              = types.Proposals
+	ProposalStatus         = types.ProposalStatus
+	TallyResult            = types.TallyResult
+	Vote                   = types.Vote
+	Votes                  = types.Votes
+	WeightedVoteOption     = types.WeightedVoteOption
+	WeightedVoteOptions    = types.WeightedVoteOptions
+	VoteDelegation         = types.VoteDelegation
+	VoteDelegations        = types.VoteDelegations
+	MsgVoteWeighted        = types.MsgVoteWeighted
+	MsgDelegateVotingPower = types.MsgDelegateVotingPower
+	MsgRevokeVotingPower   = types.MsgRevokeVotingPower
+	UpgradePlan            = types.UpgradePlan
+	Keeper                 = keeper.Keeper
 )
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 
 	govutils "github.com/okex/okexchain/x/gov/client/utils"
+	"github.com/okex/okexchain/x/gov/types"
 	"github.com/spf13/viper"
 )
 
@@ -18,6 +19,8 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(flagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(flagDeposit)
+		proposal.Plan = types.NewUpgradePlan(viper.GetString(flagUpgradeName), viper.GetUint64(flagUpgradeHeight),
+			viper.GetString(flagUpgradeInfo), viper.GetString(flagUpgradeMigrationHook))
 		return proposal, nil
 	}
 
@@ -40,7 +40,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		getCmdQueryDeposit(queryRoute, cdc),
 		getCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		getCmdQueryVoteDelegation(queryRoute, cdc),
+		getCmdQueryVoteDelegations(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -560,3 +562,81 @@ $ %s query gov tally 1
 }
 
 // DONTCOVER
+
+// getCmdQueryVoteDelegation implements the query vote delegation command.
+func getCmdQueryVoteDelegation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the delegatee of the gov voting power of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegatee which the gov voting power of a delegator is delegated to.
+
+Example:
+$ %s query gov vote-delegation okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVoteDelegationParams(delegator))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteDelegation), bz)
+			if err != nil {
+				return err
+			}
+
+			var voteDelegation types.VoteDelegation
+			cdc.MustUnmarshalJSON(res, &voteDelegation)
+			return cliCtx.PrintOutput(voteDelegation)
+		},
+	}
+}
+
+// getCmdQueryVoteDelegations implements the query vote delegations of a delegatee command.
+func getCmdQueryVoteDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-delegations [delegatee-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote delegations to a delegatee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the delegators delegating the gov voting power to a delegatee.
+
+Example:
+$ %s query gov vote-delegations okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delegatee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVoteDelegationParams(delegatee))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteDelegations), bz)
+			if err != nil {
+				return err
+			}
+
+			var voteDelegations types.VoteDelegations
+			cdc.MustUnmarshalJSON(res, &voteDelegations)
+			return cliCtx.PrintOutput(voteDelegations)
+		},
+	}
+}
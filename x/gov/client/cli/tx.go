@@ -36,6 +36,11 @@ const (
 	flagProposalType = "type"
 	flagDeposit      = "deposit"
 	flagProposal     = "proposal"
+
+	flagUpgradeName          = "upgrade-name"
+	flagUpgradeHeight        = "upgrade-height"
+	flagUpgradeInfo          = "upgrade-info"
+	flagUpgradeMigrationHook = "upgrade-migration-hook"
 )
 
 type proposal struct {
@@ -43,6 +48,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Plan        types.UpgradePlan
 }
 
 // proposalFlags defines the core required fields of a proposal. It is used to
@@ -77,6 +83,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(flags.PostCommands(
 		getCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		getCmdWeightedVote(cdc),
+		getCmdDelegateVotingPower(cdc),
+		getCmdRevokeVotingPower(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -108,8 +117,15 @@ Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" \
 	--deposit="10%s" --from mykey
+
+A software upgrade proposal can carry an upgrade plan, the node halts at the height of the plan unless the
+migration of the plan is registered in the new software:
+
+$ %s tx gov submit-proposal --title="Upgrade" --description="Upgrade to v1" --type="SoftwareUpgrade" \
+	--deposit="10%s" --upgrade-name="v1" --upgrade-height=100000 --upgrade-info="binary urls" --from mykey
 `,
 				version.ClientName, sdk.DefaultBondDenom, version.ClientName, sdk.DefaultBondDenom,
+				version.ClientName, sdk.DefaultBondDenom,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -128,6 +144,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			}
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			if sup, ok := content.(types.SoftwareUpgradeProposal); ok {
+				content = types.NewSoftwareUpgradeProposalWithPlan(sup.Title, sup.Description, proposal.Plan)
+			}
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
@@ -145,6 +164,11 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposal, "",
 		"proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(flagUpgradeName, "", "name of the upgrade plan of a software upgrade proposal")
+	cmd.Flags().Uint64(flagUpgradeHeight, 0, "height at which the upgrade plan takes effect")
+	cmd.Flags().String(flagUpgradeInfo, "", "binary info of the upgrade plan")
+	cmd.Flags().String(flagUpgradeMigrationHook, "",
+		"name of the store migration run at the upgrade height, which is the upgrade name if empty")
 
 	return cmd
 }
@@ -245,4 +269,112 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// getCmdWeightedVote implements creating a new vote split across the weighted options command.
+func getCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal split across the weighted options, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal split across the weighted options, the weights must sum
+to one. It replaces the previous vote of the voter on the proposal.
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.7,abstain=0.3 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteWeighted(cliCtx.GetFromAddress(), proposalID, options)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// getCmdDelegateVotingPower implements delegating the gov voting power command.
+func getCmdDelegateVotingPower(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-voting-power [delegatee-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate the gov voting power to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate the gov voting power to an address, which is separate from the staking proxy.
+The delegated power follows the votes of the delegatee unless the delegator votes itself.
+
+Example:
+$ %s tx gov delegate-voting-power okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVotingPower(cliCtx.GetFromAddress(), delegatee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// getCmdRevokeVotingPower implements revoking the delegated gov voting power command.
+func getCmdRevokeVotingPower(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-voting-power",
+		Args:  cobra.NoArgs,
+		Short: "Revoke the delegated gov voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the gov voting power delegated to an address.
+
+Example:
+$ %s tx gov revoke-voting-power --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgRevokeVotingPower(cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/okex/okexchain/x/gov/types"
+import (
+	"strings"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,17 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options like "yes=0.7,abstain=0.3"
+func NormalizeWeightedVoteOptions(options string) string {
+	optionStrs := strings.Split(options, ",")
+	for i, optionStr := range optionStrs {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		optionStrs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(optionStrs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
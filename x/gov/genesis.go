@@ -21,6 +21,7 @@ type GenesisState struct {
 	DepositParams      DepositParams     `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams      `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams       `json:"tally_params" yaml:"tally_params"`
+	VoteDelegations    VoteDelegations   `json:"vote_delegations,omitempty" yaml:"vote_delegations,omitempty"`
 }
 
 // DefaultGenesisState get raw genesis raw message for testing
@@ -89,6 +90,18 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	delegators := make(map[string]bool, len(data.VoteDelegations))
+	for _, voteDelegation := range data.VoteDelegations {
+		if voteDelegation.Delegator.Empty() || voteDelegation.Delegatee.Empty() ||
+			voteDelegation.Delegator.Equals(voteDelegation.Delegatee) {
+			return fmt.Errorf("governance vote delegation is invalid: %s", voteDelegation)
+		}
+		if delegators[voteDelegation.Delegator.String()] {
+			return fmt.Errorf("governance vote delegation of %s is duplicated", voteDelegation.Delegator)
+		}
+		delegators[voteDelegation.Delegator.String()] = true
+	}
+
 	return nil
 }
 
@@ -115,6 +128,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper keeper.SupplyKee
 		k.SetVote(ctx, vote.ProposalID, vote)
 	}
 
+	for _, voteDelegation := range data.VoteDelegations {
+		k.SetVoteDelegation(ctx, voteDelegation)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case StatusDepositPeriod:
@@ -179,5 +196,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		VoteDelegations:    k.GetAllVoteDelegations(ctx),
 	}
 }
@@ -25,6 +25,15 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case MsgDelegateVotingPower:
+			return handleMsgDelegateVotingPower(ctx, keeper, msg)
+
+		case MsgRevokeVotingPower:
+			return handleMsgRevokeVotingPower(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -113,6 +122,26 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		return sdk.EnvelopedErr{err}.Result()
 	}
 
+	return handleProposalAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, k keeper.Keeper, msg MsgVoteWeighted) (*sdk.Result, error) {
+	proposal, ok := k.GetProposal(ctx, msg.ProposalID)
+	if !ok {
+		return sdk.EnvelopedErr{types.ErrUnknownProposal(msg.ProposalID)}.Result()
+	}
+
+	err, _ := k.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return sdk.EnvelopedErr{err}.Result()
+	}
+
+	return handleProposalAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+// handleProposalAfterVote tallies the proposal after a vote, which may end the voting period
+func handleProposalAfterVote(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal, voter sdk.AccAddress,
+) (*sdk.Result, error) {
 	status, distribute, tallyResults := keeper.Tally(ctx, k, proposal, false)
 	// update tally results after vote every time
 	proposal.FinalTallyResult = tallyResults
@@ -130,7 +159,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
 		),
 	)
@@ -138,6 +167,40 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgDelegateVotingPower(ctx sdk.Context, k keeper.Keeper, msg MsgDelegateVotingPower) (*sdk.Result, error) {
+	if err := k.DelegateVotingPower(ctx, msg.Delegator, msg.Delegatee); err != nil {
+		return sdk.EnvelopedErr{err}.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, msg.Delegatee.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeVotingPower(ctx sdk.Context, k keeper.Keeper, msg MsgRevokeVotingPower) (*sdk.Result, error) {
+	if !k.RevokeVotingPower(ctx, msg.Delegator) {
+		return sdk.EnvelopedErr{types.ErrInvalidVoteDelegation(
+			fmt.Sprintf("%s has no vote delegation", msg.Delegator))}.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleProposalAfterTally(
 	ctx sdk.Context, k keeper.Keeper, proposal *types.Proposal, distribute bool, status ProposalStatus,
 ) (string, string) {
@@ -338,6 +338,12 @@ func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg types.MsgSubmit
 	if err != nil {
 		return common.ErrInsufficientCoins(types.DefaultCodespace, err.Error())
 	}
+	// check the upgrade plan will not be reached before the proposal passes
+	if sup, ok := msg.Content.(types.SoftwareUpgradeProposal); ok && !sup.Plan.IsEmpty() &&
+		sup.Plan.Height <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidUpgradePlan(fmt.Sprintf("height %d must be greater than current block height %d",
+			sup.Plan.Height, ctx.BlockHeight()))
+	}
 	return nil
 }
 
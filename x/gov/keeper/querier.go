@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
@@ -29,6 +31,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case types.QueryVoteDelegation:
+			return queryVoteDelegation(ctx, req, keeper)
+		case types.QueryVoteDelegations:
+			return queryVoteDelegations(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -181,6 +187,44 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func queryVoteDelegation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteDelegationParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	voteDelegation, found := keeper.GetVoteDelegation(ctx, params.Address)
+	if !found {
+		return nil, types.ErrInvalidVoteDelegation(fmt.Sprintf("%s has no vote delegation", params.Address))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, voteDelegation)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryVoteDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteDelegationParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	voteDelegations := types.VoteDelegations{}
+	for _, delegator := range keeper.GetVoteDelegators(ctx, params.Address) {
+		voteDelegations = append(voteDelegations, types.NewVoteDelegation(delegator, params.Address))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, voteDelegations)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryProposalsParams
@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                types.VoteOption     // Vote of the validator
+	Address             sdk.ValAddress            // address of the validator operator
+	BondedTokens        sdk.Int                   // Power of a Validator
+	DelegatorShares     sdk.Dec                   // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec                   // Delegator deductions from validator's delegators voting independently
+	Vote                types.WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote types.VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote types.WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -31,45 +31,88 @@ func tallyDelegatorVotes(
 	ctx sdk.Context, keeper Keeper, currValidators map[string]validatorGovInfo, proposalID uint64,
 	voteP *types.Vote, voterPower, totalVotedPower *sdk.Dec, results map[types.VoteOption]sdk.Dec,
 ) {
-	// iterate over all the votes
+	// iterate over all the votes, the vote being added replaces the previous one of the voter
 	votesIterator := keeper.GetVotes(ctx, proposalID)
 	if voteP != nil {
-		votesIterator = append(votesIterator, *voteP)
+		replaced := false
+		for i := range votesIterator {
+			if votesIterator[i].Voter.Equals(voteP.Voter) {
+				votesIterator[i], replaced = *voteP, true
+				break
+			}
+		}
+		if !replaced {
+			votesIterator = append(votesIterator, *voteP)
+		}
+	}
+	voted := make(map[string]bool, len(votesIterator))
+	for _, vote := range votesIterator {
+		voted[vote.Voter.String()] = true
 	}
+
 	for i := 0; i < len(votesIterator); i++ {
 		vote := votesIterator[i]
+		options := vote.GetWeightedOptions()
+		isVoter := voteP != nil && vote.Voter.Equals(voteP.Voter)
 
 		// if validator, just record it in the map
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = options
 			currValidators[valAddrStr] = val
 		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			delegation := keeper.sk.Delegator(ctx, vote.Voter)
-			if delegation == nil {
-				continue
+			tallyDelegatorPower(ctx, keeper, currValidators, vote.Voter, options, isVoter,
+				voterPower, totalVotedPower, results)
+		}
+
+		// the voting power delegated to the voter follows the vote unless the delegator votes itself,
+		// and the validators vote with the shares added to them
+		keeper.IterateVoteDelegators(ctx, vote.Voter, func(delegator sdk.AccAddress) (stop bool) {
+			if _, ok := currValidators[sdk.ValAddress(delegator).String()]; !ok && !voted[delegator.String()] {
+				tallyDelegatorPower(ctx, keeper, currValidators, delegator, options, isVoter,
+					voterPower, totalVotedPower, results)
 			}
-			for _, val := range delegation.GetShareAddedValidatorAddresses() {
-				valAddrStr := val.String()
-				if valInfo, ok := currValidators[valAddrStr]; ok {
-					valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(delegation.GetLastAddedShares())
-					currValidators[valAddrStr] = valInfo
-
-					votedPower := delegation.GetLastAddedShares()
-					// calculate vote power of delegator for voterPowerRate
-					if voteP != nil && vote.Voter.Equals(voteP.Voter) {
-						voterPower.Add(votedPower)
-					}
-					results[vote.Option] = results[vote.Option].Add(votedPower)
-					*totalVotedPower = totalVotedPower.Add(votedPower)
-				}
+			return false
+		})
+	}
+}
+
+// tallyDelegatorPower deducts the shares added by a delegator from the validators and tallies them by the options
+func tallyDelegatorPower(
+	ctx sdk.Context, keeper Keeper, currValidators map[string]validatorGovInfo, delAddr sdk.AccAddress,
+	options types.WeightedVoteOptions, isVoter bool, voterPower, totalVotedPower *sdk.Dec,
+	results map[types.VoteOption]sdk.Dec,
+) {
+	// iterate over all delegations from delegator, deduct from any delegated-to validators
+	delegation := keeper.sk.Delegator(ctx, delAddr)
+	if delegation == nil {
+		return
+	}
+	for _, val := range delegation.GetShareAddedValidatorAddresses() {
+		valAddrStr := val.String()
+		if valInfo, ok := currValidators[valAddrStr]; ok {
+			valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(delegation.GetLastAddedShares())
+			currValidators[valAddrStr] = valInfo
+
+			votedPower := delegation.GetLastAddedShares()
+			// calculate vote power of delegator for voterPowerRate
+			if isVoter {
+				*voterPower = voterPower.Add(votedPower)
 			}
+			tallyWeightedPower(results, options, votedPower)
+			*totalVotedPower = totalVotedPower.Add(votedPower)
 		}
 	}
 }
 
+// tallyWeightedPower splits the voted power across the weighted options
+func tallyWeightedPower(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions, power sdk.Dec) {
+	for _, option := range options {
+		results[option.Option] = results[option.Option].Add(power.Mul(option.Weight))
+	}
+}
+
 func tallyValidatorVotes(
 	currValidators map[string]validatorGovInfo, voteP *types.Vote, voterPower,
 	totalPower, totalVotedPower *sdk.Dec, results map[types.VoteOption]sdk.Dec,
@@ -78,7 +121,7 @@ func tallyValidatorVotes(
 	for key, val := range currValidators {
 		// calculate all vote power of current validators including delegated for voterPowerRate
 		*totalPower = totalPower.Add(val.DelegatorShares)
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -87,7 +130,7 @@ func tallyValidatorVotes(
 			// calculate vote power of validator after deduction for voterPowerRate
 			*voterPower = voterPower.Add(valValidVotedPower)
 		}
-		results[val.Vote] = results[val.Vote].Add(valValidVotedPower)
+		tallyWeightedPower(results, val.Vote, valValidVotedPower)
 		*totalVotedPower = totalVotedPower.Add(valValidVotedPower)
 	}
 }
@@ -113,7 +156,7 @@ func preTally(
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
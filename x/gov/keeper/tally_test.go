@@ -313,3 +313,58 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
 }
+
+func TestTallyWeightedVoteAndVoteDelegation(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("11000.0" + common.NativeToken)
+	require.Nil(t, err)
+	stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3], coin))
+	stakingHandler(ctx, staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{sdk.ValAddress(Addrs[2])}))
+
+	// the delegator delegates the voting power to an address without any shares
+	require.Nil(t, keeper.DelegateVotingPower(ctx, Addrs[3], Addrs[4]))
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[2], types.OptionNo)
+	require.Nil(t, err)
+	options := types.NewWeightedVoteOptions(
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[4], options)
+	require.Nil(t, err)
+
+	// the shares of the delegator are deducted from the validator and split by the weighted vote of the delegatee
+	expectedTallyResult := newTallyResult(t, "11003", "7702", "3300", "1", "0.0", "11003")
+	status, _, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.Equal(t, types.StatusPassed, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+
+	// the delegator votes itself which overrides the delegatee
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[3], types.OptionNo)
+	require.Nil(t, err)
+	expectedTallyResult = newTallyResult(t, "11003", "2", "0.0", "11001", "0.0", "11003")
+	status, _, tallyResults = Tally(ctx, keeper, proposal, true)
+	require.Equal(t, types.StatusRejected, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+}
@@ -10,6 +10,25 @@ import (
 func (keeper Keeper) AddVote(
 	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption,
 ) (sdk.Error, string) {
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(option), ""
+	}
+	return keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option))
+}
+
+// AddWeightedVote adds a vote split across the weighted options on a specific proposal, which replaces the
+// previous vote of the voter
+func (keeper Keeper) AddWeightedVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) (sdk.Error, string) {
+	if err := options.ValidateBasic(); err != nil {
+		return err, ""
+	}
+	return keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options))
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote) (sdk.Error, string) {
+	proposalID := vote.ProposalID
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return types.ErrUnknownProposal(proposalID), ""
@@ -18,14 +37,7 @@ func (keeper Keeper) AddVote(
 		return types.ErrInvalidateProposalStatus(), ""
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(option), ""
-	}
-
 	voteFeeStr := ""
-	vote := types.Vote{
-		ProposalID: proposalID, Voter: voterAddr, Option: option,
-	}
 	if keeper.ProposalHandlerRouter().HasRoute(proposal.ProposalRoute()) {
 		var err sdk.Error
 		voteFeeStr, err = keeper.ProposalHandlerRouter().GetRoute(proposal.ProposalRoute()).VoteHandler(ctx, proposal, vote)
@@ -36,10 +48,14 @@ func (keeper Keeper) AddVote(
 
 	keeper.SetVote(ctx, proposalID, vote)
 
+	option := vote.Option.String()
+	if len(vote.Options) != 0 {
+		option = vote.Options.String()
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, option),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
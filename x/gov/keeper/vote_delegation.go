@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// DelegateVotingPower delegates the voting power of a delegator to a delegatee, which replaces the previous
+// delegation of the delegator. The delegation of a validator is ignored in tally, whose power is voted by itself.
+// Only the delegator who has added shares can delegate, which keeps the delegators tallied with the vote of the
+// delegatee from growing for free
+func (keeper Keeper) DelegateVotingPower(ctx sdk.Context, delegator, delegatee sdk.AccAddress) sdk.Error {
+	if delegator.Equals(delegatee) {
+		return types.ErrInvalidVoteDelegation("can't delegate the voting power to oneself")
+	}
+	if del := keeper.sk.Delegator(ctx, delegator); del == nil || len(del.GetShareAddedValidatorAddresses()) == 0 {
+		return types.ErrInvalidVoteDelegation(fmt.Sprintf("%s hasn't added shares to any validator", delegator))
+	}
+
+	keeper.RevokeVotingPower(ctx, delegator)
+	keeper.SetVoteDelegation(ctx, types.NewVoteDelegation(delegator, delegatee))
+	return nil
+}
+
+// RevokeVotingPower revokes the voting power delegated by a delegator, it returns false if there is no delegation
+func (keeper Keeper) RevokeVotingPower(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	voteDelegation, found := keeper.GetVoteDelegation(ctx, delegator)
+	if !found {
+		return false
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteDelegationKey(delegator))
+	store.Delete(types.VoteDelegationByDelegateeKey(voteDelegation.Delegatee, delegator))
+	return true
+}
+
+// SetVoteDelegation stores the vote delegation of a delegator and indexes it by the delegatee
+func (keeper Keeper) SetVoteDelegation(ctx sdk.Context, voteDelegation types.VoteDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(voteDelegation)
+	store.Set(types.VoteDelegationKey(voteDelegation.Delegator), bz)
+	store.Set(types.VoteDelegationByDelegateeKey(voteDelegation.Delegatee, voteDelegation.Delegator),
+		voteDelegation.Delegator)
+}
+
+// GetVoteDelegation gets the vote delegation of a delegator
+func (keeper Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) (
+	voteDelegation types.VoteDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteDelegationKey(delegator))
+	if bz == nil {
+		return voteDelegation, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &voteDelegation)
+	return voteDelegation, true
+}
+
+// IterateVoteDelegators iterates over the delegators delegating the voting power to a delegatee
+func (keeper Keeper) IterateVoteDelegators(ctx sdk.Context, delegatee sdk.AccAddress,
+	cb func(delegator sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsByDelegateeKey(delegatee))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetVoteDelegators returns the delegators delegating the voting power to a delegatee
+func (keeper Keeper) GetVoteDelegators(ctx sdk.Context, delegatee sdk.AccAddress) (delegators []sdk.AccAddress) {
+	keeper.IterateVoteDelegators(ctx, delegatee, func(delegator sdk.AccAddress) bool {
+		delegators = append(delegators, delegator)
+		return false
+	})
+	return
+}
+
+// IterateAllVoteDelegations iterates over the all the stored vote delegations and performs a callback function
+func (keeper Keeper) IterateAllVoteDelegations(ctx sdk.Context,
+	cb func(voteDelegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var voteDelegation types.VoteDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &voteDelegation)
+
+		if cb(voteDelegation) {
+			break
+		}
+	}
+}
+
+// GetAllVoteDelegations returns all the vote delegations from the store
+func (keeper Keeper) GetAllVoteDelegations(ctx sdk.Context) (voteDelegations types.VoteDelegations) {
+	keeper.IterateAllVoteDelegations(ctx, func(voteDelegation types.VoteDelegation) bool {
+		voteDelegations = append(voteDelegations, voteDelegation)
+		return false
+	})
+	return
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/gov/types"
+	"github.com/okex/okexchain/x/staking"
+)
+
+func TestKeeper_VoteDelegation(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 1000)
+	stakingHandler := staking.NewHandler(sk)
+	valAddr := sdk.ValAddress(Addrs[5])
+	CreateValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{5})
+
+	// only the delegator who has added shares can delegate the voting power
+	require.NotNil(t, keeper.DelegateVotingPower(ctx, Addrs[0], Addrs[1]))
+	coin, err := sdk.ParseDecCoin("1.0" + common.NativeToken)
+	require.Nil(t, err)
+	for _, addr := range []sdk.AccAddress{Addrs[0], Addrs[2]} {
+		_, err = stakingHandler(ctx, staking.NewMsgDeposit(addr, coin))
+		require.Nil(t, err)
+		_, err = stakingHandler(ctx, staking.NewMsgAddShares(addr, []sdk.ValAddress{valAddr}))
+		require.Nil(t, err)
+	}
+
+	require.NotNil(t, keeper.DelegateVotingPower(ctx, Addrs[0], Addrs[0]))
+	require.Nil(t, keeper.DelegateVotingPower(ctx, Addrs[0], Addrs[1]))
+	require.Nil(t, keeper.DelegateVotingPower(ctx, Addrs[2], Addrs[1]))
+	require.Equal(t, 2, len(keeper.GetVoteDelegators(ctx, Addrs[1])))
+
+	// the delegation is replaced and removed from the index of the previous delegatee
+	require.Nil(t, keeper.DelegateVotingPower(ctx, Addrs[0], Addrs[3]))
+	voteDelegation, found := keeper.GetVoteDelegation(ctx, Addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewVoteDelegation(Addrs[0], Addrs[3]), voteDelegation)
+	require.Equal(t, []sdk.AccAddress{Addrs[2]}, keeper.GetVoteDelegators(ctx, Addrs[1]))
+	require.Equal(t, 2, len(keeper.GetAllVoteDelegations(ctx)))
+
+	// query the delegations
+	querier := NewQuerier(keeper)
+	bz, err := keeper.cdc.MarshalJSON(types.NewQueryVoteDelegationParams(Addrs[3]))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QueryVoteDelegations}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var voteDelegations types.VoteDelegations
+	keeper.cdc.MustUnmarshalJSON(res, &voteDelegations)
+	require.Equal(t, types.VoteDelegations{voteDelegation}, voteDelegations)
+
+	// revoke the delegation
+	require.True(t, keeper.RevokeVotingPower(ctx, Addrs[0]))
+	require.False(t, keeper.RevokeVotingPower(ctx, Addrs[0]))
+	require.Equal(t, 0, len(keeper.GetVoteDelegators(ctx, Addrs[3])))
+	bz, err = keeper.cdc.MarshalJSON(types.NewQueryVoteDelegationParams(Addrs[0]))
+	require.Nil(t, err)
+	_, err = querier(ctx, []string{types.QueryVoteDelegation}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+}
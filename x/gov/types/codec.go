@@ -15,6 +15,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "okexchain/gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "okexchain/gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "okexchain/gov/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "okexchain/gov/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgDelegateVotingPower{}, "okexchain/gov/MsgDelegateVotingPower", nil)
+	cdc.RegisterConcrete(MsgRevokeVotingPower{}, "okexchain/gov/MsgRevokeVotingPower", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "okexchain/gov/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "okexchain/gov/SoftwareUpgradeProposal", nil)
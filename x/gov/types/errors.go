@@ -24,6 +24,9 @@ const (
 	CodeInvalidHeight            uint32 = BaseGovError + 10
 	CodeInvalidCoins             uint32 = BaseGovError + 11
 	CodeUnknownParamType         uint32 = BaseGovError + 12
+	CodeInvalidUpgradePlan       uint32 = BaseGovError + 13
+	CodeInvalidWeightedVote      uint32 = BaseGovError + 14
+	CodeInvalidVoteDelegation    uint32 = BaseGovError + 15
 )
 
 func ErrInvalidAddress(address string) sdk.Error {
@@ -80,3 +83,15 @@ func ErrInvalidCoins() sdk.Error {
 func ErrUnknownGovParamType() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeUnknownParamType, "unkonwn gov param type")
 }
+
+func ErrInvalidUpgradePlan(msg string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidUpgradePlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+func ErrInvalidWeightedVote(msg string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidWeightedVote, fmt.Sprintf("invalid weighted vote: %s", msg))
+}
+
+func ErrInvalidVoteDelegation(msg string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidVoteDelegation, fmt.Sprintf("invalid vote delegation: %s", msg))
+}
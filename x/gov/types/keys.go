@@ -39,6 +39,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x40<delegatorAddr_Bytes>: VoteDelegation
+//
+// - 0x41<delegateeAddr_Bytes><delegatorAddr_Bytes>: delegatorAddr
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -51,6 +55,9 @@ var (
 
 	// PrefixWaitingProposalQueue defines the prefix of waiting proposal queue
 	PrefixWaitingProposalQueue = []byte{0x30}
+
+	VoteDelegationKeyPrefix         = []byte{0x40}
+	VoteDelegationByDelegateePrefix = []byte{0x41}
 )
 
 // WaitingProposalByBlockHeightKey gets the waiting proposal queue key by block height
@@ -172,6 +179,21 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// VoteDelegationKey gets the key of the vote delegation of a delegator
+func VoteDelegationKey(delegator sdk.AccAddress) []byte {
+	return append(VoteDelegationKeyPrefix, delegator.Bytes()...)
+}
+
+// VoteDelegationsByDelegateeKey gets the prefix of the delegators delegating the voting power to a delegatee
+func VoteDelegationsByDelegateeKey(delegatee sdk.AccAddress) []byte {
+	return append(VoteDelegationByDelegateePrefix, delegatee.Bytes()...)
+}
+
+// VoteDelegationByDelegateeKey gets the key of a delegator in the index of a delegatee
+func VoteDelegationByDelegateeKey(delegatee, delegator sdk.AccAddress) []byte {
+	return append(VoteDelegationsByDelegateeKey(delegatee), delegator.Bytes()...)
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -8,12 +8,16 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgDeposit        = "deposit"
-	TypeMsgVote           = "vote"
-	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit             = "deposit"
+	TypeMsgVote                = "vote"
+	TypeMsgSubmitProposal      = "submit_proposal"
+	TypeMsgVoteWeighted        = "weighted_vote"
+	TypeMsgDelegateVotingPower = "delegate_voting_power"
+	TypeMsgRevokeVotingPower   = "revoke_voting_power"
 )
 
 var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _ sdk.Msg = MsgVoteWeighted{}, MsgDelegateVotingPower{}, MsgRevokeVotingPower{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
@@ -35,12 +39,6 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.Content == nil {
 		return ErrInvalidProposalContent("content is required")
 	}
-	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
-		return ErrInvalidProposalType(msg.Content.ProposalType())
-	}
 	if msg.Proposer.Empty() {
 		return ErrInvalidAddress(msg.Proposer.String())
 	}
@@ -176,3 +174,140 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted is the vote split across the weighted options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return ErrInvalidAddress(msg.Voter.String())
+	}
+	return msg.Options.ValidateBasic()
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgDelegateVotingPower delegates the gov voting power of the delegator to the delegatee
+type MsgDelegateVotingPower struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Delegatee sdk.AccAddress `json:"delegatee" yaml:"delegatee"`
+}
+
+func NewMsgDelegateVotingPower(delegator, delegatee sdk.AccAddress) MsgDelegateVotingPower {
+	return MsgDelegateVotingPower{
+		Delegator: delegator,
+		Delegatee: delegatee,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgDelegateVotingPower) Route() string { return RouterKey }
+func (msg MsgDelegateVotingPower) Type() string  { return TypeMsgDelegateVotingPower }
+
+// Implements Msg.
+func (msg MsgDelegateVotingPower) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.Delegatee.Empty() {
+		return ErrInvalidAddress(msg.Delegatee.String())
+	}
+	if msg.Delegator.Equals(msg.Delegatee) {
+		return ErrInvalidVoteDelegation("can't delegate the voting power to oneself")
+	}
+	return nil
+}
+
+func (msg MsgDelegateVotingPower) String() string {
+	return fmt.Sprintf(`Delegate Voting Power Message:
+  Delegator: %s
+  Delegatee: %s
+`, msg.Delegator, msg.Delegatee)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVotingPower) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgRevokeVotingPower revokes the gov voting power delegated by the delegator
+type MsgRevokeVotingPower struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+}
+
+func NewMsgRevokeVotingPower(delegator sdk.AccAddress) MsgRevokeVotingPower {
+	return MsgRevokeVotingPower{
+		Delegator: delegator,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgRevokeVotingPower) Route() string { return RouterKey }
+func (msg MsgRevokeVotingPower) Type() string  { return TypeMsgRevokeVotingPower }
+
+// Implements Msg.
+func (msg MsgRevokeVotingPower) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return ErrInvalidAddress(msg.Delegator.String())
+	}
+	return nil
+}
+
+func (msg MsgRevokeVotingPower) String() string {
+	return fmt.Sprintf(`Revoke Voting Power Message:
+  Delegator: %s
+`, msg.Delegator)
+}
+
+// Implements Msg.
+func (msg MsgRevokeVotingPower) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgRevokeVotingPower) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common/proto"
 )
 
 // Proposal defines a struct used by the governance module to allow for voting
@@ -320,16 +322,66 @@ func (tp TextProposal) String() string {
 `, tp.Title, tp.Description)
 }
 
+// UpgradePlan is the plan of a software upgrade, which is written into the protocol keeper when the proposal passes
+type UpgradePlan struct {
+	Name   string `json:"name" yaml:"name"`
+	Height uint64 `json:"height" yaml:"height"`
+	// binary info of the new software, e.g. the urls and the checksums of the binaries
+	Info string `json:"info" yaml:"info"`
+	// name of the in-process store migration run at the height, which is the plan name if empty
+	MigrationHook string `json:"migration_hook" yaml:"migration_hook"`
+}
+
+// NewUpgradePlan creates a new instance of UpgradePlan
+func NewUpgradePlan(name string, height uint64, info, migrationHook string) UpgradePlan {
+	return UpgradePlan{
+		Name:          name,
+		Height:        height,
+		Info:          info,
+		MigrationHook: migrationHook,
+	}
+}
+
+// IsEmpty returns whether the plan is empty, the software upgrade proposal is only a signal without a plan
+func (up UpgradePlan) IsEmpty() bool {
+	return up == UpgradePlan{}
+}
+
+// ValidateBasic validates the upgrade plan
+func (up UpgradePlan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(up.Name)) == 0 {
+		return ErrInvalidUpgradePlan("name is required")
+	}
+	if up.Height == 0 {
+		return ErrInvalidUpgradePlan("height must be positive")
+	}
+	return nil
+}
+
+// String returns a human readable string representation of UpgradePlan
+func (up UpgradePlan) String() string {
+	return fmt.Sprintf(`Upgrade Plan:
+  Name:          %s
+  Height:        %d
+  Info:          %s
+  MigrationHook: %s
+`, up.Name, up.Height, up.Info, up.MigrationHook)
+}
+
 // Software Upgrade Proposals
-// TODO: We have to add fields for SUP specific arguments e.g. commit hash,
-// upgrade date, etc.
 type SoftwareUpgradeProposal struct {
-	Title       string `json:"title" yaml:"title"`
-	Description string `json:"description" yaml:"description"`
+	Title       string      `json:"title" yaml:"title"`
+	Description string      `json:"description" yaml:"description"`
+	Plan        UpgradePlan `json:"plan,omitempty" yaml:"plan,omitempty"`
 }
 
 func NewSoftwareUpgradeProposal(title, description string) Content {
-	return SoftwareUpgradeProposal{title, description}
+	return SoftwareUpgradeProposal{Title: title, Description: description}
+}
+
+// NewSoftwareUpgradeProposalWithPlan creates a software upgrade proposal with an upgrade plan
+func NewSoftwareUpgradeProposalWithPlan(title, description string, plan UpgradePlan) Content {
+	return SoftwareUpgradeProposal{Title: title, Description: description, Plan: plan}
 }
 
 // Implements Proposal Interface
@@ -341,14 +393,24 @@ func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Descript
 func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
 func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
 func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
-	return ValidateAbstract(DefaultCodespace, sup)
+	if err := ValidateAbstract(DefaultCodespace, sup); err != nil {
+		return err
+	}
+	if sup.Plan.IsEmpty() {
+		return nil
+	}
+	return sup.Plan.ValidateBasic()
 }
 
 func (sup SoftwareUpgradeProposal) String() string {
-	return fmt.Sprintf(`Software Upgrade Proposal:
+	out := fmt.Sprintf(`Software Upgrade Proposal:
   Title:       %s
   Description: %s
 `, sup.Title, sup.Description)
+	if !sup.Plan.IsEmpty() {
+		out += sup.Plan.String()
+	}
+	return out
 }
 
 var validProposalTypes = map[string]struct{}{
@@ -389,6 +451,37 @@ func IsValidProposalType(ty string) bool {
 	return ok
 }
 
+// ProtocolKeeper defines the expected protocol keeper to apply the upgrade plans (noalias)
+type ProtocolKeeper interface {
+	GetNextVersion(ctx sdk.Context) uint64
+	GetUpgradeConfig(ctx sdk.Context) (upgradeConfig proto.AppUpgradeConfig, found bool)
+	SetUpgradeConfig(ctx sdk.Context, upgradeConfig proto.AppUpgradeConfig)
+}
+
+// NewProposalHandler creates a handler for governance module-based proposals, which writes the upgrade plan of a
+// passed SoftwareUpgradeProposal into the protocol keeper as an AppUpgradeConfig
+func NewProposalHandler(pk ProtocolKeeper) Handler {
+	return func(ctx sdk.Context, p *Proposal) sdk.Error {
+		sup, ok := p.Content.(SoftwareUpgradeProposal)
+		if !ok || sup.Plan.IsEmpty() {
+			return ProposalHandler(ctx, p)
+		}
+
+		if uint64(ctx.BlockHeight()) >= sup.Plan.Height {
+			return ErrInvalidUpgradePlan(fmt.Sprintf("height %d has been reached", sup.Plan.Height))
+		}
+		if upgradeConfig, found := pk.GetUpgradeConfig(ctx); found {
+			return ErrInvalidUpgradePlan(fmt.Sprintf("upgrade %q of proposal %d is in process",
+				upgradeConfig.ProtocolDef.Name, upgradeConfig.ProposalID))
+		}
+
+		protocolDef := proto.NewProtocolDefinition(pk.GetNextVersion(ctx), sup.Plan.Info, sup.Plan.Height,
+			proto.DefaultUpgradeThreshold).WithUpgradePlan(sup.Plan.Name, sup.Plan.MigrationHook)
+		pk.SetUpgradeConfig(ctx, proto.NewAppUpgradeConfig(p.ProposalID, protocolDef))
+		return nil
+	}
+}
+
 // ProposalHandler implements the Handler interface for governance module-based
 // proposals (ie. TextProposal and SoftwareUpgradeProposal). Since these are
 // merely signaling mechanisms at the moment and do not affect state, it
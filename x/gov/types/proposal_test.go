@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftwareUpgradeProposal_ValidateBasic(t *testing.T) {
+	// the proposal without a plan is only a signal
+	require.Nil(t, NewSoftwareUpgradeProposal("title", "description").ValidateBasic())
+
+	sup := NewSoftwareUpgradeProposalWithPlan("title", "description", NewUpgradePlan("v1", 100, "info", ""))
+	require.Nil(t, sup.ValidateBasic())
+	require.Contains(t, sup.String(), "v1")
+
+	sup = NewSoftwareUpgradeProposalWithPlan("title", "description", NewUpgradePlan("", 100, "info", ""))
+	require.NotNil(t, sup.ValidateBasic())
+
+	sup = NewSoftwareUpgradeProposalWithPlan("title", "description", NewUpgradePlan("v1", 0, "info", ""))
+	require.NotNil(t, sup.ValidateBasic())
+}
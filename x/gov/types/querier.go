@@ -15,6 +15,9 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryVoteDelegation  = "vote_delegation"
+	QueryVoteDelegations = "vote_delegations"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -81,3 +84,17 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// Params for queries:
+// - 'custom/gov/vote_delegation'
+// - 'custom/gov/vote_delegations'
+type QueryVoteDelegationParams struct {
+	Address sdk.AccAddress
+}
+
+// creates a new instance of QueryVoteDelegationParams
+func NewQueryVoteDelegationParams(address sdk.AccAddress) QueryVoteDelegationParams {
+	return QueryVoteDelegationParams{
+		Address: address,
+	}
+}
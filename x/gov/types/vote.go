@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,14 +13,32 @@ type Vote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
 	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	// weighted options of a split vote, the Option is the one with the largest weight of them
+	Options WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"`
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance split across the weighted options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalID: proposalID, Voter: voter, Option: options.MainOption(), Options: options}
+}
+
+// GetWeightedOptions returns the weighted options of the vote, the single option vote has a weight of one
+func (v Vote) GetWeightedOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 {
+		return WeightedVoteOptions{NewWeightedVoteOption(v.Option, sdk.OneDec())}
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
+	if len(v.Options) != 0 {
+		return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
@@ -32,6 +51,10 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
+		if len(vot.Options) != 0 {
+			out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
+			continue
+		}
 		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
 	}
 	return out
@@ -41,7 +64,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -93,6 +117,118 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption is a vote option with the weight of the voting power voted for it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new instance of WeightedVoteOption
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+// String implements the Stringer interface
+func (wvo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wvo.Option, wvo.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewWeightedVoteOptions creates the weighted options of a split vote
+func NewWeightedVoteOptions(options ...WeightedVoteOption) WeightedVoteOptions {
+	return options
+}
+
+// WeightedVoteOptionsFromString parses the weighted options from a string like "Yes=0.7,Abstain=0.3", and a single
+// option without the weight is weighted one
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(strings.TrimSpace(str), ",") {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		option, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight := sdk.OneDec()
+		if len(fields) > 1 {
+			if weight, err = sdk.NewDecFromStr(strings.TrimSpace(fields[1])); err != nil {
+				return nil, fmt.Errorf("invalid weight of option %s: %s", option, err)
+			}
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidateBasic checks the options are valid and unique, and the weights are positive and sum to one
+func (wvos WeightedVoteOptions) ValidateBasic() sdk.Error {
+	if len(wvos) == 0 {
+		return ErrInvalidWeightedVote("options are required")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range wvos {
+		if !ValidVoteOption(option.Option) {
+			return ErrInvalidVote(option.Option)
+		}
+		if usedOptions[option.Option] {
+			return ErrInvalidWeightedVote(fmt.Sprintf("duplicated option %s", option.Option))
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return ErrInvalidWeightedVote(fmt.Sprintf("weight of option %s must be in (0, 1]", option.Option))
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return ErrInvalidWeightedVote(fmt.Sprintf("total weight %s must be one", totalWeight))
+	}
+	return nil
+}
+
+// MainOption returns the option with the largest weight, the first one wins the tie
+func (wvos WeightedVoteOptions) MainOption() VoteOption {
+	if len(wvos) == 0 {
+		return OptionEmpty
+	}
+	main := wvos[0]
+	for _, option := range wvos[1:] {
+		if option.Weight.GT(main.Weight) {
+			main = option
+		}
+	}
+	return main.Option
+}
+
+// Equals returns whether two weighted options are equal
+func (wvos WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(wvos) != len(comp) {
+		return false
+	}
+	for i := range wvos {
+		if wvos[i].Option != comp[i].Option || !wvos[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the Stringer interface
+func (wvos WeightedVoteOptions) String() string {
+	strs := make([]string, len(wvos))
+	for i, option := range wvos {
+		strs[i] = option.String()
+	}
+	return strings.Join(strs, ",")
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
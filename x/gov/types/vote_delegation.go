@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteDelegation is the gov voting power delegated by a delegator to a delegatee, which is separate from the
+// staking proxies. The delegated power follows the vote of the delegatee unless the delegator votes itself
+type VoteDelegation struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Delegatee sdk.AccAddress `json:"delegatee" yaml:"delegatee"`
+}
+
+// NewVoteDelegation creates a new instance of VoteDelegation
+func NewVoteDelegation(delegator, delegatee sdk.AccAddress) VoteDelegation {
+	return VoteDelegation{
+		Delegator: delegator,
+		Delegatee: delegatee,
+	}
+}
+
+// String returns a human readable string representation of VoteDelegation
+func (vd VoteDelegation) String() string {
+	return fmt.Sprintf("%s delegated the voting power to %s", vd.Delegator, vd.Delegatee)
+}
+
+// VoteDelegations is a collection of VoteDelegation objects
+type VoteDelegations []VoteDelegation
+
+// String returns a human readable string representation of VoteDelegations
+func (vds VoteDelegations) String() string {
+	if len(vds) == 0 {
+		return "[]"
+	}
+	out := "Vote Delegations:"
+	for _, vd := range vds {
+		out += fmt.Sprintf("\n  %s -> %s", vd.Delegator, vd.Delegatee)
+	}
+	return out
+}
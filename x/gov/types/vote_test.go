@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedVoteOptions(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.7,Abstain=0.3")
+	require.Nil(t, err)
+	require.Nil(t, options.ValidateBasic())
+	require.Equal(t, OptionYes, options.MainOption())
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), options[1].Weight)
+
+	// the single option is weighted one
+	options, err = WeightedVoteOptionsFromString("No")
+	require.Nil(t, err)
+	require.True(t, options.Equals(NewVote(1, nil, OptionNo).GetWeightedOptions()))
+
+	_, err = WeightedVoteOptionsFromString("Yes=abc")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.NotNil(t, err)
+
+	for _, str := range []string{"Yes=0.7,Abstain=0.2", "Yes=0.5,Yes=0.5", "Yes=1.5,No=-0.5"} {
+		options, err = WeightedVoteOptionsFromString(str)
+		require.Nil(t, err)
+		require.NotNil(t, options.ValidateBasic(), str)
+	}
+	require.NotNil(t, WeightedVoteOptions{}.ValidateBasic())
+}
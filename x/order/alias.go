@@ -30,16 +30,31 @@ type (
 	MsgNewOrders     = types.MsgNewOrders
 	MsgCancelOrders  = types.MsgCancelOrders
 	BlockMatchResult = types.BlockMatchResult
+	StopOrder        = types.StopOrder
+	PriceBand        = types.PriceBand
+	TradingHalt      = types.TradingHalt
+
+	MsgNewStopOrders    = types.MsgNewStopOrders
+	MsgCancelStopOrders = types.MsgCancelStopOrders
+	MsgAmendOrders      = types.MsgAmendOrders
+
+	MsgSetProductFeeRates   = types.MsgSetProductFeeRates
+	MsgRegisterReferralCode = types.MsgRegisterReferralCode
 )
 
 // nolint
 // functions aliases
 var (
-	RegisterCodec     = types.RegisterCodec
-	DefaultParams     = types.DefaultParams
-	NewMsgNewOrder    = types.NewMsgNewOrder
-	NewMsgCancelOrder = types.NewMsgCancelOrder
-	NewKeeper         = keeper.NewKeeper
-	NewQuerier        = keeper.NewQuerier
-	FormatOrderIDsKey = types.FormatOrderIDsKey
+	RegisterCodec              = types.RegisterCodec
+	DefaultParams              = types.DefaultParams
+	NewMsgNewOrder             = types.NewMsgNewOrder
+	NewMsgCancelOrder          = types.NewMsgCancelOrder
+	NewMsgNewStopOrders        = types.NewMsgNewStopOrders
+	NewMsgCancelStopOrders     = types.NewMsgCancelStopOrders
+	NewMsgAmendOrders          = types.NewMsgAmendOrders
+	NewMsgSetProductFeeRates   = types.NewMsgSetProductFeeRates
+	NewMsgRegisterReferralCode = types.NewMsgRegisterReferralCode
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	FormatOrderIDsKey          = types.FormatOrderIDsKey
 )
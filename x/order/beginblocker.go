@@ -5,15 +5,20 @@ import (
 
 	"github.com/okex/okexchain/x/common/perf"
 	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/match"
 	"github.com/okex/okexchain/x/order/types"
 	//"github.com/okex/okexchain/x/common/version"
 )
 
 // BeginBlocker runs the logic of BeginBlocker with version 0.
-// BeginBlocker resets keeper cache.
+// BeginBlocker resets keeper cache and triggers the stop orders crossed by the last price.
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	seq := perf.GetPerf().OnBeginBlockEnter(ctx, types.ModuleName)
 	defer perf.GetPerf().OnBeginBlockExit(ctx, types.ModuleName, seq)
 
 	keeper.ResetCache(ctx)
+
+	for _, order := range keeper.TriggerStopOrders(ctx) {
+		match.GetEngine(keeper.GetAuctionType(ctx, order.Product)).MatchOrder(ctx, keeper, order)
+	}
 }
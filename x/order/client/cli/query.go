@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"strings"
-
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -26,6 +25,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDepthBook(queryRoute, cdc),
 		GetCmdQueryStore(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryStopOrders(queryRoute, cdc),
+		GetCmdQueryFeeRates(queryRoute, cdc),
+		GetCmdQueryReferralCode(queryRoute, cdc),
+		GetCmdQueryReferralEarnings(queryRoute, cdc),
+		GetCmdQueryTradingHalts(queryRoute, cdc),
 	)...)
 
 	queryCmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
@@ -55,6 +59,111 @@ func GetCmdQueryOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryStopOrders queries the pending stop orders of an address
+func GetCmdQueryStopOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stop-orders [address]",
+		Short: "Query the pending stop orders of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryStopOrders, args[0]),
+				nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryFeeRates queries the deal fee rates of an address trading a product
+func GetCmdQueryFeeRates(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-rates [product] [address]",
+		Short: "Query the maker and taker fee rates of an address trading a product",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, types.QueryFeeRates, args[0], args[1]),
+				nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryReferralCode queries a referral code registered by a dex operator
+func GetCmdQueryReferralCode(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "referral-code [code]",
+		Short: "Query the operator, referrer and rebate rate of a referral code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryReferralCode, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryReferralEarnings queries the total rebates earned by a referrer
+func GetCmdQueryReferralEarnings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "referral-earnings [address]",
+		Short: "Query the total rebates earned by a referrer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryReferralEarnings, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryTradingHalts queries the products halted by the volatility halt
+func GetCmdQueryTradingHalts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trading-halts [product]",
+		Short: "Query the products whose matching is halted, or the halt of a product",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTradingHalts)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
 // GetCmdDepthBook queries order book about a product
 func GetCmdDepthBook(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +26,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(client.PostCommands(
 		getCmdNewOrder(cdc),
 		getCmdCancelOrder(cdc),
+		getCmdNewStopOrder(cdc),
+		getCmdCancelStopOrder(cdc),
+		getCmdAmendOrder(cdc),
+		getCmdSetProductFeeRates(cdc),
+		getCmdRegisterReferralCode(cdc),
 	)...)
 
 	return txCmd
@@ -37,6 +42,8 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	var side string
 	var price string
 	var quantity string
+	var orderType string
+	var referralCode string
 	cmd := &cobra.Command{
 		Use:   "new",
 		Short: "place a new order",
@@ -53,7 +60,7 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 				return errors.New("invalid param counts")
 			}
 
-			err := handleNewOrder(cmd, cdc, product, side, price, quantity)
+			err := handleNewOrder(cmd, cdc, product, side, price, quantity, orderType, referralCode)
 			return err
 
 		},
@@ -63,10 +70,14 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL (default \"SELL\")")
 	cmd.Flags().StringVarP(&price, "price", "p", "", "The price of the order")
 	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the order")
+	cmd.Flags().StringVarP(&orderType, "type", "t", "", "The type of the order: IOC, FOK, POST_ONLY or MARKET "+
+		"(default limit order). The price of a MARKET order is the worst price")
+	cmd.Flags().StringVarP(&referralCode, "referral-code", "", "", "The referral code registered by the operator of the products")
 	return cmd
 }
 
-func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string) error {
+func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string,
+	orderType string, referralCode string) error {
 	var items []types.OrderItem
 	productArr := strings.Split(product, ",")
 	sideArr := strings.Split(side, ",")
@@ -84,6 +95,14 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 		return errors.New("invalid param quantity counts")
 	}
 
+	typeArr := make([]string, len(productArr))
+	if len(orderType) > 0 {
+		typeArr = strings.Split(orderType, ",")
+		if len(productArr) != len(typeArr) {
+			return errors.New("invalid param type counts")
+		}
+	}
+
 	for i := 0; i < len(productArr); i++ {
 		product := productArr[i]
 		side := sideArr[i]
@@ -100,6 +119,7 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 			Side:     side,
 			Price:    price,
 			Quantity: quantity,
+			Type:     strings.ToUpper(typeArr[i]),
 		})
 	}
 	inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -107,6 +127,7 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 	cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 	msg := types.NewMsgNewOrders(cliCtx.GetFromAddress(), items)
+	msg.ReferralCode = referralCode
 	err := utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 	return err
 }
@@ -131,3 +152,177 @@ func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdNewStopOrder(cdc *codec.Codec) *cobra.Command {
+	// new stop order flags
+	var product string
+	var side string
+	var price string
+	var quantity string
+	var stopType string
+	var triggerPrice string
+	cmd := &cobra.Command{
+		Use:   "new-stop",
+		Short: "place a new stop-loss or take-profit order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(product) == 0 || len(side) == 0 || len(price) == 0 || len(quantity) == 0 ||
+				len(stopType) == 0 || len(triggerPrice) == 0 {
+				return errors.New("invalid param format")
+			}
+			decPrice, err := sdk.NewDecFromStr(price)
+			if err != nil {
+				return err
+			}
+			decQuantity, err := sdk.NewDecFromStr(quantity)
+			if err != nil {
+				return err
+			}
+			decTriggerPrice, err := sdk.NewDecFromStr(triggerPrice)
+			if err != nil {
+				return err
+			}
+			item := types.StopOrderItem{
+				Product:      product,
+				Side:         side,
+				Price:        decPrice,
+				Quantity:     decQuantity,
+				StopType:     strings.ToUpper(stopType),
+				TriggerPrice: decTriggerPrice,
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgNewStopOrders(cliCtx.GetFromAddress(), []types.StopOrderItem{item})
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&product, "product", "", "", "Trading pair in full name of the tokens: ${baseAssetSymbol}_${quoteAssetSymbol}, for example \"mycoin_okt\".")
+	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL")
+	cmd.Flags().StringVarP(&price, "price", "p", "", "The price of the order placed when triggered")
+	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the order")
+	cmd.Flags().StringVarP(&stopType, "stop-type", "", "", "STOP_LOSS or TAKE_PROFIT")
+	cmd.Flags().StringVarP(&triggerPrice, "trigger-price", "", "", "The last price which triggers the order")
+	return cmd
+}
+
+func getCmdCancelStopOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-stop [stop-order-id]",
+		Short: "cancel stop order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stopOrderIDs := strings.Split(args[0], ",")
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCancelStopOrders(cliCtx.GetFromAddress(), stopOrderIDs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdAmendOrder(cdc *codec.Codec) *cobra.Command {
+	var price string
+	var quantity string
+	cmd := &cobra.Command{
+		Use:   "amend [order-id]",
+		Short: "amend the price or quantity of an open order",
+		Long: "amend the price or quantity of an open order. The quantity is the new total quantity of the order. " +
+			"Decreasing the quantity at the same price keeps the order id, otherwise the order is replaced by a new one",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderIDArr := strings.Split(args[0], ",")
+			priceArr := strings.Split(price, ",")
+			quantityArr := strings.Split(quantity, ",")
+			if len(orderIDArr) != len(priceArr) {
+				return errors.New("invalid param price counts")
+			}
+			if len(orderIDArr) != len(quantityArr) {
+				return errors.New("invalid param quantity counts")
+			}
+
+			items := make([]types.AmendOrderItem, 0, len(orderIDArr))
+			for i, orderID := range orderIDArr {
+				decPrice, err := sdk.NewDecFromStr(priceArr[i])
+				if err != nil {
+					return err
+				}
+				decQuantity, err := sdk.NewDecFromStr(quantityArr[i])
+				if err != nil {
+					return err
+				}
+				items = append(items, types.AmendOrderItem{
+					OrderID:  orderID,
+					Price:    decPrice,
+					Quantity: decQuantity,
+				})
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgAmendOrders(cliCtx.GetFromAddress(), items)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&price, "price", "p", "", "The new price of the order")
+	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The new total quantity of the order")
+	return cmd
+}
+
+func getCmdSetProductFeeRates(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-fee-rates [product] [maker-fee-rate] [taker-fee-rate]",
+		Short: "set the maker and taker fee rates of a product by its owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			makerFeeRate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			takerFeeRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgSetProductFeeRates(cliCtx.GetFromAddress(), args[0], makerFeeRate, takerFeeRate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdRegisterReferralCode(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-referral-code [code] [referrer] [rebate-rate]",
+		Short: "register or update a referral code by a dex operator, sharing the rebate rate of deal fees with the referrer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			referrer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			rebateRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgRegisterReferralCode(cliCtx.GetFromAddress(), args[0], referrer, rebateRate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -11,14 +11,17 @@ import (
 )
 
 // EndBlocker called every block
-// 1. execute matching engine
+// 1. execute matching engines
 // 2. flush cache
 func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 	seq := perf.GetPerf().OnEndBlockEnter(ctx, types.ModuleName)
 	defer perf.GetPerf().OnEndBlockExit(ctx, types.ModuleName, seq)
 
-	match.GetEngine().Run(ctx, keeper)
+	for _, engine := range match.GetEngines() {
+		engine.Run(ctx, keeper)
+	}
+	keeper.RemoveEndedTradingHalts(ctx)
 
 	// flush cache at the end
 	keeper.Cache2Disk(ctx)
@@ -38,6 +41,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	message += tailmsg("OpenNum", ret.OpenNum)
 	message += tailmsg("CancelNum", ret.CancelNum)
 	message += tailmsg("ExpireNum", ret.ExpireNum)
+	message += tailmsg("KillNum", ret.KillNum)
 	message += tailmsg("PartialFillNum", ret.PartialFillNum)
 	perf.GetPerf().EnqueueMsg(message)
 }
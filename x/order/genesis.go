@@ -10,8 +10,15 @@ import (
 
 // GenesisState - all order state that must be provided at genesis
 type GenesisState struct {
-	Params     types.Params   `json:"params"`
-	OpenOrders []*types.Order `json:"open_orders"`
+	Params           types.Params                    `json:"params"`
+	OpenOrders       []*types.Order                  `json:"open_orders"`
+	StopOrders       []*types.StopOrder              `json:"stop_orders,omitempty"`
+	StopOrderSeq     uint64                          `json:"stop_order_seq,omitempty"`
+	DealVolumes      []types.DealVolume              `json:"deal_volumes,omitempty"`
+	ProductFeeRates  []types.ProductFeeRatesExported `json:"product_fee_rates,omitempty"`
+	ReferralCodes    []types.ReferralCode            `json:"referral_codes,omitempty"`
+	ReferralEarnings []types.ReferralEarnings        `json:"referral_earnings,omitempty"`
+	TradingHalts     []types.TradingHalt             `json:"trading_halts,omitempty"`
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
@@ -54,6 +61,35 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 	if len(data.OpenOrders) > 0 {
 		keeper.Cache2Disk(ctx)
 	}
+
+	// the coins locked by the stop orders are restored by the genesis of token module together with the ones of
+	// open orders, so only the stop orders themselves are reset here
+	for _, stopOrder := range data.StopOrders {
+		if stopOrder == nil {
+			panic("the nil pointer is not expected")
+		}
+		keeper.SetStopOrder(ctx, stopOrder)
+	}
+	keeper.SetStopOrderSeq(ctx, data.StopOrderSeq)
+
+	for _, dealVolume := range data.DealVolumes {
+		keeper.SetDealVolume(ctx, dealVolume)
+	}
+	for _, rates := range data.ProductFeeRates {
+		keeper.SetProductFeeRates(ctx, rates.Product, types.ProductFeeRates{
+			MakerFeeRate: rates.MakerFeeRate,
+			TakerFeeRate: rates.TakerFeeRate,
+		})
+	}
+	for _, code := range data.ReferralCodes {
+		keeper.SetReferralCode(ctx, code)
+	}
+	for _, earnings := range data.ReferralEarnings {
+		keeper.SetReferralEarnings(ctx, earnings.Referrer, earnings.Earnings)
+	}
+	for i := range data.TradingHalts {
+		keeper.SetTradingHalt(ctx, &data.TradingHalts[i])
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -109,8 +145,49 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data GenesisState) {
 		}
 	}
 
+	var stopOrders []*types.StopOrder
+	keeper.IterateStopOrders(ctx, func(stopOrder *types.StopOrder) (stop bool) {
+		stopOrders = append(stopOrders, stopOrder)
+		return false
+	})
+
+	var dealVolumes []types.DealVolume
+	keeper.IterateDealVolumes(ctx, func(dealVolume types.DealVolume) (stop bool) {
+		dealVolumes = append(dealVolumes, dealVolume)
+		return false
+	})
+
+	var productFeeRates []types.ProductFeeRatesExported
+	keeper.IterateProductFeeRates(ctx, func(product string, rates types.ProductFeeRates) (stop bool) {
+		productFeeRates = append(productFeeRates, types.ProductFeeRatesExported{
+			Product:      product,
+			MakerFeeRate: rates.MakerFeeRate,
+			TakerFeeRate: rates.TakerFeeRate,
+		})
+		return false
+	})
+
+	var referralCodes []types.ReferralCode
+	keeper.IterateReferralCodes(ctx, func(code types.ReferralCode) (stop bool) {
+		referralCodes = append(referralCodes, code)
+		return false
+	})
+
+	var referralEarnings []types.ReferralEarnings
+	keeper.IterateReferralEarnings(ctx, func(earnings types.ReferralEarnings) (stop bool) {
+		referralEarnings = append(referralEarnings, earnings)
+		return false
+	})
+
 	return GenesisState{
-		Params:     *params,
-		OpenOrders: openOrders,
+		Params:           *params,
+		OpenOrders:       openOrders,
+		StopOrders:       stopOrders,
+		StopOrderSeq:     keeper.GetStopOrderSeq(ctx),
+		DealVolumes:      dealVolumes,
+		ProductFeeRates:  productFeeRates,
+		ReferralCodes:    referralCodes,
+		ReferralEarnings: referralEarnings,
+		TradingHalts:     keeper.GetTradingHalts(ctx),
 	}
 }
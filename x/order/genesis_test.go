@@ -148,3 +148,53 @@ func TestExportGenesis(t *testing.T) {
 	// 0x20
 	require.Equal(t, int64(2), newOrderKeeper.GetStoreOrderNum(newCtx))
 }
+
+func TestExportGenesisStates(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	ctx := testInput.Ctx
+	orderKeeper := testInput.OrderKeeper
+	addr := testInput.TestAddrs[0]
+
+	stopOrder := types.NewStopOrder("txHash", addr, types.TestTokenPair, types.SellOrder, sdk.NewDec(9),
+		sdk.NewDec(1), types.StopTypeStopLoss, sdk.NewDec(10), time.Now().Unix(), 100)
+	stopOrder.StopOrderID = "STOP1"
+	stopOrder.CreationHeight = 1
+	stopOrder.FeePerBlock = types.DefaultFeePerBlock
+	orderKeeper.SetStopOrder(ctx, stopOrder)
+	orderKeeper.SetStopOrderSeq(ctx, 1)
+	orderKeeper.AddDealVolume(ctx, addr, common.NativeToken, sdk.NewDec(100))
+	orderKeeper.SetProductFeeRates(ctx, types.TestTokenPair, types.ProductFeeRates{
+		MakerFeeRate: sdk.NewDecWithPrec(1, 3),
+		TakerFeeRate: sdk.NewDecWithPrec(2, 3),
+	})
+	code := types.ReferralCode{Code: "code", Operator: addr, Referrer: testInput.TestAddrs[1],
+		RebateRate: sdk.NewDecWithPrec(1, 1)}
+	orderKeeper.SetReferralCode(ctx, code)
+	earnings := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(3))
+	orderKeeper.SetReferralEarnings(ctx, code.Referrer, earnings)
+	halt := types.TradingHalt{Product: types.TestTokenPair, StartHeight: 1, EndHeight: 10,
+		RefPrice: sdk.NewDec(10), MatchPrice: sdk.NewDec(12)}
+	orderKeeper.SetTradingHalt(ctx, &halt)
+
+	exportGenesis := ExportGenesis(ctx, orderKeeper)
+	require.Equal(t, []*types.StopOrder{stopOrder}, exportGenesis.StopOrders)
+	require.Equal(t, uint64(1), exportGenesis.StopOrderSeq)
+	require.Equal(t, 1, len(exportGenesis.DealVolumes))
+	require.Equal(t, 1, len(exportGenesis.ProductFeeRates))
+	require.Equal(t, []types.ReferralCode{code}, exportGenesis.ReferralCodes)
+	require.Equal(t, 1, len(exportGenesis.ReferralEarnings))
+	require.Equal(t, []types.TradingHalt{halt}, exportGenesis.TradingHalts)
+
+	newTestInput := keeper.CreateTestInput(t)
+	newCtx := newTestInput.Ctx.WithBlockHeader(ctx.BlockHeader())
+	newOrderKeeper := newTestInput.OrderKeeper
+	InitGenesis(newCtx, newOrderKeeper, exportGenesis)
+	require.Equal(t, stopOrder, newOrderKeeper.GetStopOrder(newCtx, stopOrder.StopOrderID))
+	require.Equal(t, uint64(1), newOrderKeeper.GetStopOrderSeq(newCtx))
+	require.Equal(t, sdk.NewDec(100), newOrderKeeper.GetDealVolume(newCtx, addr, common.NativeToken))
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), newOrderKeeper.GetProductFeeRates(newCtx, types.TestTokenPair).MakerFeeRate)
+	require.Equal(t, &code, newOrderKeeper.GetReferralCode(newCtx, code.Code))
+	require.Equal(t, earnings, newOrderKeeper.GetReferralEarnings(newCtx, code.Referrer))
+	require.Equal(t, &halt, newOrderKeeper.GetTradingHalt(newCtx, types.TestTokenPair))
+	require.Equal(t, exportGenesis, ExportGenesis(newCtx, newOrderKeeper))
+}
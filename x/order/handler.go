@@ -15,6 +15,7 @@ import (
 
 	"github.com/okex/okexchain/x/common/perf"
 	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/match"
 	"github.com/okex/okexchain/x/order/types"
 )
 
@@ -24,6 +25,16 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	case types.MsgCancelOrders:
 		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgNewStopOrders:
+		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
+	case types.MsgCancelStopOrders:
+		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgAmendOrders:
+		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
+	case types.MsgSetProductFeeRates:
+		gas = params.CancelOrderMsgGasUnit
+	case types.MsgRegisterReferralCode:
+		gas = params.CancelOrderMsgGasUnit
 	default:
 		gas = math.MaxUint64
 	}
@@ -63,6 +74,31 @@ func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgCancelOrders(ctx, keeper, msg, logger)
 			}
+		case types.MsgNewStopOrders:
+			name = "handleMsgNewStopOrders"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgNewStopOrders(ctx, keeper, msg, logger)
+			}
+		case types.MsgCancelStopOrders:
+			name = "handleMsgCancelStopOrders"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCancelStopOrders(ctx, keeper, msg, logger)
+			}
+		case types.MsgAmendOrders:
+			name = "handleMsgAmendOrders"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgAmendOrders(ctx, keeper, msg, logger)
+			}
+		case types.MsgSetProductFeeRates:
+			name = "handleMsgSetProductFeeRates"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetProductFeeRates(ctx, keeper, msg, logger)
+			}
+		case types.MsgRegisterReferralCode:
+			name = "handleMsgRegisterReferralCode"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgRegisterReferralCode(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Invalid msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -103,9 +139,29 @@ func checkOrderNewMsg(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgNewOrd
 		return types.ErrQuantityOverAccuracy(msg.Quantity, quantityDigit)
 	}
 
+	if !tokenPair.IsValidTick(msg.Price) {
+		return types.ErrPriceNotMultipleOfTickSize(msg.Price, tokenPair.TickSize)
+	}
+	if !tokenPair.IsValidLot(msg.Quantity) {
+		return types.ErrQuantityNotMultipleOfLotSize(msg.Quantity, tokenPair.LotSize)
+	}
+
 	if msg.Quantity.LT(tokenPair.MinQuantity) {
 		return types.ErrMsgQuantityLessThan(tokenPair.MinQuantity.String())
 	}
+
+	if minPrice, maxPrice, ok := keeper.GetPriceBandLimits(ctx, msg.Product); ok &&
+		(msg.Price.LT(minPrice) || msg.Price.GT(maxPrice)) {
+		return types.ErrPriceOutOfBand(msg.Price, minPrice, maxPrice)
+	}
+
+	if msg.Type == types.OrderTypePostOnly && keeper.GetDepthBookCopy(msg.Product).IsCrossed(msg.Price, msg.Side) {
+		return types.ErrPostOnlyOrderWouldCross(msg.Product)
+	}
+
+	if msg.ReferralCode != "" && keeper.GetProductReferralCode(ctx, msg.ReferralCode, msg.Product) == nil {
+		return types.ErrReferralCodeNotExist(msg.ReferralCode, msg.Product)
+	}
 	return nil
 }
 
@@ -113,7 +169,7 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 	feeParams := k.GetParams(ctx)
 	feePerBlockAmount := feeParams.FeePerBlock.Amount.Mul(sdk.MustNewDecFromStr(ratio))
 	feePerBlock := sdk.NewDecCoinFromDec(feeParams.FeePerBlock.Denom, feePerBlockAmount)
-	return types.NewOrder(
+	order := types.NewOrder(
 		fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
 		msg.Sender,
 		msg.Product,
@@ -124,19 +180,24 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 		feeParams.OrderExpireBlocks,
 		feePerBlock,
 	)
+	order.Type = msg.Type
+	order.ReferralCode = msg.ReferralCode
+	return order
 }
 
-func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
-	item types.OrderItem, ratio string, logger log.Logger) (types.OrderResult, sdk.CacheMultiStore, error) {
+func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress, item types.OrderItem, referralCode string,
+	ratio string, logger log.Logger) (types.OrderResult, sdk.CacheMultiStore, error) {
 
 	cacheItem := ctx.MultiStore().CacheMultiStore()
 	ctxItem := ctx.WithMultiStore(cacheItem)
 	msg := MsgNewOrder{
-		Sender:   sender,
-		Product:  item.Product,
-		Side:     item.Side,
-		Price:    item.Price,
-		Quantity: item.Quantity,
+		Sender:       sender,
+		Product:      item.Product,
+		Side:         item.Side,
+		Price:        item.Price,
+		Quantity:     item.Quantity,
+		Type:         item.Type,
+		ReferralCode: referralCode,
 	}
 	order := getOrderFromMsg(ctxItem, k, msg, ratio)
 	err := checkOrderNewMsg(ctxItem, k, msg)
@@ -149,6 +210,11 @@ func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
 		}
 	}
 
+	if err == nil {
+		// orders of continuous auction products are matched immediately
+		match.GetEngine(k.GetAuctionType(ctxItem, order.Product)).MatchOrder(ctxItem, k, order)
+	}
+
 	res := types.OrderResult{
 		Error:   err,
 		OrderID: order.OrderID,
@@ -182,7 +248,7 @@ func handleMsgNewOrders(ctx sdk.Context, k Keeper, msg types.MsgNewOrders,
 	rs := make([]types.OrderResult, 0, len(msg.OrderItems))
 	var handlerResult bitset.BitSet
 	for idx, item := range msg.OrderItems {
-		res, cacheItem, err := handleNewOrder(ctx, k, msg.Sender, item, ratio, logger)
+		res, cacheItem, err := handleNewOrder(ctx, k, msg.Sender, item, msg.ReferralCode, ratio, logger)
 		if err == nil {
 			cacheItem.Write()
 			handlerResult.Set(uint(idx))
@@ -215,11 +281,13 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 
 	for _, item := range msg.OrderItems {
 		msg := MsgNewOrder{
-			Sender:   msg.Sender,
-			Product:  item.Product,
-			Side:     item.Side,
-			Price:    item.Price,
-			Quantity: item.Quantity,
+			Sender:       msg.Sender,
+			Product:      item.Product,
+			Side:         item.Side,
+			Price:        item.Price,
+			Quantity:     item.Quantity,
+			Type:         item.Type,
+			ReferralCode: msg.ReferralCode,
 		}
 		err := checkOrderNewMsg(ctx, k, msg)
 		if err != nil {
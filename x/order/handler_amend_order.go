@@ -0,0 +1,107 @@
+package order
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/match"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func validateAmendOrder(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress,
+	item types.AmendOrderItem) (*types.Order, error) {
+	err := validateCancelOrder(ctx, k, MsgCancelOrder{Sender: sender, OrderID: item.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	order := k.GetOrder(ctx, item.OrderID)
+	msg := MsgNewOrder{
+		Sender:   sender,
+		Product:  order.Product,
+		Side:     order.Side,
+		Price:    item.Price,
+		Quantity: item.Quantity,
+		Type:     order.Type,
+	}
+	if err := checkOrderNewMsg(ctx, k, msg); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func handleAmendOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress, item types.AmendOrderItem,
+	logger log.Logger) (types.OrderResult, sdk.CacheMultiStore, error) {
+
+	cacheItem := ctx.MultiStore().CacheMultiStore()
+	ctxItem := ctx.WithMultiStore(cacheItem)
+
+	res := types.OrderResult{
+		OrderID: item.OrderID,
+	}
+	order, err := validateAmendOrder(ctxItem, k, sender, item)
+	if err == nil {
+		txHash := fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+		var amended *types.Order
+		if amended, err = k.AmendOrder(ctxItem, order, item.Price, item.Quantity, txHash); err == nil {
+			if amended.OrderID != item.OrderID {
+				// the replacing order of a continuous auction product is matched immediately
+				match.GetEngine(k.GetAuctionType(ctxItem, amended.Product)).MatchOrder(ctxItem, k, amended)
+			}
+			res.OrderID = amended.OrderID
+			logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, order<%s> amended to order<%s>, "+
+				"price<%s>, quantity<%s>", ctx.BlockHeight(), "handleMsgAmendOrders", item.OrderID,
+				amended.OrderID, item.Price, item.Quantity))
+		}
+	}
+
+	if err != nil {
+		res.Error = err
+		res.Message = err.Error()
+	}
+	return res, cacheItem, err
+}
+
+func handleMsgAmendOrders(ctx sdk.Context, k Keeper, msg types.MsgAmendOrders,
+	logger log.Logger) (*sdk.Result, error) {
+
+	rs := make([]types.OrderResult, 0, len(msg.AmendOrderItems))
+	succeeded := false
+	for _, item := range msg.AmendOrderItems {
+		res, cacheItem, err := handleAmendOrder(ctx, k, msg.Sender, item, logger)
+		if err == nil {
+			cacheItem.Write()
+			succeeded = true
+		}
+		rs = append(rs, res)
+	}
+
+	rss, err := json.Marshal(&rs)
+	if err != nil {
+		rss = []byte(fmt.Sprintf("failed to marshal result to JSON: %s", err))
+	}
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	event = event.AppendAttributes(sdk.NewAttribute("orders", string(rss)))
+	ctx.EventManager().EmitEvent(event)
+
+	if !succeeded {
+		return types.ErrAllOrderFailedToExecute().Result()
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// ValidateMsgAmendOrders validates whether the msg of amendOrders is valid.
+func ValidateMsgAmendOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgAmendOrders) error {
+	for _, item := range msg.AmendOrderItems {
+		if _, err := validateAmendOrder(ctx, k, msg.Sender, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
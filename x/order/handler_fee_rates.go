@@ -0,0 +1,36 @@
+package order
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func handleMsgSetProductFeeRates(ctx sdk.Context, k Keeper, msg types.MsgSetProductFeeRates,
+	logger log.Logger) (*sdk.Result, error) {
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return types.ErrTokenPairNotExist(msg.Product).Result()
+	}
+	if !tokenPair.Owner.Equals(msg.Owner) {
+		return types.ErrMustProductOwner(msg.Owner.String(), msg.Product).Result()
+	}
+
+	k.SetProductFeeRates(ctx, msg.Product, types.ProductFeeRates{
+		MakerFeeRate: msg.MakerFeeRate,
+		TakerFeeRate: msg.TakerFeeRate,
+	})
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, fee rates of product<%s> are set to maker<%s> taker<%s>",
+		ctx.BlockHeight(), "handleMsgSetProductFeeRates", msg.Product, msg.MakerFeeRate, msg.TakerFeeRate))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+	))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
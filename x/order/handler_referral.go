@@ -0,0 +1,38 @@
+package order
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func handleMsgRegisterReferralCode(ctx sdk.Context, k Keeper, msg types.MsgRegisterReferralCode,
+	logger log.Logger) (*sdk.Result, error) {
+	if _, exists := k.GetDexKeeper().GetOperator(ctx, msg.Operator); !exists {
+		return types.ErrNotDexOperator(msg.Operator.String()).Result()
+	}
+	// a registered code can only be updated by the operator registering it
+	if code := k.GetReferralCode(ctx, msg.Code); code != nil && !code.Operator.Equals(msg.Operator) {
+		return types.ErrInvalidReferralCode(msg.Code).Result()
+	}
+
+	k.SetReferralCode(ctx, types.ReferralCode{
+		Code:       msg.Code,
+		Operator:   msg.Operator,
+		Referrer:   msg.Referrer,
+		RebateRate: msg.RebateRate,
+	})
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, referral code<%s> of operator<%s> is set to referrer<%s> rebate rate<%s>",
+		ctx.BlockHeight(), "handleMsgRegisterReferralCode", msg.Code, msg.Operator, msg.Referrer, msg.RebateRate))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator.String()),
+	))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
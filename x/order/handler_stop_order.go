@@ -0,0 +1,169 @@
+package order
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func getStopOrderFromItem(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress,
+	item types.StopOrderItem) *types.StopOrder {
+	feeParams := k.GetParams(ctx)
+	return types.NewStopOrder(
+		fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		sender,
+		item.Product,
+		item.Side,
+		item.Price,
+		item.Quantity,
+		item.StopType,
+		item.TriggerPrice,
+		ctx.BlockHeader().Time.Unix(),
+		ctx.BlockHeight()+feeParams.OrderExpireBlocks,
+	)
+}
+
+func checkStopOrderItem(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress, item types.StopOrderItem) error {
+	msg := MsgNewOrder{
+		Sender:   sender,
+		Product:  item.Product,
+		Side:     item.Side,
+		Price:    item.Price,
+		Quantity: item.Quantity,
+	}
+	if err := checkOrderNewMsg(ctx, k, msg); err != nil {
+		return err
+	}
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, item.Product)
+	if !item.TriggerPrice.RoundDecimal(tokenPair.MaxPriceDigit).Equal(item.TriggerPrice) {
+		return types.ErrPriceOverAccuracy(item.TriggerPrice, tokenPair.MaxPriceDigit)
+	}
+	return nil
+}
+
+func handleMsgNewStopOrders(ctx sdk.Context, k Keeper, msg types.MsgNewStopOrders,
+	logger log.Logger) (*sdk.Result, error) {
+
+	rs := make([]types.OrderResult, 0, len(msg.StopOrderItems))
+	succeeded := false
+	for _, item := range msg.StopOrderItems {
+		cacheItem := ctx.MultiStore().CacheMultiStore()
+		ctxItem := ctx.WithMultiStore(cacheItem)
+
+		stopOrder := getStopOrderFromItem(ctxItem, k, msg.Sender, item)
+		err := checkStopOrderItem(ctxItem, k, msg.Sender, item)
+		if err == nil {
+			err = k.PlaceStopOrder(ctxItem, stopOrder)
+		}
+
+		res := types.OrderResult{
+			Error:   err,
+			OrderID: stopOrder.StopOrderID,
+		}
+		if err == nil {
+			cacheItem.Write()
+			succeeded = true
+			logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, stop order<%s> created, trigger price<%s>",
+				ctx.BlockHeight(), "handleMsgNewStopOrders", stopOrder.StopOrderID, stopOrder.TriggerPrice))
+		} else {
+			res.Message = err.Error()
+		}
+		rs = append(rs, res)
+	}
+
+	rss, err := json.Marshal(&rs)
+	if err != nil {
+		rss = []byte(fmt.Sprintf("failed to marshal result to JSON: %s", err))
+	}
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	event = event.AppendAttributes(sdk.NewAttribute("stop_orders", string(rss)))
+	ctx.EventManager().EmitEvent(event)
+
+	if !succeeded {
+		return types.ErrAllOrderFailedToExecute().Result()
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func validateCancelStopOrder(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress,
+	stopOrderID string) (*types.StopOrder, error) {
+	stopOrder := k.GetStopOrder(ctx, stopOrderID)
+	if stopOrder == nil {
+		return nil, types.ErrStopOrderIsNotExist(stopOrderID)
+	}
+	if !stopOrder.Sender.Equals(sender) {
+		return nil, types.ErrNotOrderOwner(stopOrderID)
+	}
+	return stopOrder, nil
+}
+
+func handleMsgCancelStopOrders(ctx sdk.Context, k Keeper, msg types.MsgCancelStopOrders,
+	logger log.Logger) (*sdk.Result, error) {
+
+	rs := make([]types.OrderResult, 0, len(msg.StopOrderIDs))
+	succeeded := false
+	for _, stopOrderID := range msg.StopOrderIDs {
+		stopOrder, err := validateCancelStopOrder(ctx, k, msg.Sender, stopOrderID)
+		res := types.OrderResult{
+			Error:   err,
+			OrderID: stopOrderID,
+		}
+		if err == nil {
+			k.QuitStopOrder(ctx, stopOrder, types.FeeTypeOrderCancel)
+			succeeded = true
+			logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, stop order<%s> canceled",
+				ctx.BlockHeight(), "handleMsgCancelStopOrders", stopOrderID))
+		} else {
+			res.Message = err.Error()
+		}
+		rs = append(rs, res)
+	}
+
+	rss, err := json.Marshal(&rs)
+	if err != nil {
+		rss = []byte(fmt.Sprintf("failed to marshal result to JSON: %s", err))
+	}
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	event = event.AppendAttributes(sdk.NewAttribute("stop_orders", string(rss)))
+	ctx.EventManager().EmitEvent(event)
+
+	if !succeeded {
+		return types.ErrNoOrdersIsCanceled().Result()
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// ValidateMsgNewStopOrders validates whether the msg of newStopOrders is valid.
+func ValidateMsgNewStopOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewStopOrders) error {
+	for _, item := range msg.StopOrderItems {
+		if err := checkStopOrderItem(ctx, k, msg.Sender, item); err != nil {
+			return err
+		}
+		stopOrder := getStopOrderFromItem(ctx, k, msg.Sender, item)
+		if err := k.PlaceStopOrder(ctx, stopOrder); err != nil {
+			return common.ErrInsufficientCoins(DefaultParamspace, err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateMsgCancelStopOrders validates whether the msg of cancelStopOrders is valid.
+func ValidateMsgCancelStopOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelStopOrders) error {
+	for _, stopOrderID := range msg.StopOrderIDs {
+		if _, err := validateCancelStopOrder(ctx, k, msg.Sender, stopOrderID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -195,6 +195,71 @@ func TestValidateMsgNewOrder(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestValidateMsgNewOrderTickLotSize(t *testing.T) {
+	common.InitConfig()
+	mapp, addrKeysSlice := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	keeper := mapp.orderKeeper
+	feeParams := types.DefaultTestParams()
+	keeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	tokenPair.TickSize = sdk.MustNewDecFromStr("0.5")
+	tokenPair.LotSize = sdk.MustNewDecFromStr("0.1")
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	// normal
+	msg := types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.BuyOrder, "9.5", "1.2")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.Nil(t, err)
+
+	// price is not a multiple of the tick size
+	msg = types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.BuyOrder, "9.6", "1.2")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.EqualValues(t, types.ErrPriceNotMultipleOfTickSize(sdk.MustNewDecFromStr("9.6"), tokenPair.TickSize).Error(),
+		err.Error())
+
+	// quantity is not a multiple of the lot size
+	msg = types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.BuyOrder, "9.5", "1.25")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.EqualValues(t, types.ErrQuantityNotMultipleOfLotSize(sdk.MustNewDecFromStr("1.25"), tokenPair.LotSize).Error(),
+		err.Error())
+}
+
+func TestValidateMsgNewOrderPriceBand(t *testing.T) {
+	common.InitConfig()
+	mapp, addrKeysSlice := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	keeper := mapp.orderKeeper
+	feeParams := types.DefaultTestParams()
+	feeParams.PriceBands = []types.PriceBand{
+		{Product: types.TestTokenPair, BandRate: sdk.MustNewDecFromStr("0.1"),
+			HaltThreshold: sdk.ZeroDec(), HaltBlocks: 0},
+	}
+	keeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	// the last price is 10.0, orders should be priced in [9.0, 11.0]
+	msg := types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.BuyOrder, "11.0", "1.0")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.Nil(t, err)
+
+	msg = types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.BuyOrder, "11.1", "1.0")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.EqualValues(t, types.ErrPriceOutOfBand(sdk.MustNewDecFromStr("11.1"), sdk.MustNewDecFromStr("9.0"),
+		sdk.MustNewDecFromStr("11.0")).Error(), err.Error())
+
+	msg = types.NewMsgNewOrder(addrKeysSlice[0].Address, types.TestTokenPair, types.SellOrder, "8.9", "1.0")
+	_, err = ValidateMsgNewOrders(ctx, keeper, msg)
+	require.NotNil(t, err)
+}
+
 // test order cancel without enough okb as fee
 func TestHandleMsgCancelOrder2(t *testing.T) {
 	mapp, addrKeysSlice := getMockApp(t, 1)
@@ -209,6 +209,15 @@ func (c *DiskCache) closeOrder(orderID string) {
 	c.openNum--
 }
 
+// reduceOrder reduces the quantity of an open order in depthBookMap, the order keeps its place in orderIDsMap
+func (c *DiskCache) reduceOrder(order *types.Order, quantity sdk.Dec) {
+	depthBook := c.getDepthBook(order.Product)
+	if depthBook != nil {
+		depthBook.SubByPrice(order.Price, quantity, order.Side)
+		c.setDepthBook(order.Product, depthBook)
+	}
+}
+
 // remove an order from orderIDsMap when order cancelled/expired
 func (c *DiskCache) removeOrder(order *types.Order) {
 
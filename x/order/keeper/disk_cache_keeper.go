@@ -53,6 +53,34 @@ func (k Keeper) DropOrder(ctx sdk.Context, orderID string) {
 	store.Delete(types.GetOrderKey(orderID))
 }
 
+// ===============================================
+// ImmediateOrder means an IOC/FOK/market order whose unfilled part will be killed after matching
+
+// SetImmediateOrder records an immediate order
+func (k Keeper) SetImmediateOrder(ctx sdk.Context, order *types.Order) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetImmediateOrderKey(order.Product, order.OrderID), []byte(order.OrderID))
+}
+
+// DropImmediateOrder deletes an immediate order record
+func (k Keeper) DropImmediateOrder(ctx sdk.Context, order *types.Order) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetImmediateOrderKey(order.Product, order.OrderID))
+}
+
+// GetImmediateOrderIDs returns the ids of the immediate orders of a product which have not been killed
+func (k Keeper) GetImmediateOrderIDs(ctx sdk.Context, product string) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetImmediateOrderPrefix(product))
+	defer iter.Close()
+
+	var orderIDs []string
+	for ; iter.Valid(); iter.Next() {
+		orderIDs = append(orderIDs, string(iter.Value()))
+	}
+	return orderIDs
+}
+
 // ===============================================
 // nolint
 func (k Keeper) StoreDepthBook(ctx sdk.Context, product string, depthBook *types.DepthBook) {
@@ -21,6 +21,7 @@ type TokenKeeper interface {
 	AddFeeDetail(ctx sdk.Context, from string, fee sdk.SysCoins, feeType string, receiver string)
 	GetAllLockedCoins(ctx sdk.Context) (locks []token.AccCoins)
 	IterateLockedFees(ctx sdk.Context, cb func(acc sdk.AccAddress, coins sdk.SysCoins) (stop bool))
+	IterateLockedVestings(ctx sdk.Context, cb func(acc sdk.AccAddress, coins sdk.SysCoins) (stop bool))
 }
 
 // SupplyKeeper : expected supply keeper
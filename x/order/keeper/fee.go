@@ -41,14 +41,39 @@ func GetOrderCostFee(order *types.Order, ctx sdk.Context) sdk.SysCoins {
 
 }
 
+// GetStopOrderNewFee is used to calculate the handling fee that needs to be locked when placing a stop order
+func GetStopOrderNewFee(stopOrder *types.StopOrder) sdk.SysCoins {
+	// the stop orders placed before charging fees have no fee per block
+	if stopOrder.FeePerBlock.Denom == "" {
+		return GetZeroFee()
+	}
+	blockNum := sdk.NewDec(stopOrder.ExpireBlockHeight - stopOrder.CreationHeight)
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(stopOrder.FeePerBlock.Denom, stopOrder.FeePerBlock.Amount.Mul(blockNum))}
+}
+
+// GetStopOrderCostFee is used to calculate the handling fee when quiting a stop order
+func GetStopOrderCostFee(stopOrder *types.StopOrder, ctx sdk.Context) sdk.SysCoins {
+	if stopOrder.FeePerBlock.Denom == "" {
+		return GetZeroFee()
+	}
+	blockNum := ctx.BlockHeight() - stopOrder.CreationHeight
+	if blockNum < 0 {
+		blockNum = 0
+	} else if blockNum > stopOrder.ExpireBlockHeight-stopOrder.CreationHeight {
+		blockNum = stopOrder.ExpireBlockHeight - stopOrder.CreationHeight
+	}
+	costFee := stopOrder.FeePerBlock.Amount.Mul(sdk.NewDec(blockNum))
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(stopOrder.FeePerBlock.Denom, costFee)}
+}
+
 // GetZeroFee returns zeroFee
 func GetZeroFee() sdk.SysCoins {
 	return sdk.SysCoins{sdk.ZeroFee()}
 }
 
-// GetDealFee is used to calculate the handling fee when matching an order
+// GetDealFee is used to calculate the handling fee when matching an order with the deal fee rate of the order
 func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper GetFeeKeeper,
-	feeParams *types.Params) sdk.SysCoins {
+	feeRate sdk.Dec) sdk.SysCoins {
 	symbols := strings.Split(order.Product, "_")
 	symbol := symbols[0]
 	quantity := fillAmt
@@ -58,7 +83,7 @@ func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper Get
 	}
 
 	minFeeDec := sdk.MustNewDecFromStr(minFee)
-	feeAmt := quantity.Mul(feeParams.TradeFeeRate)
+	feeAmt := quantity.Mul(feeRate)
 	if feeAmt.GT(minFeeDec) {
 		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, feeAmt)}
 	}
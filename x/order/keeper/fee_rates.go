@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+func getDay(ctx sdk.Context) int64 {
+	return ctx.BlockHeader().Time.Unix() / secondsPerDay
+}
+
+// getFirstDayInWindow returns the first day of the rolling window of deal volume
+func getFirstDayInWindow(day int64) int64 {
+	if firstDay := day - types.DealVolumeWindowDays + 1; firstDay > 0 {
+		return firstDay
+	}
+	return 0
+}
+
+// AddDealVolume adds the deal volume of an address in the quote token to the day of current block.
+// The daily volumes are kept apart by the quote token, since the amounts of different tokens can't be summed.
+// The daily volumes out of the rolling window are deleted when a new day starts.
+func (k Keeper) AddDealVolume(ctx sdk.Context, addr sdk.AccAddress, quoteDenom string, volume sdk.Dec) {
+	store := ctx.KVStore(k.orderStoreKey)
+	day := getDay(ctx)
+	key := types.GetDealVolumeKey(addr, quoteDenom, day)
+
+	dayVolume := sdk.ZeroDec()
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &dayVolume)
+	} else {
+		iter := store.Iterator(types.GetDealVolumePrefix(addr, quoteDenom),
+			types.GetDealVolumeKey(addr, quoteDenom, getFirstDayInWindow(day)))
+		var expiredKeys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			expiredKeys = append(expiredKeys, iter.Key())
+		}
+		iter.Close()
+		for _, expiredKey := range expiredKeys {
+			store.Delete(expiredKey)
+		}
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(dayVolume.Add(volume)))
+}
+
+// GetDealVolume returns the deal volume of an address in the quote token in the last DealVolumeWindowDays days
+func (k Keeper) GetDealVolume(ctx sdk.Context, addr sdk.AccAddress, quoteDenom string) sdk.Dec {
+	store := ctx.KVStore(k.orderStoreKey)
+	day := getDay(ctx)
+	iter := store.Iterator(types.GetDealVolumeKey(addr, quoteDenom, getFirstDayInWindow(day)),
+		types.GetDealVolumeKey(addr, quoteDenom, day+1))
+	defer iter.Close()
+
+	volume := sdk.ZeroDec()
+	for ; iter.Valid(); iter.Next() {
+		var dayVolume sdk.Dec
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &dayVolume)
+		volume = volume.Add(dayVolume)
+	}
+	return volume
+}
+
+// SetDealVolume sets the deal volume of an address in the quote token in a day
+func (k Keeper) SetDealVolume(ctx sdk.Context, dealVolume types.DealVolume) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetDealVolumeKey(dealVolume.Address, dealVolume.QuoteDenom, dealVolume.Day),
+		k.cdc.MustMarshalBinaryBare(dealVolume.Volume))
+}
+
+// IterateDealVolumes iterates over the daily deal volumes of all the addresses
+func (k Keeper) IterateDealVolumes(ctx sdk.Context, cb func(dealVolume types.DealVolume) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DealVolumeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr, quoteDenom, day := types.SplitDealVolumeKey(iter.Key())
+		dealVolume := types.DealVolume{Address: addr, QuoteDenom: quoteDenom, Day: day}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &dealVolume.Volume)
+		if cb(dealVolume) {
+			break
+		}
+	}
+}
+
+// SetProductFeeRates sets the fee rates of a product, which override the fee rates in params
+func (k Keeper) SetProductFeeRates(ctx sdk.Context, product string, rates types.ProductFeeRates) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetProductFeeRatesKey(product), k.cdc.MustMarshalBinaryBare(rates))
+}
+
+// GetProductFeeRates returns the fee rates of a product, or nil if the product has no fee rates set
+func (k Keeper) GetProductFeeRates(ctx sdk.Context, product string) *types.ProductFeeRates {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetProductFeeRatesKey(product))
+	if bz == nil {
+		return nil
+	}
+	rates := &types.ProductFeeRates{}
+	k.cdc.MustUnmarshalBinaryBare(bz, rates)
+	return rates
+}
+
+// IterateProductFeeRates iterates over the fee rates of all the products
+func (k Keeper) IterateProductFeeRates(ctx sdk.Context, cb func(product string, rates types.ProductFeeRates) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ProductFeeRatesKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rates types.ProductFeeRates
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &rates)
+		if cb(types.GetKey(iter), rates) {
+			break
+		}
+	}
+}
+
+// GetFeeRates returns the maker and taker fee rates of an address trading the product.
+// The fee rates of the product, or the fee rates in params if not set, are lowered by the fee tier of the address.
+func (k Keeper) GetFeeRates(ctx sdk.Context, addr sdk.AccAddress, product string,
+	feeParams *types.Params) (makerFeeRate, takerFeeRate sdk.Dec) {
+	makerFeeRate, takerFeeRate = feeParams.MakerFeeRate, feeParams.TradeFeeRate
+	if rates := k.GetProductFeeRates(ctx, product); rates != nil {
+		makerFeeRate, takerFeeRate = rates.MakerFeeRate, rates.TakerFeeRate
+	}
+	if len(feeParams.FeeTiers) == 0 {
+		return
+	}
+	if tier := feeParams.GetFeeTier(k.GetDealVolume(ctx, addr, types.GetQuoteDenom(product))); tier != nil {
+		makerFeeRate = sdk.MinDec(makerFeeRate, tier.MakerFeeRate)
+		takerFeeRate = sdk.MinDec(takerFeeRate, tier.TakerFeeRate)
+	}
+	return
+}
+
+// GetDealFeeRate returns the deal fee rate of an order
+func (k Keeper) GetDealFeeRate(ctx sdk.Context, order *types.Order, isMaker bool, feeParams *types.Params) sdk.Dec {
+	makerFeeRate, takerFeeRate := k.GetFeeRates(ctx, order.Sender, order.Product, feeParams)
+	if isMaker {
+		return makerFeeRate
+	}
+	return takerFeeRate
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestDealVolume(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	addr := testInput.TestAddrs[0]
+	quoteDenom := types.GetQuoteDenom(types.TestTokenPair)
+	day := time.Duration(secondsPerDay) * time.Second
+
+	ctx := testInput.Ctx.WithBlockTime(time.Unix(0, 0).Add(100 * day))
+	keeper.AddDealVolume(ctx, addr, quoteDenom, sdk.MustNewDecFromStr("10"))
+	keeper.AddDealVolume(ctx, addr, quoteDenom, sdk.MustNewDecFromStr("5"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("15"), keeper.GetDealVolume(ctx, addr, quoteDenom))
+	require.True(t, keeper.GetDealVolume(ctx, testInput.TestAddrs[1], quoteDenom).IsZero())
+
+	// the volumes in different quote tokens are kept apart
+	keeper.AddDealVolume(ctx, addr, "other", sdk.MustNewDecFromStr("7"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("7"), keeper.GetDealVolume(ctx, addr, "other"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("15"), keeper.GetDealVolume(ctx, addr, quoteDenom))
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(types.DealVolumeWindowDays * day / 2))
+	keeper.AddDealVolume(ctx, addr, quoteDenom, sdk.MustNewDecFromStr("20"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("35"), keeper.GetDealVolume(ctx, addr, quoteDenom))
+
+	// the volume of the first day is out of the rolling window
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(types.DealVolumeWindowDays * day / 2))
+	require.EqualValues(t, sdk.MustNewDecFromStr("20"), keeper.GetDealVolume(ctx, addr, quoteDenom))
+	keeper.AddDealVolume(ctx, addr, quoteDenom, sdk.MustNewDecFromStr("1"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("21"), keeper.GetDealVolume(ctx, addr, quoteDenom))
+	require.Nil(t, ctx.KVStore(keeper.orderStoreKey).Get(types.GetDealVolumeKey(addr, quoteDenom, 100)))
+}
+
+func TestGetDealFeeRate(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	feeParams := types.DefaultTestParams()
+	feeParams.MakerFeeRate = sdk.MustNewDecFromStr("0.0005")
+	feeParams.FeeTiers = []types.FeeTier{
+		{
+			MinVolume:    sdk.MustNewDecFromStr("1000"),
+			MakerFeeRate: sdk.MustNewDecFromStr("0.0002"),
+			TakerFeeRate: sdk.MustNewDecFromStr("0.0008"),
+		},
+		{
+			MinVolume:    sdk.MustNewDecFromStr("10000"),
+			MakerFeeRate: sdk.ZeroDec(),
+			TakerFeeRate: sdk.MustNewDecFromStr("0.0006"),
+		},
+	}
+
+	order := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	order.Sender = testInput.TestAddrs[0]
+	quoteDenom := types.GetQuoteDenom(order.Product)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0005"), keeper.GetDealFeeRate(ctx, order, true, &feeParams))
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.001"), keeper.GetDealFeeRate(ctx, order, false, &feeParams))
+
+	// the volume in another quote token doesn't count
+	keeper.AddDealVolume(ctx, order.Sender, "other", sdk.MustNewDecFromStr("10000"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0005"), keeper.GetDealFeeRate(ctx, order, true, &feeParams))
+
+	// reach the first fee tier
+	keeper.AddDealVolume(ctx, order.Sender, quoteDenom, sdk.MustNewDecFromStr("1000"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0002"), keeper.GetDealFeeRate(ctx, order, true, &feeParams))
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0008"), keeper.GetDealFeeRate(ctx, order, false, &feeParams))
+
+	// the fee rates of the product are lowered by the fee tier
+	keeper.SetProductFeeRates(ctx, types.TestTokenPair, types.ProductFeeRates{
+		MakerFeeRate: sdk.MustNewDecFromStr("0.0001"),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+	})
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0001"), keeper.GetDealFeeRate(ctx, order, true, &feeParams))
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0008"), keeper.GetDealFeeRate(ctx, order, false, &feeParams))
+
+	// reach the second fee tier
+	keeper.AddDealVolume(ctx, order.Sender, quoteDenom, sdk.MustNewDecFromStr("9000"))
+	require.True(t, keeper.GetDealFeeRate(ctx, order, true, &feeParams).IsZero())
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.0006"), keeper.GetDealFeeRate(ctx, order, false, &feeParams))
+}
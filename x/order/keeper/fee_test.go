@@ -80,7 +80,7 @@ func TestOrderDealFee(t *testing.T) {
 		Quantity: sdk.MustNewDecFromStr("100.0"),
 	}
 	keeper.priceMap[types.TestTokenPair] = sdk.MustNewDecFromStr("10.0")
-	feeOther := GetDealFee(order, sdk.MustNewDecFromStr("10.0"), ctx, keeper, &feeParams)
+	feeOther := GetDealFee(order, sdk.MustNewDecFromStr("10.0"), ctx, keeper, feeParams.TradeFeeRate)
 	// 10 * 0.001
 	expectFee := sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("0.01"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -95,7 +95,7 @@ func TestOrderDealFee(t *testing.T) {
 	keeper.priceMap["xxb_yyb"] = sdk.MustNewDecFromStr("20.0")
 	keeper.priceMap["yyb_"+common.NativeToken] = sdk.MustNewDecFromStr("0.6")
 
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, feeParams.TradeFeeRate)
 	// 100 * 0.001
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("0.1"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -107,7 +107,7 @@ func TestOrderDealFee(t *testing.T) {
 		Price:    sdk.MustNewDecFromStr("11.0"),
 		Quantity: sdk.MustNewDecFromStr("100.0"),
 	}
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("100.0"), ctx, keeper, feeParams.TradeFeeRate)
 	// 100 * 20 * 0.001
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec("yyb", sdk.MustNewDecFromStr("2.0"))}
 	require.EqualValues(t, expectFee, feeOther)
@@ -119,7 +119,7 @@ func TestOrderDealFee(t *testing.T) {
 		Price:    sdk.MustNewDecFromStr("1.0"),
 		Quantity: sdk.MustNewDecFromStr("0.00000001"),
 	}
-	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("0.000000000000000001"), ctx, keeper, &feeParams)
+	feeOther = GetDealFee(order, sdk.MustNewDecFromStr("0.000000000000000001"), ctx, keeper, feeParams.TradeFeeRate)
 	expectFee = sdk.SysCoins{sdk.NewDecCoinFromDec("xxb", sdk.MustNewDecFromStr(minFee))}
 	require.EqualValues(t, expectFee, feeOther)
 }
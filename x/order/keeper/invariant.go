@@ -17,7 +17,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 // locks amounts held on store
 func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		var lockedCoins, lockedFees, orderLockedFees sdk.SysCoins
+		var lockedCoins, lockedFees, lockedVestings, orderLockedFees sdk.SysCoins
 
 		for _, accCoins := range keeper.tokenKeeper.GetAllLockedCoins(ctx) {
 			lockedCoins = lockedCoins.Add2(accCoins.Coins)
@@ -29,6 +29,12 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 			return false
 		})
 
+		// coins locked by the vesting schedules are also kept in the token module account
+		keeper.tokenKeeper.IterateLockedVestings(ctx, func(acc sdk.AccAddress, coins sdk.SysCoins) bool {
+			lockedVestings = lockedVestings.Add2(coins)
+			return false
+		})
+
 		// get open orders lock fee
 		products := keeper.GetProductsFromDepthBookMap()
 		for _, product := range products {
@@ -52,9 +58,10 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 		}
 
 		macc := keeper.supplyKeeper.GetModuleAccount(ctx, token.ModuleName)
-		broken := !macc.GetCoins().IsEqual(lockedCoins.Add2(lockedFees))
+		totalLocked := lockedCoins.Add2(lockedFees).Add2(lockedVestings)
+		broken := !macc.GetCoins().IsEqual(totalLocked)
 		return sdk.FormatInvariant(types.ModuleName, "locks",
 			fmt.Sprintf("\ttoken ModuleAccount coins: %s\n\tsum of locks amounts:  %s\n",
-				macc.GetCoins(), lockedCoins.Add2(lockedFees))), broken
+				macc.GetCoins(), totalLocked)), broken
 	}
 }
@@ -134,6 +134,7 @@ type OrderOperationMetric struct {
 	OpenNum        int64
 	CancelNum      int64
 	ExpireNum      int64
+	KillNum        int64
 	PartialFillNum int64
 }
 
@@ -144,6 +145,7 @@ func (k Keeper) GetOperationMetric() OrderOperationMetric {
 		OpenNum:        k.diskCache.getOpenNum(),
 		CancelNum:      k.cache.GetCancelNum(),
 		ExpireNum:      k.cache.GetExpireNum(),
+		KillNum:        k.cache.GetKillNum(),
 		PartialFillNum: k.cache.GetPartialFillNum(),
 	}
 }
@@ -363,6 +365,29 @@ func (k Keeper) SetBlockMatchResult(result *types.BlockMatchResult) {
 	}
 }
 
+// AddContinuousMatchResult records deals filled by the continuous auction engine in DeliverTx
+func (k Keeper) AddContinuousMatchResult(product string, result types.MatchResult) {
+	if k.enableBackend {
+		k.cache.addContinuousMatchResult(product, result)
+	}
+}
+
+// GetContinuousMatchResults returns the deals filled by the continuous auction engine in current block
+func (k Keeper) GetContinuousMatchResults() map[string]types.MatchResult {
+	return k.cache.getContinuousMatchResults()
+}
+
+// AddContinuousDealNum records the number of the maker orders filled by the continuous auction engine
+func (k Keeper) AddContinuousDealNum(num int64) {
+	k.cache.addContinuousDealNum(num)
+}
+
+// GetContinuousDealNum returns the number of the maker orders filled by the continuous auction engine in current
+// block, which is bounded by MaxDealsPerBlock
+func (k Keeper) GetContinuousDealNum() int64 {
+	return k.cache.getContinuousDealNum()
+}
+
 // LockCoins locks coins from the specified address,
 func (k Keeper) LockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error {
 	if coins.IsZero() {
@@ -449,10 +474,16 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.SysCoins, from sdk.A
 	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, from, k.feeCollectorName, baseCoins)
 }
 
-// GetParams gets inflation params from the global param store
+// GetAuctionType returns the auction type which the specified product is matched with
+func (k Keeper) GetAuctionType(ctx sdk.Context, product string) string {
+	return k.GetParams(ctx).GetAuctionType(product)
+}
+
+// GetParams gets inflation params from the global param store, the params unset before the upgrade get the default
+// values
 func (k Keeper) GetParams(ctx sdk.Context) *types.Params {
-	var param types.Params
-	k.paramSpace.GetParamSet(ctx, &param)
+	param := types.DefaultParams()
+	params.GetParamSetIfExists(ctx, k.paramSpace, &param)
 	return &param
 }
 
@@ -472,6 +503,7 @@ func (k Keeper) SetMetric() {
 	k.metric.PendingNum.Set(float64(k.diskCache.openNum))
 	k.metric.CanceledNum.Set(float64(k.cache.cancelNum))
 	k.metric.ExpiredNum.Set(float64(k.cache.expireNum))
+	k.metric.KilledNum.Set(float64(k.cache.killNum))
 	k.metric.PartialFilledNum.Set(float64(k.cache.partialFillNum))
 }
 
@@ -496,13 +528,17 @@ func (k Keeper) GetBestBidAndAsk(ctx sdk.Context, product string) (sdk.Dec, sdk.
 	return bestBid, bestAsk
 }
 
-// RemoveOrderFromDepthBook removes order from depthBook, and updates cancelNum, expireNum, updatedOrderIDs from cache
+// RemoveOrderFromDepthBook removes order from depthBook, and updates cancelNum, expireNum, killNum, updatedOrderIDs
+// from cache
 func (k Keeper) RemoveOrderFromDepthBook(order *types.Order, feeType string) {
 	k.addUpdatedOrderID(order.OrderID)
-	if feeType == types.FeeTypeOrderCancel {
+	switch feeType {
+	case types.FeeTypeOrderCancel:
 		k.cache.IncreaseCancelNum()
-	} else if feeType == types.FeeTypeOrderExpire {
+	case types.FeeTypeOrderExpire:
 		k.cache.IncreaseExpireNum()
+	case types.FeeTypeOrderKill:
+		k.cache.IncreaseKillNum()
 	}
 
 	k.diskCache.removeOrder(order)
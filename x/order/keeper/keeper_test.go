@@ -10,6 +10,7 @@ import (
 
 	"github.com/okex/okexchain/x/dex"
 	"github.com/okex/okexchain/x/order/types"
+	"github.com/okex/okexchain/x/params"
 	token "github.com/okex/okexchain/x/token/types"
 )
 
@@ -380,3 +381,19 @@ func TestFilterDelistedProducts(t *testing.T) {
 	cleanProducts := keeper.FilterDelistedProducts(ctx, productsList)
 	require.EqualValues(t, expectedProductsList, cleanProducts)
 }
+
+func TestMigrateParams(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	feeParams := types.DefaultTestParams()
+	feeParams.MakerFeeRate = sdk.MustNewDecFromStr("0.0003")
+	feeParams.ContinuousAuctionProducts = []string{types.TestTokenPair}
+	keeper.SetParams(ctx, &feeParams)
+
+	// the params set are kept
+	defaultParams := types.DefaultParams()
+	params.MigrateParamSet(ctx, keeper.paramSpace, &defaultParams)
+	require.Equal(t, feeParams, *keeper.GetParams(ctx))
+}
@@ -8,13 +8,18 @@ import (
 // Cache stores some caches that will not be written to disk
 type Cache struct {
 	// Reset at BeginBlock
-	updatedOrderIDs  []string
-	blockMatchResult *types.BlockMatchResult
+	updatedOrderIDs    []string
+	blockMatchResult   *types.BlockMatchResult
 	handlerTxMsgResult []bitset.BitSet
+	// match results filled by the continuous auction engine in DeliverTx
+	continuousMatchResults map[string]types.MatchResult
+	// number of the maker orders filled by the continuous auction engine in this block
+	continuousDealNum int64
 
 	// for statistic
 	cancelNum      int64 // canceled orders num in this block
 	expireNum      int64 // expired orders num in this block
+	killNum        int64 // killed immediate orders num in this block
 	partialFillNum int64 // partially filled orders num in this block
 	fullFillNum    int64 // fully filled orders num in this block
 }
@@ -22,8 +27,9 @@ type Cache struct {
 // nolint
 func NewCache() *Cache {
 	return &Cache{
-		updatedOrderIDs:  []string{},
-		blockMatchResult: nil,
+		updatedOrderIDs:        []string{},
+		blockMatchResult:       nil,
+		continuousMatchResults: make(map[string]types.MatchResult),
 	}
 }
 
@@ -32,9 +38,12 @@ func (c *Cache) reset() {
 	c.updatedOrderIDs = []string{}
 	c.blockMatchResult = &types.BlockMatchResult{}
 	c.handlerTxMsgResult = []bitset.BitSet{}
+	c.continuousMatchResults = make(map[string]types.MatchResult)
+	c.continuousDealNum = 0
 
 	c.cancelNum = 0
 	c.expireNum = 0
+	c.killNum = 0
 	c.fullFillNum = 0
 	c.partialFillNum = 0
 }
@@ -47,6 +56,31 @@ func (c *Cache) setBlockMatchResult(result *types.BlockMatchResult) {
 	c.blockMatchResult = result
 }
 
+// addContinuousMatchResult merges the deals of a product into the match result of current block
+func (c *Cache) addContinuousMatchResult(product string, result types.MatchResult) {
+	existing, ok := c.continuousMatchResults[product]
+	if !ok {
+		c.continuousMatchResults[product] = result
+		return
+	}
+	existing.Price = result.Price
+	existing.Quantity = existing.Quantity.Add(result.Quantity)
+	existing.Deals = append(existing.Deals, result.Deals...)
+	c.continuousMatchResults[product] = existing
+}
+
+func (c *Cache) getContinuousMatchResults() map[string]types.MatchResult {
+	return c.continuousMatchResults
+}
+
+func (c *Cache) addContinuousDealNum(num int64) {
+	c.continuousDealNum += num
+}
+
+func (c *Cache) getContinuousDealNum() int64 {
+	return c.continuousDealNum
+}
+
 func (c *Cache) addTxHandlerMsgResult(resultSet bitset.BitSet) {
 	c.handlerTxMsgResult = append(c.handlerTxMsgResult, resultSet)
 }
@@ -57,6 +91,12 @@ func (c *Cache) IncreaseExpireNum() int64 {
 	return c.expireNum
 }
 
+// nolint
+func (c *Cache) IncreaseKillNum() int64 {
+	c.killNum++
+	return c.killNum
+}
+
 // --------
 
 // nolint
@@ -127,6 +167,11 @@ func (c *Cache) GetExpireNum() int64 {
 	return c.expireNum
 }
 
+// nolint
+func (c *Cache) GetKillNum() int64 {
+	return c.killNum
+}
+
 // nolint
 func (c *Cache) GetPartialFillNum() int64 {
 	return c.partialFillNum
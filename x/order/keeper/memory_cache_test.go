@@ -18,6 +18,7 @@ func TestCache_GetCancelNum(t *testing.T) {
 
 	cache.IncreaseCancelNum()
 	cache.IncreaseExpireNum()
+	cache.IncreaseKillNum()
 	cache.IncreaseFullFillNum()
 	cache.IncreasePartialFillNum()
 
@@ -27,6 +28,7 @@ func TestCache_GetCancelNum(t *testing.T) {
 
 	require.EqualValues(t, 0, cache.GetCancelNum())
 	require.EqualValues(t, 1, cache.GetExpireNum())
+	require.EqualValues(t, 1, cache.GetKillNum())
 	require.EqualValues(t, 0, cache.GetFullFillNum())
 	require.EqualValues(t, 0, cache.GetPartialFillNum())
 
@@ -42,16 +42,115 @@ func (k Keeper) PlaceOrder(ctx sdk.Context, order *types.Order) error {
 	order.RecordOrderNewFee(fee)
 	k.AddFeeDetail(ctx, order.Sender, fee, types.FeeTypeOrderNew)
 
+	k.insertOrder(ctx, order)
+	return nil
+}
+
+// insertOrder assigns a new order id to the order, saves it and inserts it into the depth book
+func (k Keeper) insertOrder(ctx sdk.Context, order *types.Order) {
 	blockHeight := ctx.BlockHeight()
 	orderNum := k.GetBlockOrderNum(ctx, blockHeight)
 	order.OrderID = types.FormatOrderID(blockHeight, orderNum+1)
 
 	k.SetBlockOrderNum(ctx, blockHeight, orderNum+1)
 	k.SetOrder(ctx, order.OrderID, order)
+	if order.IsImmediate() {
+		k.SetImmediateOrder(ctx, order)
+	}
 
 	// update depth book and orderIDsMap in cache
 	k.InsertOrderIntoDepthBook(order)
-	return nil
+}
+
+// AmendOrder changes the price or the total quantity of an open order in one step.
+// Decreasing the quantity at the same price amends the order in place, and the order keeps its id and time priority.
+// Otherwise the order is closed with the fee for the blocks it rests charged, and replaced by a new order,
+// which takes over the unconsumed locked fee of the closed one.
+// Only the difference of the locked coins is locked or unlocked. It returns the amended order.
+func (k Keeper) AmendOrder(ctx sdk.Context, order *types.Order, price, quantity sdk.Dec,
+	txHash string) (*types.Order, error) {
+	filledQuantity := order.Quantity.Sub(order.RemainQuantity)
+	if quantity.LTE(filledQuantity) {
+		return nil, types.ErrInvalidAmendQuantity(quantity, filledQuantity)
+	}
+	if price.Equal(order.Price) && quantity.Equal(order.Quantity) {
+		return nil, types.ErrOrderIsNotAmended(order.OrderID)
+	}
+
+	if price.Equal(order.Price) && quantity.LT(order.Quantity) {
+		k.reduceOrder(ctx, order, order.Quantity.Sub(quantity))
+		return order, nil
+	}
+	return k.replaceOrder(ctx, order, price, quantity.Sub(filledQuantity), txHash)
+}
+
+// reduceOrder reduces the quantity of an open order and unlocks the coins of the reduced part
+func (k Keeper) reduceOrder(ctx sdk.Context, order *types.Order, reducedQuantity sdk.Dec) {
+	oldLockedCoins := order.NeedUnlockCoins()
+	order.Quantity = order.Quantity.Sub(reducedQuantity)
+	order.RemainQuantity = order.RemainQuantity.Sub(reducedQuantity)
+	if order.Side == types.BuyOrder {
+		order.RemainLocked = order.RemainLocked.Sub(order.Price.Mul(reducedQuantity))
+	} else {
+		order.RemainLocked = order.RemainLocked.Sub(reducedQuantity)
+	}
+	k.UnlockCoins(ctx, order.Sender, oldLockedCoins.Sub(order.NeedUnlockCoins()), token.LockCoinsTypeQuantity)
+
+	k.SetOrder(ctx, order.OrderID, order)
+	k.addUpdatedOrderID(order.OrderID)
+	k.diskCache.reduceOrder(order, reducedQuantity)
+}
+
+// replaceOrder closes an open order as cancelling it, and places a new order with the remaining quantity
+func (k Keeper) replaceOrder(ctx sdk.Context, order *types.Order, price, remainQuantity sdk.Dec,
+	txHash string) (*types.Order, error) {
+	newOrder := types.NewOrder(txHash, order.Sender, order.Product, order.Side, price, remainQuantity,
+		ctx.BlockHeader().Time.Unix(), order.OrderExpireBlocks, order.FeePerBlock)
+	newOrder.Type = order.Type
+	newOrder.ReferralCode = order.ReferralCode
+
+	// lock or unlock the difference of the locked coins
+	lockDenom := newOrder.NeedLockCoins()[0].Denom
+	diff := newOrder.RemainLocked.Sub(order.RemainLocked)
+	if diff.IsPositive() {
+		diffCoins := sdk.SysCoins{sdk.NewDecCoinFromDec(lockDenom, diff)}
+		if err := k.LockCoins(ctx, order.Sender, diffCoins, token.LockCoinsTypeQuantity); err != nil {
+			return nil, err
+		}
+	} else if diff.IsNegative() {
+		diffCoins := sdk.SysCoins{sdk.NewDecCoinFromDec(lockDenom, diff.Neg())}
+		k.UnlockCoins(ctx, order.Sender, diffCoins, token.LockCoinsTypeQuantity)
+	}
+
+	// charge the fee for the blocks the old order rests from its locked fee, and carry the unconsumed
+	// locked fee over to the new order, so that only the charged part is locked again
+	lockedFee := GetOrderNewFee(order)
+	fee := GetOrderCostFee(order, ctx)
+	carriedFee := lockedFee.Sub(fee)
+	k.UnlockCoins(ctx, order.Sender, fee, token.LockCoinsTypeFee)
+	if err := k.AddCollectedFees(ctx, fee, order.Sender, types.FeeTypeOrderCancel, false); err != nil {
+		return nil, err
+	}
+	newFee := GetOrderNewFee(newOrder)
+	topUpFee := newFee.Sub(carriedFee)
+	if err := k.LockCoins(ctx, order.Sender, topUpFee, token.LockCoinsTypeFee); err != nil {
+		return nil, err
+	}
+	order.RecordOrderCancelFee(fee)
+	k.AddFeeDetail(ctx, order.Sender, topUpFee, types.FeeTypeOrderNew)
+	newOrder.RecordOrderNewFee(newFee)
+
+	order.Cancel()
+	order.Unlock()
+	k.SetOrder(ctx, order.OrderID, order)
+	if order.IsImmediate() {
+		k.DropImmediateOrder(ctx, order)
+	}
+	k.RemoveOrderFromDepthBook(order, types.FeeTypeOrderCancel)
+
+	k.insertOrder(ctx, newOrder)
+	k.addUpdatedOrderID(newOrder.OrderID)
+	return newOrder, nil
 }
 
 // ExpireOrder quits the specified order with the expired state
@@ -64,6 +163,11 @@ func (k Keeper) CancelOrder(ctx sdk.Context, order *types.Order, logger log.Logg
 	return k.quitOrder(ctx, order, types.FeeTypeOrderCancel, logger)
 }
 
+// KillOrder quits the unfilled part of an immediate order with the killed state
+func (k Keeper) KillOrder(ctx sdk.Context, order *types.Order, logger log.Logger) sdk.SysCoins {
+	return k.quitOrder(ctx, order, types.FeeTypeOrderKill, logger)
+}
+
 // quitOrder unlocks & charges fee, unlocks coins, updates order, and updates DepthBook
 func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, logger log.Logger) (fee sdk.SysCoins) {
 	switch feeType {
@@ -71,6 +175,8 @@ func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, l
 		order.Cancel()
 	case types.FeeTypeOrderExpire:
 		order.Expire()
+	case types.FeeTypeOrderKill:
+		order.Kill()
 	default:
 		return
 	}
@@ -95,6 +201,9 @@ func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, l
 
 	order.Unlock()
 	k.SetOrder(ctx, order.OrderID, order)
+	if order.IsImmediate() {
+		k.DropImmediateOrder(ctx, order)
+	}
 
 	// remove order from depth book cache
 	k.RemoveOrderFromDepthBook(order, feeType)
@@ -206,3 +206,103 @@ func TestPlaceOrderAndExpireOrder(t *testing.T) {
 	require.EqualValues(t, 0, keeper.diskCache.openNum)
 	require.EqualValues(t, 1, keeper.cache.expireNum)
 }
+
+func TestAmendOrder(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	order := mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	order.Sender = testInput.TestAddrs[0]
+	require.Nil(t, keeper.PlaceOrder(ctx, order))
+
+	// nothing changed
+	_, err = keeper.AmendOrder(ctx, order, order.Price, order.Quantity, "")
+	require.Error(t, err)
+
+	// decrease quantity in place, the order id is kept
+	amended, err := keeper.AmendOrder(ctx, order, sdk.MustNewDecFromStr("10.0"), sdk.MustNewDecFromStr("0.5"), "")
+	require.Nil(t, err)
+	require.Equal(t, types.FormatOrderID(10, 1), amended.OrderID)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.5"), amended.RemainQuantity)
+	require.EqualValues(t, sdk.MustNewDecFromStr("5.0"), amended.RemainLocked)
+	acc := testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins := sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("94.7408")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+	depthBook := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 1, len(depthBook.Items))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), depthBook.Items[0].BuyQuantity)
+
+	// change price, the order is replaced and only the difference is locked
+	amended, err = keeper.AmendOrder(ctx, order, sdk.MustNewDecFromStr("9.0"), sdk.MustNewDecFromStr("2.0"), "")
+	require.Nil(t, err)
+	require.Equal(t, types.FormatOrderID(10, 2), amended.OrderID)
+	require.EqualValues(t, types.OrderStatusCancelled, keeper.GetOrder(ctx, order.OrderID).Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("18.0"), amended.RemainLocked)
+	acc = testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins = sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("81.7408")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+	depthBook = keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 1, len(depthBook.Items))
+	require.Equal(t, sdk.MustNewDecFromStr("9.0"), depthBook.Items[0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("2.0"), depthBook.Items[0].BuyQuantity)
+
+	// the fee for the blocks the replaced order rests is charged
+	ctx = ctx.WithBlockHeight(11)
+	replaced := amended
+	amended, err = keeper.AmendOrder(ctx, amended, sdk.MustNewDecFromStr("8.0"), sdk.MustNewDecFromStr("2.0"), "")
+	require.Nil(t, err)
+	require.Equal(t, types.FormatOrderID(11, 1), amended.OrderID)
+	// the unconsumed locked fee of the replaced order is carried over instead of being returned
+	replaced = keeper.GetOrder(ctx, replaced.OrderID)
+	require.Equal(t, "0.000001000000000000"+common.NativeToken,
+		replaced.GetExtraInfoWithKey(types.OrderExtraInfoKeyCancelFee))
+	require.Equal(t, "", replaced.GetExtraInfoWithKey(types.OrderExtraInfoKeyReceiveFee))
+	require.Equal(t, GetOrderNewFee(replaced), GetOrderNewFee(amended))
+	acc = testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins = sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("83.740799")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+	fee := keeper.CancelOrder(ctx, amended, ctx.Logger())
+	require.Equal(t, "0.000000000000000000"+common.NativeToken, fee.String())
+	acc = testInput.AccountKeeper.GetAccount(ctx, testInput.TestAddrs[0])
+	expectCoins = sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("99.999999")),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("100")),
+	}
+	require.EqualValues(t, expectCoins.String(), acc.GetCoins().String())
+}
+
+func TestPlaceOrderWithFrozenAccount(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	// the quote token locked by the buy order is frozen
+	testInput.TokenKeeper.FreezeAccount(ctx, testInput.TestAddrs[0], common.NativeToken)
+	order := mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	order.Sender = testInput.TestAddrs[0]
+	err = keeper.PlaceOrder(ctx, order)
+	require.NotNil(t, err)
+	require.EqualValues(t, 0, keeper.GetBlockOrderNum(ctx, 10))
+
+	testInput.TokenKeeper.UnfreezeAccount(ctx, testInput.TestAddrs[0], common.NativeToken)
+	err = keeper.PlaceOrder(ctx, order)
+	require.Nil(t, err)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// GetPriceBandLimits returns the lowest and highest order prices allowed for the product around its last price.
+// ok is false if the product has no price band.
+func (k Keeper) GetPriceBandLimits(ctx sdk.Context, product string) (minPrice, maxPrice sdk.Dec, ok bool) {
+	band := k.GetParams(ctx).GetPriceBand(product)
+	if band == nil || !band.BandRate.IsPositive() {
+		return minPrice, maxPrice, false
+	}
+	refPrice := k.GetLastPrice(ctx, product)
+	if !refPrice.IsPositive() {
+		return minPrice, maxPrice, false
+	}
+	minPrice, maxPrice = band.Limits(refPrice)
+	return minPrice, maxPrice, true
+}
+
+// SetTradingHalt saves the trading halt of a product
+func (k Keeper) SetTradingHalt(ctx sdk.Context, halt *types.TradingHalt) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetTradingHaltKey(halt.Product), k.cdc.MustMarshalBinaryBare(halt))
+}
+
+// GetTradingHalt returns the trading halt of a product, or nil if the product is not halted
+func (k Keeper) GetTradingHalt(ctx sdk.Context, product string) *types.TradingHalt {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetTradingHaltKey(product))
+	if bz == nil {
+		return nil
+	}
+	halt := &types.TradingHalt{}
+	k.cdc.MustUnmarshalBinaryBare(bz, halt)
+	return halt
+}
+
+// DeleteTradingHalt deletes the trading halt of a product
+func (k Keeper) DeleteTradingHalt(ctx sdk.Context, product string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetTradingHaltKey(product))
+}
+
+// GetTradingHalts returns the trading halts of all the products
+func (k Keeper) GetTradingHalts(ctx sdk.Context) []types.TradingHalt {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TradingHaltKey)
+	defer iter.Close()
+
+	var halts []types.TradingHalt
+	for ; iter.Valid(); iter.Next() {
+		var halt types.TradingHalt
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &halt)
+		halts = append(halts, halt)
+	}
+	return halts
+}
+
+// IsProductHalted returns whether matching of the product is paused by a volatility halt
+func (k Keeper) IsProductHalted(ctx sdk.Context, product string) bool {
+	halt := k.GetTradingHalt(ctx, product)
+	return halt != nil && halt.IsActive(ctx.BlockHeight())
+}
+
+// CheckTradingHalt is called before an auction of the product is executed at the match price.
+// It halts the product and returns true if the match price moves too far from the reference price.
+// The first auction in the block after a halt ends is executed without the check, and resumes the trading.
+func (k Keeper) CheckTradingHalt(ctx sdk.Context, product string, refPrice, matchPrice sdk.Dec) bool {
+	if halt := k.GetTradingHalt(ctx, product); halt != nil {
+		k.DeleteTradingHalt(ctx, product)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTradingResume,
+			sdk.NewAttribute(types.AttributeKeyProduct, product),
+			sdk.NewAttribute(types.AttributeKeyMatchPrice, matchPrice.String()),
+		))
+		return false
+	}
+
+	band := k.GetParams(ctx).GetPriceBand(product)
+	if band == nil || !band.IsHaltTriggered(refPrice, matchPrice) {
+		return false
+	}
+
+	halt := &types.TradingHalt{
+		Product:     product,
+		StartHeight: ctx.BlockHeight(),
+		EndHeight:   ctx.BlockHeight() + band.HaltBlocks,
+		RefPrice:    refPrice,
+		MatchPrice:  matchPrice,
+	}
+	k.SetTradingHalt(ctx, halt)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTradingHalt,
+		sdk.NewAttribute(types.AttributeKeyProduct, product),
+		sdk.NewAttribute(types.AttributeKeyRefPrice, refPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyMatchPrice, matchPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyHaltEndHeight, fmt.Sprintf("%d", halt.EndHeight)),
+	))
+	ctx.Logger().With("module", "order").Info(fmt.Sprintf("BlockHeight<%d> halt product(%s) until %d, "+
+		"ref price: %s, match price: %s", ctx.BlockHeight(), product, halt.EndHeight, refPrice, matchPrice))
+	return true
+}
+
+// RemoveEndedTradingHalts removes the trading halts which ended before the current block, so that only the
+// auctions in the first block after a halt ends are executed without the check
+func (k Keeper) RemoveEndedTradingHalts(ctx sdk.Context) {
+	for _, halt := range k.GetTradingHalts(ctx) {
+		if halt.EndHeight >= ctx.BlockHeight() {
+			continue
+		}
+		k.DeleteTradingHalt(ctx, halt.Product)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTradingResume,
+			sdk.NewAttribute(types.AttributeKeyProduct, halt.Product),
+		))
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestPriceBandAndTradingHalt(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, dex.GetBuiltInTokenPair()))
+
+	// unprotected product
+	_, _, ok := keeper.GetPriceBandLimits(ctx, types.TestTokenPair)
+	require.False(t, ok)
+
+	params := keeper.GetParams(ctx)
+	params.PriceBands = []types.PriceBand{
+		{Product: types.TestTokenPair, BandRate: sdk.MustNewDecFromStr("0.2"),
+			HaltThreshold: sdk.MustNewDecFromStr("0.1"), HaltBlocks: 3},
+	}
+	keeper.SetParams(ctx, params)
+	minPrice, maxPrice, ok := keeper.GetPriceBandLimits(ctx, types.TestTokenPair)
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("8"), minPrice)
+	require.EqualValues(t, sdk.MustNewDecFromStr("12"), maxPrice)
+
+	refPrice := sdk.MustNewDecFromStr("10")
+	require.False(t, keeper.CheckTradingHalt(ctx, types.TestTokenPair, refPrice, sdk.MustNewDecFromStr("10.5")))
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+	require.True(t, keeper.CheckTradingHalt(ctx, types.TestTokenPair, refPrice, sdk.MustNewDecFromStr("11.5")))
+	require.True(t, keeper.IsProductHalted(ctx, types.TestTokenPair))
+
+	// query halts
+	querier := NewQuerier(keeper)
+	path := []string{types.QueryTradingHalts, types.TestTokenPair}
+	bz, err := querier(ctx, path, abci.RequestQuery{})
+	require.Nil(t, err)
+	var halts []types.TradingHalt
+	keeper.cdc.MustUnmarshalJSON(bz, &halts)
+	require.EqualValues(t, 1, len(halts))
+	require.EqualValues(t, 13, halts[0].EndHeight)
+
+	// the halt ends, and the record is removed by the next auction
+	ctx = ctx.WithBlockHeight(14)
+	require.False(t, keeper.IsProductHalted(ctx, types.TestTokenPair))
+	bz, err = querier(ctx, []string{types.QueryTradingHalts}, abci.RequestQuery{})
+	require.Nil(t, err)
+	halts = nil
+	keeper.cdc.MustUnmarshalJSON(bz, &halts)
+	require.EqualValues(t, 0, len(halts))
+	require.False(t, keeper.CheckTradingHalt(ctx, types.TestTokenPair, refPrice, sdk.MustNewDecFromStr("11.5")))
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+}
+
+func TestRemoveEndedTradingHalts(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	keeper.SetTradingHalt(ctx, &types.TradingHalt{Product: types.TestTokenPair, StartHeight: 5, EndHeight: 10,
+		RefPrice: sdk.OneDec(), MatchPrice: sdk.OneDec()})
+
+	// the halt is kept until the first block after it ends is finished
+	keeper.RemoveEndedTradingHalts(ctx)
+	require.NotNil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+	ctx = ctx.WithBlockHeight(11)
+	keeper.RemoveEndedTradingHalts(ctx)
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+
+	// the stale record doesn't skip the check of a later auction
+	params := keeper.GetParams(ctx)
+	params.PriceBands = []types.PriceBand{
+		{Product: types.TestTokenPair, BandRate: sdk.ZeroDec(),
+			HaltThreshold: sdk.MustNewDecFromStr("0.1"), HaltBlocks: 3},
+	}
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(100)
+	require.True(t, keeper.CheckTradingHalt(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10"),
+		sdk.MustNewDecFromStr("11.5")))
+}
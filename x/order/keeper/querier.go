@@ -29,6 +29,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 		case types.QueryDepthBookV2:
 			return queryDepthBookV2(ctx, path[1:], req, keeper)
+		case types.QueryStopOrders:
+			return queryStopOrders(ctx, path[1:], keeper)
+		case types.QueryFeeRates:
+			return queryFeeRates(ctx, path[1:], keeper)
+		case types.QueryReferralCode:
+			return queryReferralCode(ctx, path[1:], keeper)
+		case types.QueryReferralEarnings:
+			return queryReferralEarnings(ctx, path[1:], keeper)
+		case types.QueryTradingHalts:
+			return queryTradingHalts(ctx, path[1:], keeper)
 		default:
 			return nil, types.ErrUnknownOrderQueryType()
 		}
@@ -46,6 +56,90 @@ func queryOrder(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func queryStopOrders(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrInvalidAddress("")
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, types.ErrInvalidAddress(path[0])
+	}
+	stopOrders := []*types.StopOrder{}
+	keeper.IterateStopOrders(ctx, func(stopOrder *types.StopOrder) bool {
+		if stopOrder.Sender.Equals(addr) {
+			stopOrders = append(stopOrders, stopOrder)
+		}
+		return false
+	})
+	bz := keeper.cdc.MustMarshalJSON(stopOrders)
+	return bz, nil
+}
+
+// queryFeeRates queries the deal fee rates of an address trading a product, with path product/address
+func queryFeeRates(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, types.ErrInvalidAddress("")
+	}
+	if keeper.GetDexKeeper().GetTokenPair(ctx, path[0]) == nil {
+		return nil, types.ErrTokenPairNotExist(path[0])
+	}
+	addr, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, types.ErrInvalidAddress(path[1])
+	}
+
+	makerFeeRate, takerFeeRate := keeper.GetFeeRates(ctx, addr, path[0], keeper.GetParams(ctx))
+	info := types.FeeRatesInfo{
+		Product:      path[0],
+		DealVolume:   keeper.GetDealVolume(ctx, addr, types.GetQuoteDenom(path[0])),
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+	bz := keeper.cdc.MustMarshalJSON(info)
+	return bz, nil
+}
+
+// queryReferralCode queries a referral code, with path code
+func queryReferralCode(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrInvalidReferralCode("")
+	}
+	code := keeper.GetReferralCode(ctx, path[0])
+	if code == nil {
+		return nil, types.ErrInvalidReferralCode(path[0])
+	}
+	bz := keeper.cdc.MustMarshalJSON(code)
+	return bz, nil
+}
+
+// queryReferralEarnings queries the total rebates earned by a referrer, with path address
+func queryReferralEarnings(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrInvalidAddress("")
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, types.ErrInvalidAddress(path[0])
+	}
+	bz := keeper.cdc.MustMarshalJSON(keeper.GetReferralEarnings(ctx, addr))
+	return bz, nil
+}
+
+// queryTradingHalts queries the products halted by the volatility halt, with optional path product
+func queryTradingHalts(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	halts := []types.TradingHalt{}
+	for _, halt := range keeper.GetTradingHalts(ctx) {
+		if len(path) > 0 && halt.Product != path[0] {
+			continue
+		}
+		if halt.IsActive(ctx.BlockHeight()) {
+			halts = append(halts, halt)
+		}
+	}
+	bz := keeper.cdc.MustMarshalJSON(halts)
+	return bz, nil
+}
+
 // QueryDepthBookParams as input parameters when querying the depthBook
 type QueryDepthBookParams struct {
 	Product string
@@ -231,6 +231,7 @@ func TestQueryParameters(t *testing.T) {
 		TradeFeeRate:          sdk.MustNewDecFromStr("0.001"),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+		MakerFeeRate:          sdk.MustNewDecFromStr("0.0005"),
 	}
 	keeper.SetParams(ctx, params)
 	path := []string{types.QueryParameters}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// SetReferralCode sets a referral code registered by a dex operator
+func (k Keeper) SetReferralCode(ctx sdk.Context, code types.ReferralCode) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetReferralCodeKey(code.Code), k.cdc.MustMarshalBinaryBare(code))
+}
+
+// GetReferralCode returns the referral code, or nil if it is not registered
+func (k Keeper) GetReferralCode(ctx sdk.Context, code string) *types.ReferralCode {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetReferralCodeKey(code))
+	if bz == nil {
+		return nil
+	}
+	referralCode := &types.ReferralCode{}
+	k.cdc.MustUnmarshalBinaryBare(bz, referralCode)
+	return referralCode
+}
+
+// IterateReferralCodes iterates over all the referral codes
+func (k Keeper) IterateReferralCodes(ctx sdk.Context, cb func(code types.ReferralCode) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReferralCodeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var code types.ReferralCode
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &code)
+		if cb(code) {
+			break
+		}
+	}
+}
+
+// GetProductReferralCode returns the referral code if it is registered by the operator owning the product,
+// otherwise returns nil
+func (k Keeper) GetProductReferralCode(ctx sdk.Context, code string, product string) *types.ReferralCode {
+	referralCode := k.GetReferralCode(ctx, code)
+	if referralCode == nil {
+		return nil
+	}
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
+	if tokenPair == nil || !tokenPair.Owner.Equals(referralCode.Operator) {
+		return nil
+	}
+	return referralCode
+}
+
+// GetReferralEarnings returns the total rebates earned by a referrer
+func (k Keeper) GetReferralEarnings(ctx sdk.Context, referrer sdk.AccAddress) sdk.SysCoins {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetReferralEarningsKey(referrer))
+	if bz == nil {
+		return sdk.SysCoins{}
+	}
+	var earnings sdk.SysCoins
+	k.cdc.MustUnmarshalBinaryBare(bz, &earnings)
+	return earnings
+}
+
+// SetReferralEarnings sets the total rebates earned by a referrer
+func (k Keeper) SetReferralEarnings(ctx sdk.Context, referrer sdk.AccAddress, earnings sdk.SysCoins) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetReferralEarningsKey(referrer), k.cdc.MustMarshalBinaryBare(earnings))
+}
+
+// IterateReferralEarnings iterates over the rebates earned by all the referrers
+func (k Keeper) IterateReferralEarnings(ctx sdk.Context, cb func(earnings types.ReferralEarnings) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReferralEarningsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		earnings := types.ReferralEarnings{Referrer: sdk.AccAddress(iter.Key()[len(types.ReferralEarningsKey):])}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &earnings.Earnings)
+		if cb(earnings) {
+			break
+		}
+	}
+}
+
+func (k Keeper) addReferralEarnings(ctx sdk.Context, referrer sdk.AccAddress, rebate sdk.SysCoins) {
+	k.SetReferralEarnings(ctx, referrer, k.GetReferralEarnings(ctx, referrer).Add(rebate...))
+}
+
+// SendReferralRebate sends the rebate rate of the deal fee of an order from the fee receiver of the product
+// to the referrer of the order's referral code, and returns the rebate
+func (k Keeper) SendReferralRebate(ctx sdk.Context, order *types.Order, dealFee sdk.SysCoins) sdk.SysCoins {
+	if order.ReferralCode == "" || dealFee.IsZero() {
+		return nil
+	}
+	// the referral code is ignored if the product has been transferred to another operator
+	referralCode := k.GetProductReferralCode(ctx, order.ReferralCode, order.Product)
+	if referralCode == nil {
+		return nil
+	}
+	rebate := dealFee.MulDecTruncate(referralCode.RebateRate)
+	if rebate.IsZero() {
+		return nil
+	}
+
+	from, err := k.GetProductFeeReceiver(ctx, order.Product)
+	if err != nil {
+		return nil
+	}
+	if err := k.tokenKeeper.SendCoinsFromAccountToAccount(ctx, from, referralCode.Referrer, rebate); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Send referral rebate(%s) to address(%s) failed: %s",
+			rebate.String(), referralCode.Referrer.String(), err.Error()))
+		return nil
+	}
+	k.addReferralEarnings(ctx, referralCode.Referrer, rebate)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeReferralRebate,
+		sdk.NewAttribute(types.AttributeKeyOrderID, order.OrderID),
+		sdk.NewAttribute(types.AttributeKeyReferralCode, referralCode.Code),
+		sdk.NewAttribute(types.AttributeKeyReferrer, referralCode.Referrer.String()),
+		sdk.NewAttribute(types.AttributeKeyRebate, rebate.String()),
+	))
+	return rebate
+}
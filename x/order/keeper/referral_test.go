@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestSendReferralRebate(t *testing.T) {
+	testInput := CreateTestInputWithBalance(t, 3, 100)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	operator, referrer := testInput.TestAddrs[0], testInput.TestAddrs[1]
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	tokenPair.Owner = operator
+	require.Nil(t, testInput.DexKeeper.SaveTokenPair(ctx, tokenPair))
+	testInput.DexKeeper.SetOperator(ctx, dex.DEXOperator{Address: operator, HandlingFeeAddress: operator})
+
+	keeper.SetReferralCode(ctx, types.ReferralCode{
+		Code:       "okex2021",
+		Operator:   operator,
+		Referrer:   referrer,
+		RebateRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	require.NotNil(t, keeper.GetProductReferralCode(ctx, "okex2021", types.TestTokenPair))
+	require.Nil(t, keeper.GetProductReferralCode(ctx, "unknown", types.TestTokenPair))
+
+	// the code registered by another operator is not applied to the product
+	keeper.SetReferralCode(ctx, types.ReferralCode{
+		Code:       "other2021",
+		Operator:   testInput.TestAddrs[2],
+		Referrer:   referrer,
+		RebateRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	require.Nil(t, keeper.GetProductReferralCode(ctx, "other2021", types.TestTokenPair))
+
+	order := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	order.Sender = testInput.TestAddrs[2]
+	dealFee := sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("1"))}
+	require.Nil(t, keeper.SendReferralRebate(ctx, order, dealFee))
+
+	// 20% of the deal fee is sent from the fee receiver to the referrer
+	order.ReferralCode = "okex2021"
+	preCoins := keeper.GetCoins(ctx, referrer)
+	rebate := keeper.SendReferralRebate(ctx, order, dealFee)
+	expectedRebate := sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr("0.2"))}
+	require.EqualValues(t, expectedRebate, rebate)
+	require.EqualValues(t, preCoins.Add(expectedRebate...), keeper.GetCoins(ctx, referrer))
+	require.EqualValues(t, expectedRebate, keeper.GetReferralEarnings(ctx, referrer))
+
+	keeper.SendReferralRebate(ctx, order, dealFee)
+	require.EqualValues(t, expectedRebate.Add(expectedRebate...), keeper.GetReferralEarnings(ctx, referrer))
+	require.True(t, keeper.GetReferralEarnings(ctx, operator).IsZero())
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/types"
+	token "github.com/okex/okexchain/x/token/types"
+)
+
+// GetStopOrder gets stop order from KVStore
+func (k Keeper) GetStopOrder(ctx sdk.Context, stopOrderID string) *types.StopOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetStopOrderKey(stopOrderID))
+	if bz == nil {
+		return nil
+	}
+	stopOrder := &types.StopOrder{}
+	k.cdc.MustUnmarshalBinaryBare(bz, stopOrder)
+	return stopOrder
+}
+
+// SetStopOrder sets stop order and its trigger price and expire height indexes to KVStore
+func (k Keeper) SetStopOrder(ctx sdk.Context, stopOrder *types.StopOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetStopOrderKey(stopOrder.StopOrderID), k.cdc.MustMarshalBinaryBare(stopOrder))
+	store.Set(types.GetStopOrderTriggerKey(stopOrder), []byte(stopOrder.StopOrderID))
+	store.Set(types.GetStopOrderExpireKey(stopOrder), []byte(stopOrder.StopOrderID))
+}
+
+// DropStopOrder deletes stop order and its indexes from KVStore
+func (k Keeper) DropStopOrder(ctx sdk.Context, stopOrder *types.StopOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetStopOrderKey(stopOrder.StopOrderID))
+	store.Delete(types.GetStopOrderTriggerKey(stopOrder))
+	store.Delete(types.GetStopOrderExpireKey(stopOrder))
+}
+
+// IterateStopOrders iterates over all the stop orders
+func (k Keeper) IterateStopOrders(ctx sdk.Context, cb func(stopOrder *types.StopOrder) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.StopOrderKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		stopOrder := &types.StopOrder{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), stopOrder)
+		if cb(stopOrder) {
+			break
+		}
+	}
+}
+
+// GetStopOrderSeq returns the sequence of the last stop order id
+func (k Keeper) GetStopOrderSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.orderStoreKey)
+	if bz := store.Get(types.StopOrderSeqKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+// SetStopOrderSeq sets the sequence of the last stop order id
+func (k Keeper) SetStopOrderSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.StopOrderSeqKey, sdk.Uint64ToBigEndian(seq))
+}
+
+func (k Keeper) nextStopOrderSeq(ctx sdk.Context) uint64 {
+	seq := k.GetStopOrderSeq(ctx) + 1
+	k.SetStopOrderSeq(ctx, seq)
+	return seq
+}
+
+// PlaceStopOrder locks coins and the fee for a stop order and saves it outside the depth book
+func (k Keeper) PlaceStopOrder(ctx sdk.Context, stopOrder *types.StopOrder) error {
+	stopOrder.CreationHeight = ctx.BlockHeight()
+	stopOrder.FeePerBlock = k.GetParams(ctx).FeePerBlock
+	if err := k.LockCoins(ctx, stopOrder.Sender, stopOrder.NeedLockCoins(), token.LockCoinsTypeQuantity); err != nil {
+		return err
+	}
+	if err := k.LockCoins(ctx, stopOrder.Sender, GetStopOrderNewFee(stopOrder), token.LockCoinsTypeFee); err != nil {
+		return err
+	}
+	stopOrder.StopOrderID = types.FormatStopOrderID(k.nextStopOrderSeq(ctx))
+	k.SetStopOrder(ctx, stopOrder)
+	return nil
+}
+
+// QuitStopOrder unlocks coins of a stop order, charges the fee for the blocks it rests and deletes it
+func (k Keeper) QuitStopOrder(ctx sdk.Context, stopOrder *types.StopOrder, feeType string) (fee sdk.SysCoins) {
+	k.UnlockCoins(ctx, stopOrder.Sender, stopOrder.NeedLockCoins(), token.LockCoinsTypeQuantity)
+
+	lockedFee := GetStopOrderNewFee(stopOrder)
+	fee = GetStopOrderCostFee(stopOrder, ctx)
+	k.UnlockCoins(ctx, stopOrder.Sender, lockedFee, token.LockCoinsTypeFee)
+	k.AddFeeDetail(ctx, stopOrder.Sender, lockedFee.Sub(fee), types.FeeTypeOrderReceive)
+	if err := k.AddCollectedFees(ctx, fee, stopOrder.Sender, feeType, false); err != nil {
+		ctx.Logger().With("module", "order").Error(fmt.Sprintf("failed to charge stop order(%s) %s fee: %v",
+			stopOrder.StopOrderID, feeType, err))
+	}
+
+	k.DropStopOrder(ctx, stopOrder)
+	return fee
+}
+
+// triggerStopOrder places the order of a triggered stop order into the depth book
+func (k Keeper) triggerStopOrder(ctx sdk.Context, stopOrder *types.StopOrder, feeParams *types.Params) (*types.Order, error) {
+	cacheCtx, write := ctx.CacheContext()
+	k.QuitStopOrder(cacheCtx, stopOrder, types.FeeTypeOrderNew)
+
+	order := types.NewOrder(stopOrder.TxHash, stopOrder.Sender, stopOrder.Product, stopOrder.Side,
+		stopOrder.Price, stopOrder.Quantity, ctx.BlockHeader().Time.Unix(), feeParams.OrderExpireBlocks,
+		feeParams.FeePerBlock)
+	if err := k.PlaceOrder(cacheCtx, order); err != nil {
+		return nil, err
+	}
+	write()
+	return order, nil
+}
+
+func emitStopOrderEvent(ctx sdk.Context, stopOrder *types.StopOrder, status string, orderID string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeStopOrder,
+		sdk.NewAttribute(types.AttributeKeyStopOrderID, stopOrder.StopOrderID),
+		sdk.NewAttribute(types.AttributeKeyProduct, stopOrder.Product),
+		sdk.NewAttribute(types.AttributeKeyStopOrderStatus, status),
+		sdk.NewAttribute(types.AttributeKeyOrderID, orderID),
+	))
+}
+
+// getStopOrdersByIndex returns the stop orders whose ids are the values of the index keys in the range
+func (k Keeper) getStopOrdersByIndex(ctx sdk.Context, start, end []byte) (stopOrders []*types.StopOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if stopOrder := k.GetStopOrder(ctx, string(iter.Value())); stopOrder != nil {
+			stopOrders = append(stopOrders, stopOrder)
+		}
+	}
+	return stopOrders
+}
+
+// getTriggeredStopOrders returns the stop orders of a product whose trigger price is crossed by the last price
+func (k Keeper) getTriggeredStopOrders(ctx sdk.Context, product string, lastPrice sdk.Dec) []*types.StopOrder {
+	if !lastPrice.IsPositive() {
+		return nil
+	}
+	// the stop orders triggered by fall have a trigger price no less than the last price
+	fallPrefix := types.GetStopOrderTriggerPrefix(product, true)
+	stopOrders := k.getStopOrdersByIndex(ctx, types.GetStopOrderTriggerPriceKey(product, true, lastPrice),
+		sdk.PrefixEndBytes(fallPrefix))
+	// the stop orders triggered by rise have a trigger price no greater than the last price
+	risePrefix := types.GetStopOrderTriggerPrefix(product, false)
+	return append(stopOrders, k.getStopOrdersByIndex(ctx, risePrefix,
+		sdk.PrefixEndBytes(types.GetStopOrderTriggerPriceKey(product, false, lastPrice)))...)
+}
+
+// TriggerStopOrders places the stop orders whose trigger price is crossed by the last price into the depth book,
+// and quits the expired stop orders. It returns the orders placed.
+func (k Keeper) TriggerStopOrders(ctx sdk.Context) []*types.Order {
+	logger := ctx.Logger().With("module", "order")
+
+	// the stop orders of the products delisted are quit when they expire
+	expiredStopOrders := k.getStopOrdersByIndex(ctx, types.StopOrderExpireKey,
+		types.GetStopOrderExpirePrefix(ctx.BlockHeight()))
+	for _, stopOrder := range expiredStopOrders {
+		k.QuitStopOrder(ctx, stopOrder, types.FeeTypeOrderExpire)
+		emitStopOrderEvent(ctx, stopOrder, types.StopOrderStatusExpired, "")
+	}
+
+	var orders []*types.Order
+	feeParams := k.GetParams(ctx)
+	for _, tokenPair := range k.dexKeeper.GetTokenPairs(ctx) {
+		product := tokenPair.Name()
+		// wait until the product is unlocked
+		if k.IsProductLocked(ctx, product) {
+			continue
+		}
+		for _, stopOrder := range k.getTriggeredStopOrders(ctx, product, k.GetLastPrice(ctx, product)) {
+			order, err := k.triggerStopOrder(ctx, stopOrder, feeParams)
+			if err != nil {
+				logger.Info(fmt.Sprintf("trigger stop order(%s) failed: %v", stopOrder.StopOrderID, err))
+				k.QuitStopOrder(ctx, stopOrder, types.FeeTypeOrderKill)
+				emitStopOrderEvent(ctx, stopOrder, types.StopOrderStatusTriggerFailed, "")
+				continue
+			}
+			emitStopOrderEvent(ctx, stopOrder, types.StopOrderStatusTriggered, order.OrderID)
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func mockStopOrder(sender sdk.AccAddress, side, price, quantity, stopType, triggerPrice string,
+	expireBlockHeight int64) *types.StopOrder {
+	return types.NewStopOrder("", sender, types.TestTokenPair, side, sdk.MustNewDecFromStr(price),
+		sdk.MustNewDecFromStr(quantity), stopType, sdk.MustNewDecFromStr(triggerPrice), 0, expireBlockHeight)
+}
+
+func TestPlaceAndQuitStopOrder(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	err := testInput.DexKeeper.SaveTokenPair(ctx, dex.GetBuiltInTokenPair())
+	require.Nil(t, err)
+
+	stopOrder := mockStopOrder(testInput.TestAddrs[0], types.SellOrder, "9.0", "1.0",
+		types.StopTypeStopLoss, "9.5", 100)
+	require.NoError(t, keeper.PlaceStopOrder(ctx, stopOrder))
+	require.Equal(t, "STOP1", stopOrder.StopOrderID)
+	require.NotNil(t, keeper.GetStopOrder(ctx, stopOrder.StopOrderID))
+	require.Equal(t, 1, len(testInput.TokenKeeper.GetLockedCoins(ctx, testInput.TestAddrs[0])))
+
+	// no order is placed into the depth book
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+
+	keeper.QuitStopOrder(ctx, stopOrder, types.FeeTypeOrderCancel)
+	require.Nil(t, keeper.GetStopOrder(ctx, stopOrder.StopOrderID))
+	require.Equal(t, 0, len(testInput.TokenKeeper.GetLockedCoins(ctx, testInput.TestAddrs[0])))
+}
+
+func TestStopOrderFee(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	err := testInput.DexKeeper.SaveTokenPair(ctx, dex.GetBuiltInTokenPair())
+	require.Nil(t, err)
+	params := keeper.GetParams(ctx)
+	params.FeePerBlock = sdk.NewDecCoinFromDec(types.DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr("0.01"))
+	keeper.SetParams(ctx, params)
+
+	addr := testInput.TestAddrs[0]
+	balance := testInput.AccountKeeper.GetAccount(ctx, addr).GetCoins().AmountOf(types.DefaultFeeDenomPerBlock)
+	stopOrder := mockStopOrder(addr, types.SellOrder, "9.0", "1.0", types.StopTypeStopLoss, "9.5", 100)
+	require.NoError(t, keeper.PlaceStopOrder(ctx, stopOrder))
+	require.Equal(t, int64(10), stopOrder.CreationHeight)
+	// the fee for 90 blocks is locked
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"),
+		testInput.TokenKeeper.GetLockedCoins(ctx, addr).AmountOf(types.DefaultFeeDenomPerBlock))
+
+	// the fee for the 10 blocks the stop order rests is charged when it's cancelled
+	fee := keeper.QuitStopOrder(ctx.WithBlockHeight(20), stopOrder, types.FeeTypeOrderCancel)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), fee.AmountOf(types.DefaultFeeDenomPerBlock))
+	require.Equal(t, balance.Sub(sdk.MustNewDecFromStr("0.1")),
+		testInput.AccountKeeper.GetAccount(ctx, addr).GetCoins().AmountOf(types.DefaultFeeDenomPerBlock))
+	require.Equal(t, 0, len(testInput.TokenKeeper.GetLockedCoins(ctx, addr)))
+}
+
+func TestTriggerStopOrders(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	err := testInput.DexKeeper.SaveTokenPair(ctx, dex.GetBuiltInTokenPair())
+	require.Nil(t, err)
+
+	stopLoss := mockStopOrder(testInput.TestAddrs[0], types.SellOrder, "9.0", "1.0",
+		types.StopTypeStopLoss, "9.5", 100)
+	takeProfit := mockStopOrder(testInput.TestAddrs[0], types.SellOrder, "11.0", "1.0",
+		types.StopTypeTakeProfit, "11.0", 100)
+	expired := mockStopOrder(testInput.TestAddrs[1], types.BuyOrder, "10.0", "1.0",
+		types.StopTypeStopLoss, "10.5", 10)
+	for _, stopOrder := range []*types.StopOrder{stopLoss, takeProfit, expired} {
+		require.NoError(t, keeper.PlaceStopOrder(ctx, stopOrder))
+	}
+
+	// last price falls to the stop loss trigger price
+	keeper.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.5"))
+	ctx = ctx.WithBlockHeight(11)
+	orders := keeper.TriggerStopOrders(ctx)
+	require.Equal(t, 1, len(orders))
+	require.EqualValues(t, sdk.MustNewDecFromStr("9.0"), orders[0].Price)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, orders[0].OrderID).Status)
+
+	require.Nil(t, keeper.GetStopOrder(ctx, stopLoss.StopOrderID))
+	require.Nil(t, keeper.GetStopOrder(ctx, expired.StopOrderID))
+	require.NotNil(t, keeper.GetStopOrder(ctx, takeProfit.StopOrderID))
+	require.Equal(t, 0, len(testInput.TokenKeeper.GetLockedCoins(ctx, testInput.TestAddrs[1])))
+
+	// the stop orders are dropped from the indexes
+	require.Equal(t, 0, len(keeper.getTriggeredStopOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.5"))))
+
+	// last price rises to the take profit trigger price
+	keeper.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("11.0"))
+	orders = keeper.TriggerStopOrders(ctx)
+	require.Equal(t, 1, len(orders))
+	require.Nil(t, keeper.GetStopOrder(ctx, takeProfit.StopOrderID))
+}
+
+func TestGetTriggeredStopOrders(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	fallLow := mockStopOrder(testInput.TestAddrs[0], types.SellOrder, "9.0", "1.0",
+		types.StopTypeStopLoss, "9.0", 100)
+	fallHigh := mockStopOrder(testInput.TestAddrs[0], types.BuyOrder, "10.0", "1.0",
+		types.StopTypeTakeProfit, "10.0", 100)
+	riseLow := mockStopOrder(testInput.TestAddrs[0], types.SellOrder, "10.0", "1.0",
+		types.StopTypeTakeProfit, "10.0", 100)
+	riseHigh := mockStopOrder(testInput.TestAddrs[0], types.BuyOrder, "11.0", "1.0",
+		types.StopTypeStopLoss, "11.0", 100)
+	for i, stopOrder := range []*types.StopOrder{fallLow, fallHigh, riseLow, riseHigh} {
+		stopOrder.StopOrderID = types.FormatStopOrderID(uint64(i + 1))
+		stopOrder.FeePerBlock = types.DefaultFeePerBlock
+		keeper.SetStopOrder(ctx, stopOrder)
+	}
+
+	require.Equal(t, []*types.StopOrder{fallHigh, riseLow},
+		keeper.getTriggeredStopOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0")))
+	require.Equal(t, []*types.StopOrder{fallLow, fallHigh},
+		keeper.getTriggeredStopOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("8.0")))
+	require.Equal(t, []*types.StopOrder{riseLow, riseHigh},
+		keeper.getTriggeredStopOrders(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("12.0")))
+	require.Equal(t, 0, len(keeper.getTriggeredStopOrders(ctx, "other_pair", sdk.MustNewDecFromStr("10.0"))))
+}
@@ -107,12 +107,16 @@ func CreateTestInputWithBalance(t *testing.T, numAddrs, initQuantity int64) Test
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc,
 		paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
-	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace),
-		blacklistedAddrs)
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName: nil,
 		token.ModuleName:      {supply.Minter, supply.Burner},
 	}
+	moduleAccAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		moduleAccAddrs[supply.NewModuleAddress(acc).String()] = true
+	}
+	bankKeeper := token.NewFreezeBankKeeper(bank.NewBaseKeeper(accountKeeper,
+		paramsKeeper.Subspace(bank.DefaultParamspace), blacklistedAddrs), keyToken, moduleAccAddrs)
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
 
@@ -4,13 +4,79 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
 )
 
-// nolint
+// CaEngine is the continuous auction match engine.
+// Orders are matched in DeliverTx with price-time priority and filled at the maker price.
 type CaEngine struct {
 }
 
-// nolint
+// Run uncrosses the depth books left crossed by the volatility halts,
+// and merges the deals filled in the block into the block match result
 func (e *CaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
-	// TODO
+	for _, product := range keeper.GetParams(ctx).ContinuousAuctionProducts {
+		uncross(ctx, keeper, product)
+	}
+
+	matchResults := keeper.GetContinuousMatchResults()
+	if len(matchResults) == 0 {
+		return
+	}
+
+	blockMatchResult := keeper.GetBlockMatchResult()
+	if blockMatchResult == nil || blockMatchResult.ResultMap == nil {
+		blockMatchResult = &types.BlockMatchResult{
+			BlockHeight: ctx.BlockHeight(),
+			ResultMap:   make(map[string]types.MatchResult),
+			TimeStamp:   ctx.BlockHeader().Time.Unix(),
+		}
+	}
+	for product, matchResult := range matchResults {
+		blockMatchResult.ResultMap[product] = matchResult
+	}
+	keeper.SetBlockMatchResult(blockMatchResult)
+}
+
+// MatchOrder fills the new order against the resting orders on the opposite side immediately
+func (e *CaEngine) MatchOrder(ctx sdk.Context, keeper keeper.Keeper, order *types.Order) {
+	logger := ctx.Logger().With("module", "order")
+	if order.Type == types.OrderTypeFOK && !isFullyFillable(ctx, keeper, order,
+		getHaltChecker(ctx, keeper, order.Product), getRemainDeals(ctx, keeper)) {
+		keeper.KillOrder(ctx, order, logger)
+		return
+	}
+
+	// the resting orders crossed during a halt are matched before the new order
+	uncross(ctx, keeper, order.Product)
+	deals, filledQuantity := matchOrder(ctx, keeper, order)
+	addMatchResult(ctx, keeper, order.Product, deals, filledQuantity)
+
+	// the unfilled part of IOC and market orders is killed at once
+	if order.IsImmediate() {
+		if order.Status == types.OrderStatusOpen {
+			keeper.KillOrder(ctx, order, logger)
+		} else {
+			keeper.DropImmediateOrder(ctx, order)
+		}
+	}
+}
+
+// uncross matches the resting orders crossed in the depth book of the product
+func uncross(ctx sdk.Context, keeper keeper.Keeper, product string) {
+	deals, filledQuantity := uncrossOrders(ctx, keeper, product)
+	addMatchResult(ctx, keeper, product, deals, filledQuantity)
+}
+
+// addMatchResult adds the deals filled in DeliverTx or EndBlocker to the continuous match results of the block
+func addMatchResult(ctx sdk.Context, keeper keeper.Keeper, product string, deals []types.Deal,
+	filledQuantity sdk.Dec) {
+	if filledQuantity.IsPositive() {
+		keeper.AddContinuousMatchResult(product, types.MatchResult{
+			BlockHeight: ctx.BlockHeight(),
+			Price:       keeper.GetLastPrice(ctx, product),
+			Quantity:    filledQuantity,
+			Deals:       deals,
+		})
+	}
 }
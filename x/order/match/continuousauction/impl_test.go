@@ -0,0 +1,278 @@
+package continuousauction
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/dex"
+	orderkeeper "github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaEngine_MatchOrder(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ContinuousAuctionProducts = []string{types.TestTokenPair}
+	keeper.SetParams(ctx, params)
+	require.Equal(t, types.AuctionTypeContinuous, keeper.GetAuctionType(ctx, types.TestTokenPair))
+
+	engine := &CaEngine{}
+
+	// mock resting orders
+	makers := []*types.Order{
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "0.5"),
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.5", "1.0"),
+	}
+	for _, maker := range makers {
+		maker.Sender = testInput.TestAddrs[1]
+		require.NoError(t, keeper.PlaceOrder(ctx, maker))
+		engine.MatchOrder(ctx, keeper, maker)
+		require.EqualValues(t, types.OrderStatusOpen, maker.Status)
+	}
+
+	// taker crosses two price levels, filled at maker prices
+	taker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "11.0", "1.0")
+	taker.Sender = testInput.TestAddrs[0]
+	require.NoError(t, keeper.PlaceOrder(ctx, taker))
+	engine.MatchOrder(ctx, keeper, taker)
+
+	order0 := keeper.GetOrder(ctx, makers[0].OrderID)
+	order1 := keeper.GetOrder(ctx, makers[1].OrderID)
+	order2 := keeper.GetOrder(ctx, taker.OrderID)
+	require.EqualValues(t, types.OrderStatusFilled, order0.Status)
+	require.EqualValues(t, types.OrderStatusOpen, order1.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.5"), order1.RemainQuantity)
+	require.EqualValues(t, types.OrderStatusFilled, order2.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.25"), order2.FilledAvgPrice)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.5"), keeper.GetLastPrice(ctx, types.TestTokenPair))
+
+	// only the remaining sell order is left in depth book
+	book := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 1, len(book.Items))
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.5"), book.Items[0].Price)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.5"), book.Items[0].SellQuantity)
+	require.True(t, book.Items[0].BuyQuantity.IsZero())
+
+	// deals are merged into block match result at EndBlocker
+	engine.Run(ctx, keeper)
+	result := keeper.GetBlockMatchResult()
+	require.NotNil(t, result)
+	require.Equal(t, 4, len(result.ResultMap[types.TestTokenPair].Deals))
+	require.EqualValues(t, sdk.OneDec(), result.ResultMap[types.TestTokenPair].Quantity)
+}
+
+func TestCaEngine_NoCross(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	engine := &CaEngine{}
+	sell := types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0")
+	sell.Sender = testInput.TestAddrs[1]
+	require.NoError(t, keeper.PlaceOrder(ctx, sell))
+	buy := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "9.0", "1.0")
+	buy.Sender = testInput.TestAddrs[0]
+	require.NoError(t, keeper.PlaceOrder(ctx, buy))
+	engine.MatchOrder(ctx, keeper, buy)
+
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, sell.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, buy.OrderID).Status)
+	require.Equal(t, 2, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+	require.Equal(t, 0, len(keeper.GetContinuousMatchResults()))
+}
+
+func TestCaEngine_MatchImmediateOrders(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	engine := &CaEngine{}
+	sell := types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0")
+	sell.Sender = testInput.TestAddrs[1]
+	require.NoError(t, keeper.PlaceOrder(ctx, sell))
+
+	// FOK order can not be fully filled, killed without any deal
+	fok := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0")
+	fok.Sender = testInput.TestAddrs[0]
+	fok.Type = types.OrderTypeFOK
+	require.NoError(t, keeper.PlaceOrder(ctx, fok))
+	engine.MatchOrder(ctx, keeper, fok)
+	require.EqualValues(t, types.OrderStatusKilled, keeper.GetOrder(ctx, fok.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, sell.OrderID).Status)
+	require.EqualValues(t, 1, keeper.GetOperationMetric().KillNum)
+	require.EqualValues(t, 0, keeper.GetOperationMetric().CancelNum)
+
+	// market order is partially filled, the rest is killed
+	market := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "11.0", "1.5")
+	market.Sender = testInput.TestAddrs[0]
+	market.Type = types.OrderTypeMarket
+	require.NoError(t, keeper.PlaceOrder(ctx, market))
+	engine.MatchOrder(ctx, keeper, market)
+	order := keeper.GetOrder(ctx, market.OrderID)
+	require.EqualValues(t, types.OrderStatusPartialFilledKilled, order.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10"), order.FilledAvgPrice)
+	require.True(t, order.RemainLocked.IsZero())
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, sell.OrderID).Status)
+
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+	require.Equal(t, 0, len(keeper.GetImmediateOrderIDs(ctx, types.TestTokenPair)))
+}
+
+func TestCaEngine_TradingHalt(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ContinuousAuctionProducts = []string{types.TestTokenPair}
+	params.PriceBands = []types.PriceBand{
+		{Product: types.TestTokenPair, BandRate: sdk.ZeroDec(),
+			HaltThreshold: sdk.MustNewDecFromStr("0.1"), HaltBlocks: 3},
+	}
+	keeper.SetParams(ctx, params)
+
+	engine := &CaEngine{}
+	makers := []*types.Order{
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.5", "0.5"),
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "12.0", "1.0"),
+	}
+	for _, maker := range makers {
+		maker.Sender = testInput.TestAddrs[1]
+		require.NoError(t, keeper.PlaceOrder(ctx, maker))
+		engine.MatchOrder(ctx, keeper, maker)
+	}
+
+	// FOK order is killed if it can't be fully filled before the price halts the trading
+	fok := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "12.0", "1.5")
+	fok.Sender = testInput.TestAddrs[0]
+	fok.Type = types.OrderTypeFOK
+	require.NoError(t, keeper.PlaceOrder(ctx, fok))
+	engine.MatchOrder(ctx, keeper, fok)
+	require.EqualValues(t, types.OrderStatusKilled, keeper.GetOrder(ctx, fok.OrderID).Status)
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+
+	// the matching stops before the price 12.0 which moves 20% from the last price 10.0, and halts the trading
+	taker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "12.0", "1.5")
+	taker.Sender = testInput.TestAddrs[0]
+	require.NoError(t, keeper.PlaceOrder(ctx, taker))
+	engine.MatchOrder(ctx, keeper, taker)
+	order := keeper.GetOrder(ctx, taker.OrderID)
+	require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	require.EqualValues(t, sdk.OneDec(), order.RemainQuantity)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, makers[1].OrderID).Status)
+	require.True(t, keeper.IsProductHalted(ctx, types.TestTokenPair))
+
+	// orders aren't matched during the halt
+	ctx = ctx.WithBlockHeight(13)
+	taker2 := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "12.0", "0.5")
+	taker2.Sender = testInput.TestAddrs[0]
+	require.NoError(t, keeper.PlaceOrder(ctx, taker2))
+	engine.MatchOrder(ctx, keeper, taker2)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, taker2.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, makers[1].OrderID).Status)
+
+	// the book is left crossed by the halt
+	_, _, crossed := getBestPrices(keeper.GetDepthBookCopy(types.TestTokenPair))
+	require.True(t, crossed)
+
+	// the crossed orders are matched in the order they are placed once the halt ends, which resumes the trading
+	ctx = ctx.WithBlockHeight(14)
+	engine.Run(ctx, keeper)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, makers[1].OrderID).Status)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, taker2.OrderID).Status)
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+	_, _, crossed = getBestPrices(keeper.GetDepthBookCopy(types.TestTokenPair))
+	require.False(t, crossed)
+
+	// the new order is matched as usual
+	taker3 := types.MockOrder("", types.TestTokenPair, types.SellOrder, "12.0", "0.5")
+	taker3.Sender = testInput.TestAddrs[1]
+	require.NoError(t, keeper.PlaceOrder(ctx, taker3))
+	engine.MatchOrder(ctx, keeper, taker3)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker3.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker2.OrderID).Status)
+}
+
+func TestIsPlacedBefore(t *testing.T) {
+	require.True(t, isPlacedBefore(types.FormatOrderID(10, 9), types.FormatOrderID(10, 10)))
+	require.True(t, isPlacedBefore(types.FormatOrderID(9, 10), types.FormatOrderID(10, 1)))
+	require.False(t, isPlacedBefore(types.FormatOrderID(10, 10), types.FormatOrderID(10, 9)))
+	require.False(t, isPlacedBefore(types.FormatOrderID(10, 1), types.FormatOrderID(10, 1)))
+}
+
+func TestCaEngine_MaxDealsPerBlock(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ContinuousAuctionProducts = []string{types.TestTokenPair}
+	params.MaxDealsPerBlock = 4
+	keeper.SetParams(ctx, params)
+
+	// a deep book of the sell orders at the same price
+	engine := &CaEngine{}
+	makers := make([]*types.Order, 6)
+	for i := range makers {
+		makers[i] = types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "0.1")
+		makers[i].Sender = testInput.TestAddrs[1]
+		require.NoError(t, keeper.PlaceOrder(ctx, makers[i]))
+		engine.MatchOrder(ctx, keeper, makers[i])
+	}
+
+	// the taker sweeping the book fills MaxDealsPerBlock maker orders only, and the rest of it is left resting
+	taker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "0.6")
+	taker.Sender = testInput.TestAddrs[0]
+	require.NoError(t, keeper.PlaceOrder(ctx, taker))
+	engine.MatchOrder(ctx, keeper, taker)
+	order := keeper.GetOrder(ctx, taker.OrderID)
+	require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.2"), order.RemainQuantity)
+	for i, maker := range makers {
+		expectedStatus := types.OrderStatusFilled
+		if i >= 4 {
+			expectedStatus = types.OrderStatusOpen
+		}
+		require.EqualValues(t, expectedStatus, keeper.GetOrder(ctx, maker.OrderID).Status)
+	}
+	require.EqualValues(t, 4, keeper.GetContinuousDealNum())
+
+	// no more deals are filled in the block, the FOK order can't be fully filled
+	fok := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "0.1")
+	fok.Sender = testInput.TestAddrs[0]
+	fok.Type = types.OrderTypeFOK
+	require.NoError(t, keeper.PlaceOrder(ctx, fok))
+	engine.MatchOrder(ctx, keeper, fok)
+	require.EqualValues(t, types.OrderStatusKilled, keeper.GetOrder(ctx, fok.OrderID).Status)
+	engine.Run(ctx, keeper)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, taker.OrderID).Status)
+
+	// the crossed orders are matched in the next block
+	keeper.Cache2Disk(ctx)
+	ctx = ctx.WithBlockHeight(11)
+	keeper.ResetCache(ctx)
+	engine.Run(ctx, keeper)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, makers[5].OrderID).Status)
+	require.EqualValues(t, 2, keeper.GetContinuousDealNum())
+}
@@ -0,0 +1,268 @@
+package continuousauction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/match/periodicauction"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// getCrossedPrices returns the prices on the opposite side which can be matched with the taker order,
+// sorted from the best price to the worst price
+func getCrossedPrices(book *types.DepthBook, taker *types.Order) []sdk.Dec {
+	var prices []sdk.Dec
+	if taker.Side == types.BuyOrder {
+		// sell orders, prices from low to high
+		for i := len(book.Items) - 1; i >= 0; i-- {
+			if book.Items[i].Price.GT(taker.Price) {
+				break
+			}
+			if book.Items[i].SellQuantity.IsPositive() {
+				prices = append(prices, book.Items[i].Price)
+			}
+		}
+	} else {
+		// buy orders, prices from high to low
+		for i := 0; i < len(book.Items); i++ {
+			if book.Items[i].Price.LT(taker.Price) {
+				break
+			}
+			if book.Items[i].BuyQuantity.IsPositive() {
+				prices = append(prices, book.Items[i].Price)
+			}
+		}
+	}
+	return prices
+}
+
+// isFullyFillable returns whether the resting orders on the opposite side can fill the whole taker order,
+// before the matching reaches a price which halts the trading or fills more than remainDeals maker orders
+func isFullyFillable(ctx sdk.Context, k keeper.Keeper, taker *types.Order, isHaltTriggered func(price sdk.Dec) bool,
+	remainDeals int64) bool {
+	fillableQuantity := sdk.ZeroDec()
+	for _, price := range getCrossedPrices(k.GetDepthBookCopy(taker.Product), taker) {
+		if isHaltTriggered(price) {
+			break
+		}
+		key := types.FormatOrderIDsKey(taker.Product, price, oppositeSide(taker.Side))
+		for _, orderID := range k.GetProductPriceOrderIDs(key) {
+			if remainDeals <= 0 || fillableQuantity.GTE(taker.RemainQuantity) {
+				return fillableQuantity.GTE(taker.RemainQuantity)
+			}
+			if maker := k.GetOrder(ctx, orderID); maker != nil {
+				fillableQuantity = fillableQuantity.Add(maker.RemainQuantity)
+				remainDeals--
+			}
+		}
+	}
+	return fillableQuantity.GTE(taker.RemainQuantity)
+}
+
+// getRemainDeals returns how many maker orders can still be filled by the continuous auction engine in current
+// block. The matching in DeliverTx is charged with the flat gas of the msg, so that the deals of a block are bounded
+// like the periodic auction does, and the orders beyond the bound are left resting
+func getRemainDeals(ctx sdk.Context, k keeper.Keeper) int64 {
+	return k.GetParams(ctx).MaxDealsPerBlock - k.GetContinuousDealNum()
+}
+
+// getHaltChecker returns whether matching at a price halts the trading of the product, which is called on the
+// crossed prices in the order they are matched. It mirrors CheckTradingHalt without changing any state
+func getHaltChecker(ctx sdk.Context, k keeper.Keeper, product string) func(price sdk.Dec) bool {
+	if k.IsProductHalted(ctx, product) {
+		return func(sdk.Dec) bool { return true }
+	}
+	band := k.GetParams(ctx).GetPriceBand(product)
+	refPrice := k.GetLastPrice(ctx, product)
+	// the first match after a halt ends is executed without the check
+	resuming := k.GetTradingHalt(ctx, product) != nil
+	return func(price sdk.Dec) bool {
+		if resuming {
+			resuming = false
+			return false
+		}
+		return band != nil && band.IsHaltTriggered(refPrice, price)
+	}
+}
+
+func oppositeSide(side string) string {
+	if side == types.BuyOrder {
+		return types.SellOrder
+	}
+	return types.BuyOrder
+}
+
+// fillPriceLevel fills at most remainDeals maker orders at the specified price in time priority,
+// returns deals, the filled quantity and the number of the maker orders filled
+func fillPriceLevel(ctx sdk.Context, k keeper.Keeper, taker *types.Order, price sdk.Dec,
+	feeParams *types.Params, remainDeals int64) ([]types.Deal, sdk.Dec, int64) {
+
+	var deals []types.Deal
+	filledQuantity := sdk.ZeroDec()
+	var dealNum int64
+	key := types.FormatOrderIDsKey(taker.Product, price, oppositeSide(taker.Side))
+	orderIDs := k.GetProductPriceOrderIDs(key)
+
+	index := 0
+	for index < len(orderIDs) && taker.RemainQuantity.IsPositive() && dealNum < remainDeals {
+		maker := k.GetOrder(ctx, orderIDs[index])
+		if maker == nil {
+			ctx.Logger().Error(fmt.Sprintf("[Order] Not exist orderID: %s", orderIDs[index]))
+			index++
+			continue
+		}
+
+		fillQuantity := sdk.MinDec(maker.RemainQuantity, taker.RemainQuantity)
+		// deal fee of the sell side is calculated with the last price
+		k.SetLastPrice(ctx, taker.Product, price)
+		if deal := periodicauction.FillOrder(maker, ctx, k, price, fillQuantity, feeParams, true); deal != nil {
+			deals = append(deals, *deal)
+		}
+		if deal := periodicauction.FillOrder(taker, ctx, k, price, fillQuantity, feeParams, false); deal != nil {
+			deals = append(deals, *deal)
+		}
+		filledQuantity = filledQuantity.Add(fillQuantity)
+		dealNum++
+
+		if maker.Status == types.OrderStatusFilled {
+			index++
+		}
+	}
+
+	// Note: orderIDs cannot be nil, we will use empty slice to remove Data on keeper
+	unFilledOrderIDs := append([]string{}, orderIDs[index:]...)
+	k.SetOrderIDs(key, unFilledOrderIDs)
+
+	return deals, filledQuantity, dealNum
+}
+
+// removeFilledTaker removes the fully filled taker order from the orderIDsMap
+func removeFilledTaker(k keeper.Keeper, taker *types.Order) {
+	key := types.FormatOrderIDsKey(taker.Product, taker.Price, taker.Side)
+	orderIDs := k.GetProductPriceOrderIDs(key)
+	remainOrderIDs := make([]string, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if orderID != taker.OrderID {
+			remainOrderIDs = append(remainOrderIDs, orderID)
+		}
+	}
+	k.SetOrderIDs(key, remainOrderIDs)
+}
+
+// matchOrder matches the taker order, which has been placed into the depth book, with resting orders
+// on the opposite side. Better prices are filled first, and earlier orders are filled first at the same price.
+// Every deal is filled at the price of the maker order. The matching stops before a price which moves too far
+// from the last price, and halts the trading like the periodic auction does. It also stops once MaxDealsPerBlock maker
+// orders are filled in the block, and the rest of the taker order is left resting.
+func matchOrder(ctx sdk.Context, k keeper.Keeper, taker *types.Order) ([]types.Deal, sdk.Dec) {
+	var deals []types.Deal
+	filledQuantity := sdk.ZeroDec()
+	// orders are kept in the depth book during a volatility halt, but not matched
+	if k.IsProductHalted(ctx, taker.Product) {
+		return deals, filledQuantity
+	}
+	feeParams := k.GetParams(ctx)
+	remainDeals := getRemainDeals(ctx, k)
+	book := k.GetDepthBookCopy(taker.Product)
+	refPrice := k.GetLastPrice(ctx, taker.Product)
+
+	for _, price := range getCrossedPrices(book, taker) {
+		if !taker.RemainQuantity.IsPositive() || remainDeals <= 0 {
+			break
+		}
+		if k.CheckTradingHalt(ctx, taker.Product, refPrice, price) {
+			break
+		}
+		levelDeals, levelFilled, levelDealNum := fillPriceLevel(ctx, k, taker, price, feeParams, remainDeals)
+		deals = append(deals, levelDeals...)
+		filledQuantity = filledQuantity.Add(levelFilled)
+		book.SubByPrice(price, levelFilled, oppositeSide(taker.Side))
+		remainDeals -= levelDealNum
+		k.AddContinuousDealNum(levelDealNum)
+	}
+
+	if filledQuantity.IsPositive() {
+		book.SubByPrice(taker.Price, filledQuantity, taker.Side)
+		if taker.Status == types.OrderStatusFilled {
+			removeFilledTaker(k, taker)
+		}
+		k.SetDepthBook(taker.Product, book)
+
+		ctx.Logger().With("module", "order").Debug(fmt.Sprintf("BlockHeight<%d> continuous match order(%s): "+
+			"filled: %v, remain: %v, dealsNum: %d", ctx.BlockHeight(), taker.OrderID, filledQuantity,
+			taker.RemainQuantity, len(deals)))
+	}
+
+	return deals, filledQuantity
+}
+
+// getBestPrices returns the best buy price and the best sell price of the depth book,
+// crossed is false if either side is empty or the best buy price is lower than the best sell price
+func getBestPrices(book *types.DepthBook) (buyPrice, sellPrice sdk.Dec, crossed bool) {
+	// items are sorted from high price to low price
+	buyFound, sellFound := false, false
+	for i := 0; i < len(book.Items) && !buyFound; i++ {
+		if book.Items[i].BuyQuantity.IsPositive() {
+			buyPrice, buyFound = book.Items[i].Price, true
+		}
+	}
+	for i := len(book.Items) - 1; i >= 0 && !sellFound; i-- {
+		if book.Items[i].SellQuantity.IsPositive() {
+			sellPrice, sellFound = book.Items[i].Price, true
+		}
+	}
+	return buyPrice, sellPrice, buyFound && sellFound && buyPrice.GTE(sellPrice)
+}
+
+// isPlacedBefore returns whether the order of orderID1 is placed before the order of orderID2
+func isPlacedBefore(orderID1, orderID2 string) bool {
+	var height1, num1, height2, num2 int64
+	format := "ID%d-%d"
+	if _, err := fmt.Sscanf(orderID1, format, &height1, &num1); err != nil {
+		return false
+	}
+	if _, err := fmt.Sscanf(orderID2, format, &height2, &num2); err != nil {
+		return true
+	}
+	return height1 < height2 || (height1 == height2 && num1 < num2)
+}
+
+// uncrossOrders matches the resting orders left crossed in the depth book, which happens when a volatility halt
+// stops the matching in the middle, or the orders are placed during the halt. The later one of the orders at the
+// best buy price and the best sell price is matched as the taker, so that the earlier orders keep their priority
+// and are filled at their prices. The matching stops with the book still crossed once MaxDealsPerBlock is reached,
+// and goes on in the next block.
+func uncrossOrders(ctx sdk.Context, k keeper.Keeper, product string) ([]types.Deal, sdk.Dec) {
+	var deals []types.Deal
+	filledQuantity := sdk.ZeroDec()
+	for !k.IsProductHalted(ctx, product) {
+		buyPrice, sellPrice, crossed := getBestPrices(k.GetDepthBookCopy(product))
+		if !crossed {
+			break
+		}
+		buyOrderIDs := k.GetProductPriceOrderIDs(types.FormatOrderIDsKey(product, buyPrice, types.BuyOrder))
+		sellOrderIDs := k.GetProductPriceOrderIDs(types.FormatOrderIDsKey(product, sellPrice, types.SellOrder))
+		if len(buyOrderIDs) == 0 || len(sellOrderIDs) == 0 {
+			break
+		}
+		takerID := buyOrderIDs[0]
+		if isPlacedBefore(takerID, sellOrderIDs[0]) {
+			takerID = sellOrderIDs[0]
+		}
+		taker := k.GetOrder(ctx, takerID)
+		if taker == nil {
+			ctx.Logger().Error(fmt.Sprintf("[Order] Not exist orderID: %s", takerID))
+			break
+		}
+
+		takerDeals, takerFilled := matchOrder(ctx, k, taker)
+		if !takerFilled.IsPositive() {
+			break
+		}
+		deals = append(deals, takerDeals...)
+		filledQuantity = filledQuantity.Add(takerFilled)
+	}
+	return deals, filledQuantity
+}
@@ -1,38 +1,41 @@
 package match
 
 import (
-	"sync"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/okex/okexchain/x/order/keeper"
 	"github.com/okex/okexchain/x/order/match/continuousauction"
 	"github.com/okex/okexchain/x/order/match/periodicauction"
+	"github.com/okex/okexchain/x/order/types"
 )
 
 // nolint
-const DefaultAuctionType = "periodicauction"
+const DefaultAuctionType = types.AuctionTypePeriodic
 
 // nolint
 var (
-	once        sync.Once
-	engine      Engine
-	auctionType = DefaultAuctionType
+	paEngine = &periodicauction.PaEngine{}
+	caEngine = &continuousauction.CaEngine{}
 )
 
-// GetEngine : periodic auction only today
-func GetEngine() Engine {
-	once.Do(func() {
-		if auctionType == DefaultAuctionType {
-			engine = &periodicauction.PaEngine{}
-		} else {
-			engine = &continuousauction.CaEngine{}
-		}
-	})
-	return engine
+// GetEngine returns the match engine of the specified auction type
+func GetEngine(auctionType string) Engine {
+	if auctionType == types.AuctionTypeContinuous {
+		return caEngine
+	}
+	return paEngine
+}
+
+// GetEngines returns all the match engines in the order they are run in EndBlocker.
+// The periodic auction engine runs first, it also expires orders and cleans up delisted products for all products.
+func GetEngines() []Engine {
+	return []Engine{paEngine, caEngine}
 }
 
 // nolint
 type Engine interface {
+	// Run is called in EndBlocker
 	Run(ctx sdk.Context, keeper keeper.Keeper)
+	// MatchOrder is called in DeliverTx right after a new order is placed
+	MatchOrder(ctx sdk.Context, keeper keeper.Keeper, order *types.Order)
 }
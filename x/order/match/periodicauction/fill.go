@@ -156,14 +156,16 @@ func fillOrderByKey(ctx sdk.Context, keeper orderkeeper.Keeper, key string,
 		}
 		if filledAmount.Add(order.RemainQuantity).LTE(needFillAmount) {
 			filledAmount = filledAmount.Add(order.RemainQuantity)
-			if deal := fillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams,
+				isMakerOrder(ctx, order)); deal != nil {
 				deals = append(deals, *deal)
 			}
 
 			filledDealsCnt++
 			index++
 		} else {
-			if deal := fillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams,
+				isMakerOrder(ctx, order)); deal != nil {
 				deals = append(deals, *deal)
 			}
 			filledAmount = needFillAmount
@@ -199,8 +201,14 @@ func balanceAccount(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keep
 	keeper.BalanceAccount(ctx, order.Sender, outputCoins, inputCoins)
 }
 
+// isMakerOrder returns whether the order rested in the depth book from a prior block,
+// which makes it a maker in the periodic auction
+func isMakerOrder(ctx sdk.Context, order *types.Order) bool {
+	return types.GetBlockHeightFromOrderID(order.OrderID) < ctx.BlockHeight()
+}
+
 func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, fillQuantity sdk.Dec,
-	feeParams *types.Params) (dealFee sdk.SysCoins, feeReceiver string) {
+	feeParams *types.Params, isMaker bool) (dealFee sdk.SysCoins, feeReceiver string) {
 	// charge fee
 	fee := orderkeeper.GetZeroFee()
 	if order.Status == types.OrderStatusFilled {
@@ -217,18 +225,23 @@ func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, f
 			ctx.Logger().Error(fmt.Sprintf("Send fee failed:%s\n", err.Error()))
 		}
 	}
-	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeParams)
+	feeRate := keeper.GetDealFeeRate(ctx, order, isMaker, feeParams)
+	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeRate)
 	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, types.FeeTypeOrderDeal, order.Product)
 	if err == nil {
 		order.RecordOrderDealFee(fee)
+		// share the deal fee with the referrer of the order
+		keeper.SendReferralRebate(ctx, order, dealFee)
 	}
 	return
 }
 
-// Fill an order. Update order, charge fee and transfer tokens. Return a deal.
+// FillOrder fills an order. Update order, charge fee and transfer tokens. Return a deal.
 // If an order is fully filled but still lock some coins, unlock it.
-func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
-	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params) *types.Deal {
+// It is also used by the continuous auction engine to fill orders at the maker price.
+// The deal fee is charged with the maker or taker fee rate, and the deal volume of the sender is recorded.
+func FillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
+	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params, isMaker bool) *types.Deal {
 
 	// update order
 	order.Fill(fillPrice, fillQuantity)
@@ -241,7 +254,29 @@ func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 		order.Unlock()
 	}
 
-	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams)
+	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams, isMaker)
+	keeper.AddDealVolume(ctx, order.Sender, types.GetQuoteDenom(order.Product), fillPrice.Mul(fillQuantity))
 	keeper.UpdateOrder(order, ctx) // update order info on filled
 	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(), FeeReceiver: feeReceiver}
 }
+
+// killImmediateOrders kills the unfilled part of IOC/FOK/market orders after matching.
+// Orders of a locked product are killed after the product is unlocked.
+func killImmediateOrders(ctx sdk.Context, keeper orderkeeper.Keeper, product string) {
+	if keeper.IsProductLocked(ctx, product) {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "order")
+	for _, orderID := range keeper.GetImmediateOrderIDs(ctx, product) {
+		order := keeper.GetOrder(ctx, orderID)
+		if order == nil {
+			continue
+		}
+		if order.Status == types.OrderStatusOpen {
+			keeper.KillOrder(ctx, order, logger)
+		} else {
+			keeper.DropImmediateOrder(ctx, order)
+		}
+	}
+}
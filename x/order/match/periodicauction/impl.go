@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
 )
 
 // PaEngine is the periodic auction match engine
@@ -16,3 +17,7 @@ func (e *PaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
 	cleanupOrdersWhoseTokenPairHaveBeenDelisted(ctx, keeper)
 	matchOrders(ctx, keeper)
 }
+
+// MatchOrder does nothing, orders of periodic auction products wait for the auction in EndBlocker
+func (e *PaEngine) MatchOrder(ctx sdk.Context, keeper keeper.Keeper, order *types.Order) {
+}
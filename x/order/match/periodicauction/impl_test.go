@@ -46,3 +46,44 @@ func TestPaEngine_Run(t *testing.T) {
 	require.EqualValues(t, types.OrderStatusOpen, order2.Status)
 	require.EqualValues(t, sdk.MustNewDecFromStr("2"), order2.RemainQuantity)
 }
+
+func TestPaEngine_RunImmediateOrders(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	// mock orders: FOK can not be fully filled, IOC is partially filled
+	orders := []*types.Order{
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0"),
+		types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.5"),
+	}
+	orders[0].Sender = testInput.TestAddrs[1]
+	orders[1].Sender = testInput.TestAddrs[0]
+	orders[1].Type = types.OrderTypeFOK
+	orders[2].Sender = testInput.TestAddrs[0]
+	orders[2].Type = types.OrderTypeIOC
+	for i := 0; i < 3; i++ {
+		err := keeper.PlaceOrder(ctx, orders[i])
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, len(keeper.GetImmediateOrderIDs(ctx, types.TestTokenPair)))
+
+	engine := &PaEngine{}
+	engine.Run(ctx, keeper)
+
+	// check order status
+	order0 := keeper.GetOrder(ctx, orders[0].OrderID)
+	order1 := keeper.GetOrder(ctx, orders[1].OrderID)
+	order2 := keeper.GetOrder(ctx, orders[2].OrderID)
+	require.EqualValues(t, types.OrderStatusFilled, order0.Status)
+	require.EqualValues(t, types.OrderStatusKilled, order1.Status)
+	require.EqualValues(t, types.OrderStatusPartialFilledKilled, order2.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("0.5"), order2.RemainQuantity)
+	require.True(t, order2.RemainLocked.IsZero())
+	require.Equal(t, 0, len(keeper.GetImmediateOrderIDs(ctx, types.TestTokenPair)))
+	require.Equal(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+}
@@ -273,6 +273,7 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	// step0: get active products
 	products := keeper.GetDiskCache().GetNewDepthbookKeys()
 	products = keeper.FilterDelistedProducts(ctx, products)
+	products = filterContinuousProducts(ctx, keeper, products)
 	keeper.GetDexKeeper().SortProducts(ctx, products) // sort products
 
 	// step1: calc best price and max execution for every active product, save latest price
@@ -289,6 +290,11 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	// step2: execute match results, fill orders in match results, transfer tokens and collect fees
 	executeMatch(ctx, keeper, products, updatedProductsBasePrice, lockMap)
 
+	// step2.1: kill the unfilled part of IOC/FOK/market orders
+	for _, product := range products {
+		killImmediateOrders(ctx, keeper, product)
+	}
+
 	// step3: save match results for querying
 	if len(updatedProductsBasePrice) > 0 {
 		blockMatchResult := &types.BlockMatchResult{
@@ -300,6 +306,18 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	}
 }
 
+// filterContinuousProducts drops the products matched by the continuous auction engine in DeliverTx
+func filterContinuousProducts(ctx sdk.Context, k keeper.Keeper, products []string) []string {
+	feeParams := k.GetParams(ctx)
+	var periodicProducts []string
+	for _, product := range products {
+		if feeParams.GetAuctionType(product) == types.AuctionTypePeriodic {
+			periodicProducts = append(periodicProducts, product)
+		}
+	}
+	return periodicProducts
+}
+
 func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []string) map[string]types.MatchResult {
 	resultMap := make(map[string]types.MatchResult)
 
@@ -308,10 +326,19 @@ func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []str
 		if tokenPair == nil {
 			continue
 		}
+		// orders are kept in the depth book during a volatility halt, but not matched
+		if k.IsProductHalted(ctx, product) {
+			continue
+		}
 		book := k.GetDepthBookCopy(product)
-		bestPrice, maxExecution := periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
-			k.GetLastPrice(ctx, product))
-		if maxExecution.IsPositive() {
+		refPrice := k.GetLastPrice(ctx, product)
+		bestPrice, maxExecution := periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit, refPrice)
+		// killing FOK orders changes the depth book, so calc the best price again
+		for killUnfillableFOKOrders(ctx, k, product, book, bestPrice, maxExecution) {
+			book = k.GetDepthBookCopy(product)
+			bestPrice, maxExecution = periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit, refPrice)
+		}
+		if maxExecution.IsPositive() && !k.CheckTradingHalt(ctx, product, refPrice, bestPrice) {
 			k.SetLastPrice(ctx, product, bestPrice)
 			resultMap[product] = types.MatchResult{BlockHeight: ctx.BlockHeight(), Price: bestPrice,
 				Quantity: maxExecution, Deals: []types.Deal{}}
@@ -321,6 +348,56 @@ func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []str
 	return resultMap
 }
 
+// isFullyFilledAt returns whether the order will be fully filled with the best price and max execution.
+// Orders with better prices are filled first, and earlier orders are filled first at the same price.
+func isFullyFilledAt(ctx sdk.Context, k keeper.Keeper, book *types.DepthBook, order *types.Order,
+	bestPrice, maxExecution sdk.Dec) bool {
+	if (order.Side == types.BuyOrder && order.Price.LT(bestPrice)) ||
+		(order.Side == types.SellOrder && order.Price.GT(bestPrice)) {
+		return false
+	}
+
+	filledAhead := sdk.ZeroDec()
+	for _, item := range book.Items {
+		if order.Side == types.BuyOrder && item.Price.GT(order.Price) {
+			filledAhead = filledAhead.Add(item.BuyQuantity)
+		} else if order.Side == types.SellOrder && item.Price.LT(order.Price) {
+			filledAhead = filledAhead.Add(item.SellQuantity)
+		}
+	}
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	for _, orderID := range k.GetProductPriceOrderIDs(key) {
+		if orderID == order.OrderID {
+			break
+		}
+		if aheadOrder := k.GetOrder(ctx, orderID); aheadOrder != nil {
+			filledAhead = filledAhead.Add(aheadOrder.RemainQuantity)
+		}
+	}
+
+	return filledAhead.Add(order.RemainQuantity).LTE(maxExecution)
+}
+
+// killUnfillableFOKOrders kills the first FOK order which can not be fully filled in this auction,
+// returns whether an order is killed. The best price should be calculated again once an order is killed.
+func killUnfillableFOKOrders(ctx sdk.Context, k keeper.Keeper, product string, book *types.DepthBook,
+	bestPrice, maxExecution sdk.Dec) bool {
+	logger := ctx.Logger().With("module", "order")
+	for _, orderID := range k.GetImmediateOrderIDs(ctx, product) {
+		order := k.GetOrder(ctx, orderID)
+		if order == nil || order.Type != types.OrderTypeFOK || order.Status != types.OrderStatusOpen {
+			continue
+		}
+		if maxExecution.IsPositive() && isFullyFilledAt(ctx, k, book, order, bestPrice, maxExecution) {
+			continue
+		}
+		k.KillOrder(ctx, order, logger)
+		logger.Info(fmt.Sprintf("BlockHeight<%d> kill FOK order(%s)", ctx.BlockHeight(), order.OrderID))
+		return true
+	}
+	return false
+}
+
 func lockProduct(ctx sdk.Context, k keeper.Keeper, logger log.Logger, product string, matchResult types.MatchResult,
 	buyExecutedCnt, sellExecutedCnt sdk.Dec) {
 	blockHeight := ctx.BlockHeight()
@@ -569,6 +569,57 @@ func TestCalcMatchPriceAndExecution(t *testing.T) {
 	require.EqualValues(t, sdk.MustNewDecFromStr("3.0"), matchResult.Quantity)
 }
 
+func TestCalcMatchPriceAndExecutionWithTradingHalt(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.PriceBands = []types.PriceBand{
+		{Product: types.TestTokenPair, BandRate: sdk.ZeroDec(),
+			HaltThreshold: sdk.MustNewDecFromStr("0.1"), HaltBlocks: 5},
+	}
+	keeper.SetParams(ctx, params)
+
+	// the clearing price 12.0 moves 20% from the last price 10.0
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "12.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "12.0", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[0]
+	orders[1].Sender = testInput.TestAddrs[1]
+	for _, order := range orders {
+		require.Nil(t, keeper.PlaceOrder(ctx, order))
+	}
+	products := []string{types.TestTokenPair}
+
+	updatedProductsBasePrice := calcMatchPriceAndExecution(ctx, keeper, products)
+	require.EqualValues(t, 0, len(updatedProductsBasePrice))
+	halt := keeper.GetTradingHalt(ctx, types.TestTokenPair)
+	require.NotNil(t, halt)
+	require.EqualValues(t, 15, halt.EndHeight)
+	require.EqualValues(t, sdk.MustNewDecFromStr("12.0"), halt.MatchPrice)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.0"), keeper.GetLastPrice(ctx, types.TestTokenPair))
+
+	// matching is paused during the halt
+	ctx = ctx.WithBlockHeight(15)
+	require.True(t, keeper.IsProductHalted(ctx, types.TestTokenPair))
+	updatedProductsBasePrice = calcMatchPriceAndExecution(ctx, keeper, products)
+	require.EqualValues(t, 0, len(updatedProductsBasePrice))
+
+	// the first auction after the halt is executed without the check
+	ctx = ctx.WithBlockHeight(16)
+	require.False(t, keeper.IsProductHalted(ctx, types.TestTokenPair))
+	updatedProductsBasePrice = calcMatchPriceAndExecution(ctx, keeper, products)
+	matchResult, ok := updatedProductsBasePrice[types.TestTokenPair]
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("12.0"), matchResult.Price)
+	require.Nil(t, keeper.GetTradingHalt(ctx, types.TestTokenPair))
+}
+
 func TestLockProduct(t *testing.T) {
 	testInput := orderkeeper.CreateTestInput(t)
 	keeper := testInput.OrderKeeper
@@ -8,6 +8,11 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgNewOrders{}, "okexchain/order/MsgNew", nil)
 	cdc.RegisterConcrete(MsgCancelOrders{}, "okexchain/order/MsgCancel", nil)
+	cdc.RegisterConcrete(MsgNewStopOrders{}, "okexchain/order/MsgNewStop", nil)
+	cdc.RegisterConcrete(MsgCancelStopOrders{}, "okexchain/order/MsgCancelStop", nil)
+	cdc.RegisterConcrete(MsgAmendOrders{}, "okexchain/order/MsgAmend", nil)
+	cdc.RegisterConcrete(MsgSetProductFeeRates{}, "okexchain/order/MsgSetProductFeeRates", nil)
+	cdc.RegisterConcrete(MsgRegisterReferralCode{}, "okexchain/order/MsgRegisterReferralCode", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
@@ -12,7 +12,34 @@ const (
 	FeeTypeOrderExpire  = "expire"
 	FeeTypeOrderDeal    = "deal"
 	FeeTypeOrderReceive = "receive"
+	FeeTypeOrderKill    = "kill"
 	TestTokenPair       = common.TestToken + "_" + sdk.DefaultBondDenom
 	BuyOrder            = "BUY"
 	SellOrder           = "SELL"
+
+	// the deal volume of an address is summed over the last DealVolumeWindowDays days to get its fee tier
+	DealVolumeWindowDays = 30
+
+	// stop order events
+	EventTypeStopOrder           = "stop_order"
+	AttributeKeyStopOrderID      = "stop_order_id"
+	AttributeKeyOrderID          = "order_id"
+	AttributeKeyProduct          = "product"
+	AttributeKeyStopOrderStatus  = "status"
+	StopOrderStatusTriggered     = "triggered"
+	StopOrderStatusExpired       = "expired"
+	StopOrderStatusTriggerFailed = "trigger_failed"
+
+	// referral rebate events
+	EventTypeReferralRebate  = "referral_rebate"
+	AttributeKeyReferralCode = "referral_code"
+	AttributeKeyReferrer     = "referrer"
+	AttributeKeyRebate       = "rebate"
+
+	// volatility halt events
+	EventTypeTradingHalt      = "trading_halt"
+	EventTypeTradingResume    = "trading_resume"
+	AttributeKeyRefPrice      = "ref_price"
+	AttributeKeyMatchPrice    = "match_price"
+	AttributeKeyHaltEndHeight = "halt_end_height"
 )
@@ -111,6 +111,19 @@ func (depthBook *DepthBook) Sub(index int, num sdk.Dec, side string) {
 	}
 }
 
+// SubByPrice : subtract the buy or sell quantity at the specified price, and remove the item if empty
+func (depthBook *DepthBook) SubByPrice(price, num sdk.Dec, side string) {
+	bookLen := len(depthBook.Items)
+	index := sort.Search(bookLen, func(i int) bool {
+		return price.GTE(depthBook.Items[i].Price)
+	})
+
+	if index < bookLen && depthBook.Items[index].Price.Equal(price) {
+		depthBook.Sub(index, num, side)
+		depthBook.RemoveIfEmpty(index)
+	}
+}
+
 // RemoveIfEmpty : remove the filled or empty item
 func (depthBook *DepthBook) RemoveIfEmpty(index int) bool {
 	res := depthBook.Items[index].BuyQuantity.IsZero() && depthBook.Items[index].SellQuantity.IsZero()
@@ -120,6 +133,19 @@ func (depthBook *DepthBook) RemoveIfEmpty(index int) bool {
 	return res
 }
 
+// IsCrossed : whether an order at the price would cross with the opposite side of depth book
+func (depthBook *DepthBook) IsCrossed(price sdk.Dec, side string) bool {
+	for _, item := range depthBook.Items {
+		if side == BuyOrder && item.SellQuantity.IsPositive() && item.Price.LTE(price) {
+			return true
+		}
+		if side == SellOrder && item.BuyQuantity.IsPositive() && item.Price.GTE(price) {
+			return true
+		}
+	}
+	return false
+}
+
 // Copy : depth copy of depth book
 func (depthBook *DepthBook) Copy() *DepthBook {
 	itemList := make([]DepthBookItem, 0, len(depthBook.Items))
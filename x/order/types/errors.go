@@ -12,7 +12,7 @@ const (
 	CodeInvalidAddress                        uint32 = 63000
 	CodeSizeIsInvalid                         uint32 = 63001
 	CodeTokenPairNotExist                     uint32 = 63002
-	CodeSendCoinsFailed                        uint32 = 63003
+	CodeSendCoinsFailed                       uint32 = 63003
 	CodeTradingPairIsDelisting                uint32 = 63004
 	CodePriceOverAccuracy                     uint32 = 63005
 	CodeQuantityOverAccuracy                  uint32 = 63006
@@ -38,6 +38,21 @@ const (
 	CodeNotOrderOwner                         uint32 = 63026
 	CodeProductIsEmpty                        uint32 = 63027
 	CodeAllOrderFailedToExecute               uint32 = 63028
+	CodeInvalidOrderType                      uint32 = 63029
+	CodePostOnlyOrderWouldCross               uint32 = 63030
+	CodeInvalidStopType                       uint32 = 63031
+	CodeStopOrderIsNotExist                   uint32 = 63032
+	CodeInvalidAmendQuantity                  uint32 = 63033
+	CodeOrderIsNotAmended                     uint32 = 63034
+	CodeMustProductOwner                      uint32 = 63035
+	CodeInvalidFeeRate                        uint32 = 63036
+	CodeInvalidReferralCode                   uint32 = 63037
+	CodeInvalidRebateRate                     uint32 = 63038
+	CodeReferralCodeNotExist                  uint32 = 63039
+	CodeNotDexOperator                        uint32 = 63040
+	CodePriceNotMultipleOfTickSize            uint32 = 63041
+	CodeQuantityNotMultipleOfLotSize          uint32 = 63042
+	CodePriceOutOfBand                        uint32 = 63043
 )
 
 func ErrInvalidAddress(address string) sdk.EnvelopedErr {
@@ -152,3 +167,63 @@ func ErrNotOrderOwner(orderID string) sdk.EnvelopedErr {
 func ErrAllOrderFailedToExecute() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAllOrderFailedToExecute, "all order items failed to execute")}
 }
+
+func ErrInvalidOrderType(orderType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidOrderType, fmt.Sprintf("invalid order type: %s", orderType))}
+}
+
+func ErrPostOnlyOrderWouldCross(product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodePostOnlyOrderWouldCross, fmt.Sprintf("post only order would cross with the depth book of %s", product))}
+}
+
+func ErrInvalidStopType(stopType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidStopType, fmt.Sprintf("invalid stop type: %s, should be %s or %s", stopType, StopTypeStopLoss, StopTypeTakeProfit))}
+}
+
+func ErrStopOrderIsNotExist(stopOrderID string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeStopOrderIsNotExist, fmt.Sprintf("stop order(%s) does not exist", stopOrderID))}
+}
+
+func ErrInvalidAmendQuantity(quantity sdk.Dec, filledQuantity sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAmendQuantity, fmt.Sprintf("amend quantity(%s) should be greater than the filled quantity(%s)", quantity, filledQuantity))}
+}
+
+func ErrOrderIsNotAmended(orderID string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeOrderIsNotAmended, fmt.Sprintf("neither price nor quantity of order(%s) is changed", orderID))}
+}
+
+func ErrMustProductOwner(addr string, product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeMustProductOwner, fmt.Sprintf("%s is not the owner of product: %s", addr, product))}
+}
+
+func ErrInvalidFeeRate(feeRate sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFeeRate, fmt.Sprintf("invalid fee rate: %s, should be in [0, 1]", feeRate))}
+}
+
+func ErrInvalidReferralCode(code string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidReferralCode, fmt.Sprintf("invalid referral code: %s, should be 4 to 32 letters or digits", code))}
+}
+
+func ErrInvalidRebateRate(rebateRate sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidRebateRate, fmt.Sprintf("invalid rebate rate: %s, should be in [0, 1]", rebateRate))}
+}
+
+func ErrReferralCodeNotExist(code string, product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeReferralCodeNotExist, fmt.Sprintf("referral code(%s) does not exist for the operator of product: %s", code, product))}
+}
+
+func ErrNotDexOperator(addr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotDexOperator, fmt.Sprintf("%s is not a dex operator", addr))}
+}
+
+func ErrPriceNotMultipleOfTickSize(price sdk.Dec, tickSize sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodePriceNotMultipleOfTickSize, fmt.Sprintf("price(%s) is not a multiple of the tick size(%s)", price, tickSize))}
+}
+
+func ErrQuantityNotMultipleOfLotSize(quantity sdk.Dec, lotSize sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeQuantityNotMultipleOfLotSize, fmt.Sprintf("quantity(%s) is not a multiple of the lot size(%s)", quantity, lotSize))}
+}
+
+func ErrPriceOutOfBand(price sdk.Dec, minPrice sdk.Dec, maxPrice sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodePriceOutOfBand, fmt.Sprintf("price(%s) is out of the price band [%s, %s]", price, minPrice, maxPrice))}
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProductFeeRates overrides the maker and taker fee rates in params for a product
+type ProductFeeRates struct {
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// String implements the stringer interface.
+func (rates ProductFeeRates) String() string {
+	return fmt.Sprintf(`ProductFeeRates:
+  MakerFeeRate: %s
+  TakerFeeRate: %s`, rates.MakerFeeRate, rates.TakerFeeRate)
+}
+
+// DealVolume is the deal volume of an address in the quote token in a day, which is used for the genesis export
+type DealVolume struct {
+	Address    sdk.AccAddress `json:"address"`
+	QuoteDenom string         `json:"quote_denom"`
+	Day        int64          `json:"day"`
+	Volume     sdk.Dec        `json:"volume"`
+}
+
+// ProductFeeRatesExported is the fee rates of a product, which is used for the genesis export
+type ProductFeeRatesExported struct {
+	Product      string  `json:"product"`
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// FeeRatesInfo is the result of the fee rates query
+type FeeRatesInfo struct {
+	Product      string  `json:"product"`
+	DealVolume   sdk.Dec `json:"deal_volume"`
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// MsgSetProductFeeRates is used by the owner of a product to set its maker and taker fee rates
+type MsgSetProductFeeRates struct {
+	Owner        sdk.AccAddress `json:"owner"`
+	Product      string         `json:"product"`
+	MakerFeeRate sdk.Dec        `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec        `json:"taker_fee_rate"`
+}
+
+// NewMsgSetProductFeeRates is a constructor function for MsgSetProductFeeRates
+func NewMsgSetProductFeeRates(owner sdk.AccAddress, product string,
+	makerFeeRate, takerFeeRate sdk.Dec) MsgSetProductFeeRates {
+	return MsgSetProductFeeRates{
+		Owner:        owner,
+		Product:      product,
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+}
+
+// nolint
+func (msg MsgSetProductFeeRates) Route() string { return "order" }
+
+// nolint
+func (msg MsgSetProductFeeRates) Type() string { return "set_product_fee_rates" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgSetProductFeeRates) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrInvalidAddress(msg.Owner.String())
+	}
+	symbols := strings.Split(msg.Product, "_")
+	if len(symbols) != 2 {
+		return ErrOrderItemProductFormat()
+	}
+	if symbols[0] == symbols[1] {
+		return ErrOrderItemProductSymbolIsEqual()
+	}
+	for _, rate := range []sdk.Dec{msg.MakerFeeRate, msg.TakerFeeRate} {
+		if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+			return ErrInvalidFeeRate(rate)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgSetProductFeeRates) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetProductFeeRates) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
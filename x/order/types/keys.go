@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,12 @@ const (
 	QueryParameters  = "params"
 	QueryStore       = "store"
 	QueryDepthBookV2 = "depthbookV2"
+	QueryStopOrders  = "stoporders"
+	QueryFeeRates    = "feerates"
+
+	QueryReferralCode     = "referralcode"
+	QueryReferralEarnings = "referralearnings"
+	QueryTradingHalts     = "tradinghalts"
 
 	OrderStoreKey = ModuleName
 )
@@ -40,12 +47,22 @@ var (
 	PriceKey             = []byte{0x14}
 	ExpireBlockHeightKey = []byte{0x15}
 	OrderNumPerBlockKey  = []byte{0x16}
+	ImmediateOrderKey    = []byte{0x21}
+	StopOrderKey         = []byte{0x22}
+	DealVolumeKey        = []byte{0x24}
+	ProductFeeRatesKey   = []byte{0x25}
+	ReferralCodeKey      = []byte{0x26}
+	ReferralEarningsKey  = []byte{0x27}
+	TradingHaltKey       = []byte{0x28}
+	StopOrderTriggerKey  = []byte{0x29}
+	StopOrderExpireKey   = []byte{0x2A}
 
 	// none iterator keys
 	RecentlyClosedOrderIDsKey = []byte{0x17}
 	LastExpiredBlockHeightKey = []byte{0x18}
 	OpenOrderNumKey           = []byte{0x19}
 	StoreOrderNumKey          = []byte{0x20}
+	StopOrderSeqKey           = []byte{0x23}
 )
 
 // nolint
@@ -78,6 +95,100 @@ func GetExpireBlockHeightKey(blockHeight int64) []byte {
 	return append(ExpireBlockHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
 }
 
+// nolint
+func GetStopOrderKey(stopOrderID string) []byte {
+	return append(StopOrderKey, []byte(stopOrderID)...)
+}
+
+// GetStopOrderTriggerPrefix returns the prefix of the stop orders of a product which are triggered when the last
+// price falls to or rises to the trigger price
+func GetStopOrderTriggerPrefix(product string, triggeredByFall bool) []byte {
+	direction := byte(0x01)
+	if triggeredByFall {
+		direction = 0x00
+	}
+	return append(append(StopOrderTriggerKey, []byte(product+":")...), direction)
+}
+
+// GetStopOrderTriggerPriceKey returns the prefix of the stop orders indexed under the trigger price
+func GetStopOrderTriggerPriceKey(product string, triggeredByFall bool, triggerPrice sdk.Dec) []byte {
+	// the fixed-length big endian bytes keep the stop orders sorted by the trigger price
+	priceBytes := make([]byte, 32)
+	bz := triggerPrice.BigInt().Bytes()
+	copy(priceBytes[len(priceBytes)-len(bz):], bz)
+	return append(GetStopOrderTriggerPrefix(product, triggeredByFall), priceBytes...)
+}
+
+// GetStopOrderTriggerKey returns the store key of the trigger price index of a stop order
+func GetStopOrderTriggerKey(stopOrder *StopOrder) []byte {
+	return append(GetStopOrderTriggerPriceKey(stopOrder.Product, stopOrder.IsTriggeredByFall(),
+		stopOrder.TriggerPrice), []byte(stopOrder.StopOrderID)...)
+}
+
+// GetStopOrderExpirePrefix returns the prefix of the stop orders which expire at the block height
+func GetStopOrderExpirePrefix(blockHeight int64) []byte {
+	return append(StopOrderExpireKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetStopOrderExpireKey returns the store key of the expire height index of a stop order
+func GetStopOrderExpireKey(stopOrder *StopOrder) []byte {
+	return append(GetStopOrderExpirePrefix(stopOrder.ExpireBlockHeight), []byte(stopOrder.StopOrderID)...)
+}
+
+// GetDealVolumePrefix returns the store prefix of the daily deal volumes of an address in the quote token
+func GetDealVolumePrefix(addr sdk.AccAddress, quoteDenom string) []byte {
+	return append(append(DealVolumeKey, addr.Bytes()...), []byte(quoteDenom+":")...)
+}
+
+// GetDealVolumeKey returns the store key of the deal volume of an address in the quote token in a day
+func GetDealVolumeKey(addr sdk.AccAddress, quoteDenom string, day int64) []byte {
+	return append(GetDealVolumePrefix(addr, quoteDenom), sdk.Uint64ToBigEndian(uint64(day))...)
+}
+
+// SplitDealVolumeKey splits the store key of a deal volume into the address, the quote denom and the day
+func SplitDealVolumeKey(key []byte) (sdk.AccAddress, string, int64) {
+	addrEnd := len(DealVolumeKey) + sdk.AddrLen
+	dayStart := len(key) - 8
+	return sdk.AccAddress(key[len(DealVolumeKey):addrEnd]), string(key[addrEnd : dayStart-1]),
+		int64(sdk.BigEndianToUint64(key[dayStart:]))
+}
+
+// GetQuoteDenom returns the quote token of a product
+func GetQuoteDenom(product string) string {
+	symbols := strings.Split(product, "_")
+	return symbols[len(symbols)-1]
+}
+
+// GetProductFeeRatesKey returns the store key of the fee rates of a product
+func GetProductFeeRatesKey(product string) []byte {
+	return append(ProductFeeRatesKey, []byte(product)...)
+}
+
+// GetReferralCodeKey returns the store key of a referral code
+func GetReferralCodeKey(code string) []byte {
+	return append(ReferralCodeKey, []byte(code)...)
+}
+
+// GetReferralEarningsKey returns the store key of the rebates earned by a referrer
+func GetReferralEarningsKey(referrer sdk.AccAddress) []byte {
+	return append(ReferralEarningsKey, referrer.Bytes()...)
+}
+
+// GetTradingHaltKey returns the store key of the trading halt of a product
+func GetTradingHaltKey(product string) []byte {
+	return append(TradingHaltKey, []byte(product)...)
+}
+
+// GetImmediateOrderPrefix returns the prefix of the immediate orders of a product
+func GetImmediateOrderPrefix(product string) []byte {
+	return append(ImmediateOrderKey, []byte(product+":")...)
+}
+
+// nolint
+func GetImmediateOrderKey(product, orderID string) []byte {
+	return append(GetImmediateOrderPrefix(product), []byte(orderID)...)
+}
+
 // nolint
 func FormatOrderIDsKey(product string, price sdk.Dec, side string) string {
 	return fmt.Sprintf("%v:%v:%v", product, price.String(), side)
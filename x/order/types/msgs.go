@@ -19,6 +19,9 @@ type MsgNewOrder struct {
 	Side     string         `json:"side"`     // BUY/SELL
 	Price    sdk.Dec        `json:"price"`    // price of the order
 	Quantity sdk.Dec        `json:"quantity"` // quantity of the order
+	Type     string         `json:"type"`     // order type, see OrderTypeXXX
+	// referral code registered by the operator of the product
+	ReferralCode string `json:"referral_code"`
 }
 
 // NewMsgNewOrder is a constructor function for MsgNewOrder
@@ -56,16 +59,18 @@ func NewMsgCancelOrder(sender sdk.AccAddress, orderID string) MsgCancelOrders {
 //********************MsgNewOrders*************
 // nolint
 type MsgNewOrders struct {
-	Sender     sdk.AccAddress `json:"sender"` // order maker address
-	OrderItems []OrderItem    `json:"order_items"`
+	Sender       sdk.AccAddress `json:"sender"` // order maker address
+	OrderItems   []OrderItem    `json:"order_items"`
+	ReferralCode string         `json:"referral_code,omitempty"` // optional referral code applied to all the orders
 }
 
 // nolint
 type OrderItem struct {
-	Product  string  `json:"product"`  // product for trading pair in full name of the tokens
-	Side     string  `json:"side"`     // BUY/SELL
-	Price    sdk.Dec `json:"price"`    // price of the order
-	Quantity sdk.Dec `json:"quantity"` // quantity of the order
+	Product  string  `json:"product"`        // product for trading pair in full name of the tokens
+	Side     string  `json:"side"`           // BUY/SELL
+	Price    sdk.Dec `json:"price"`          // price of the order, the worst price of a market order
+	Quantity sdk.Dec `json:"quantity"`       // quantity of the order
+	Type     string  `json:"type,omitempty"` // order type, see OrderTypeXXX
 }
 
 // nolint
@@ -121,6 +126,12 @@ func (msg MsgNewOrders) ValidateBasic() sdk.Error {
 		if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
 			return ErrOrderItemPriceOrQuantityIsNotPositive()
 		}
+		if !IsValidOrderType(item.Type) {
+			return ErrInvalidOrderType(item.Type)
+		}
+	}
+	if msg.ReferralCode != "" && !IsValidReferralCode(msg.ReferralCode) {
+		return ErrInvalidReferralCode(msg.ReferralCode)
 	}
 
 	return nil
@@ -214,6 +225,220 @@ func (msg MsgCancelOrders) CalculateGas(gasUnit uint64) uint64 {
 	return uint64(len(msg.OrderIDs)) * gasUnit
 }
 
+//********************MsgNewStopOrders*************
+// nolint
+type MsgNewStopOrders struct {
+	Sender         sdk.AccAddress  `json:"sender"` // order maker address
+	StopOrderItems []StopOrderItem `json:"stop_order_items"`
+}
+
+// nolint
+type StopOrderItem struct {
+	Product      string  `json:"product"`       // product for trading pair in full name of the tokens
+	Side         string  `json:"side"`          // BUY/SELL
+	Price        sdk.Dec `json:"price"`         // price of the order placed when triggered
+	Quantity     sdk.Dec `json:"quantity"`      // quantity of the order
+	StopType     string  `json:"stop_type"`     // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice sdk.Dec `json:"trigger_price"` // trigger price
+}
+
+// NewMsgNewStopOrders is a constructor function for MsgNewStopOrders
+func NewMsgNewStopOrders(sender sdk.AccAddress, stopOrderItems []StopOrderItem) MsgNewStopOrders {
+	return MsgNewStopOrders{
+		Sender:         sender,
+		StopOrderItems: stopOrderItems,
+	}
+}
+
+// nolint
+func (msg MsgNewStopOrders) Route() string { return "order" }
+
+// nolint
+func (msg MsgNewStopOrders) Type() string { return "new_stop" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgNewStopOrders) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if len(msg.StopOrderItems) == 0 {
+		return ErrOrderItemCountsIsEmpty()
+	}
+	if len(msg.StopOrderItems) > OrderItemLimit {
+		return ErrOrderItemCountsBiggerThanLimit(OrderItemLimit)
+	}
+	for _, item := range msg.StopOrderItems {
+		if len(item.Product) == 0 {
+			return ErrOrderItemProductCountsIsEmpty()
+		}
+		symbols := strings.Split(item.Product, "_")
+		if len(symbols) != 2 {
+			return ErrOrderItemProductFormat()
+		}
+		if symbols[0] == symbols[1] {
+			return ErrOrderItemProductSymbolIsEqual()
+		}
+		if item.Side != BuyOrder && item.Side != SellOrder {
+			return ErrOrderItemSideIsNotBuyAndSell()
+		}
+		if !(item.Price.IsPositive() && item.Quantity.IsPositive() && item.TriggerPrice.IsPositive()) {
+			return ErrOrderItemPriceOrQuantityIsNotPositive()
+		}
+		if item.StopType != StopTypeStopLoss && item.StopType != StopTypeTakeProfit {
+			return ErrInvalidStopType(item.StopType)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgNewStopOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNewStopOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Calculate customize gas
+func (msg MsgNewStopOrders) CalculateGas(gasUnit uint64) uint64 {
+	return uint64(len(msg.StopOrderItems)) * gasUnit
+}
+
+// nolint
+type MsgCancelStopOrders struct {
+	Sender       sdk.AccAddress `json:"sender"` // order maker address
+	StopOrderIDs []string       `json:"stop_order_ids"`
+}
+
+// NewMsgCancelStopOrders is a constructor function for MsgCancelStopOrders
+func NewMsgCancelStopOrders(sender sdk.AccAddress, stopOrderIDs []string) MsgCancelStopOrders {
+	return MsgCancelStopOrders{
+		Sender:       sender,
+		StopOrderIDs: stopOrderIDs,
+	}
+}
+
+// nolint
+func (msg MsgCancelStopOrders) Route() string { return "order" }
+
+// nolint
+func (msg MsgCancelStopOrders) Type() string { return "cancel_stop" }
+
+// nolint
+func (msg MsgCancelStopOrders) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if len(msg.StopOrderIDs) == 0 {
+		return ErrOrderIDsIsEmpty()
+	}
+	if len(msg.StopOrderIDs) > MultiCancelOrderItemLimit {
+		return ErrCancelOrderBiggerThanLimit(MultiCancelOrderItemLimit)
+	}
+	if hasDuplicatedID(msg.StopOrderIDs) {
+		return ErrOrderIDsHasDuplicatedID()
+	}
+	for _, item := range msg.StopOrderIDs {
+		if item == "" {
+			return ErrUserInputOrderIDIsEmpty()
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelStopOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelStopOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Calculate customize gas
+func (msg MsgCancelStopOrders) CalculateGas(gasUnit uint64) uint64 {
+	return uint64(len(msg.StopOrderIDs)) * gasUnit
+}
+
+//********************MsgAmendOrders*************
+// nolint
+type MsgAmendOrders struct {
+	Sender          sdk.AccAddress   `json:"sender"` // order maker address
+	AmendOrderItems []AmendOrderItem `json:"amend_order_items"`
+}
+
+// AmendOrderItem changes the price or quantity of an open order
+type AmendOrderItem struct {
+	OrderID  string  `json:"order_id"` // order id
+	Price    sdk.Dec `json:"price"`    // new price of the order
+	Quantity sdk.Dec `json:"quantity"` // new quantity of the order, including the filled part
+}
+
+// NewMsgAmendOrders is a constructor function for MsgAmendOrders
+func NewMsgAmendOrders(sender sdk.AccAddress, amendOrderItems []AmendOrderItem) MsgAmendOrders {
+	return MsgAmendOrders{
+		Sender:          sender,
+		AmendOrderItems: amendOrderItems,
+	}
+}
+
+// nolint
+func (msg MsgAmendOrders) Route() string { return "order" }
+
+// nolint
+func (msg MsgAmendOrders) Type() string { return "amend" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgAmendOrders) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if len(msg.AmendOrderItems) == 0 {
+		return ErrOrderItemCountsIsEmpty()
+	}
+	if len(msg.AmendOrderItems) > OrderItemLimit {
+		return ErrOrderItemCountsBiggerThanLimit(OrderItemLimit)
+	}
+	orderIDs := make([]string, 0, len(msg.AmendOrderItems))
+	for _, item := range msg.AmendOrderItems {
+		if item.OrderID == "" {
+			return ErrUserInputOrderIDIsEmpty()
+		}
+		if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
+			return ErrOrderItemPriceOrQuantityIsNotPositive()
+		}
+		orderIDs = append(orderIDs, item.OrderID)
+	}
+	if hasDuplicatedID(orderIDs) {
+		return ErrOrderIDsHasDuplicatedID()
+	}
+
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgAmendOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAmendOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Calculate customize gas
+func (msg MsgAmendOrders) CalculateGas(gasUnit uint64) uint64 {
+	return uint64(len(msg.AmendOrderItems)) * gasUnit
+}
+
 // nolint
 type OrderResult struct {
 	Error   error  `json:"error"`
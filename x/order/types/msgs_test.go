@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
 
 	"github.com/stretchr/testify/require"
@@ -91,6 +92,18 @@ func TestMsgNewOrderInvalid(t *testing.T) {
 	orderMsg = NewMsgNewOrder(addr, common.TestToken+"_"+common.TestToken, BuyOrder, testPrice, "-1")
 	err = orderMsg.ValidateBasic()
 	require.NotNil(t, err)
+
+	//invalid order type
+	orderMsg = NewMsgNewOrder(addr, "btc_"+common.NativeToken, BuyOrder, testPrice, testQuantity)
+	orderMsg.OrderItems[0].Type = "GTC"
+	err = orderMsg.ValidateBasic()
+	require.NotNil(t, err)
+
+	//valid order types
+	for _, orderType := range []string{OrderTypeIOC, OrderTypeFOK, OrderTypePostOnly, OrderTypeMarket} {
+		orderMsg.OrderItems[0].Type = orderType
+		require.Nil(t, orderMsg.ValidateBasic())
+	}
 }
 
 func TestMsgCancelOrder(t *testing.T) {
@@ -313,3 +326,85 @@ func TestHasDuplicatedID(t *testing.T) {
 	result2 := hasDuplicatedID(ids2)
 	require.EqualValues(t, true, result2)
 }
+
+func TestMsgNewStopOrders(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	item := StopOrderItem{
+		Product:      TestTokenPair,
+		Side:         SellOrder,
+		Price:        sdk.MustNewDecFromStr("9.0"),
+		Quantity:     sdk.MustNewDecFromStr("1.0"),
+		StopType:     StopTypeStopLoss,
+		TriggerPrice: sdk.MustNewDecFromStr("9.5"),
+	}
+	msg := NewMsgNewStopOrders(addr, []StopOrderItem{item})
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "new_stop", msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	item.StopType = "STOP"
+	msg = NewMsgNewStopOrders(addr, []StopOrderItem{item})
+	require.Error(t, msg.ValidateBasic())
+
+	item.StopType = StopTypeTakeProfit
+	item.TriggerPrice = sdk.ZeroDec()
+	msg = NewMsgNewStopOrders(addr, []StopOrderItem{item})
+	require.Error(t, msg.ValidateBasic())
+
+	cancelMsg := NewMsgCancelStopOrders(addr, []string{"STOP1"})
+	require.Nil(t, cancelMsg.ValidateBasic())
+	require.Equal(t, "cancel_stop", cancelMsg.Type())
+	cancelMsg = NewMsgCancelStopOrders(addr, []string{"STOP1", "STOP1"})
+	require.Error(t, cancelMsg.ValidateBasic())
+}
+
+func TestMsgAmendOrders(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	item := AmendOrderItem{
+		OrderID:  testOrderID,
+		Price:    sdk.MustNewDecFromStr("9.0"),
+		Quantity: sdk.MustNewDecFromStr("1.0"),
+	}
+	msg := NewMsgAmendOrders(addr, []AmendOrderItem{item})
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "amend", msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	msg = NewMsgAmendOrders(addr, []AmendOrderItem{item, item})
+	require.Error(t, msg.ValidateBasic())
+
+	item.Quantity = sdk.ZeroDec()
+	msg = NewMsgAmendOrders(addr, []AmendOrderItem{item})
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgRegisterReferralCode(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	referrer, err := hex.DecodeString("3434343434343434343434343434343434343434")
+	require.Nil(t, err)
+	msg := NewMsgRegisterReferralCode(addr, "okex2021", referrer, sdk.MustNewDecFromStr("0.2"))
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "register_referral_code", msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	msg = NewMsgRegisterReferralCode(addr, "ok", referrer, sdk.MustNewDecFromStr("0.2"))
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgRegisterReferralCode(addr, "okex_2021", referrer, sdk.MustNewDecFromStr("0.2"))
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgRegisterReferralCode(addr, "okex2021", nil, sdk.MustNewDecFromStr("0.2"))
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgRegisterReferralCode(addr, "okex2021", referrer, sdk.MustNewDecFromStr("1.2"))
+	require.Error(t, msg.ValidateBasic())
+
+	newOrdersMsg := NewMsgNewOrder(addr, TestTokenPair, BuyOrder, "10.0", "1.0")
+	newOrdersMsg.ReferralCode = "okex2021"
+	require.Nil(t, newOrdersMsg.ValidateBasic())
+	newOrdersMsg.ReferralCode = "okex-2021"
+	require.Error(t, newOrdersMsg.ValidateBasic())
+}
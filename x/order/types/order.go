@@ -20,6 +20,9 @@ const (
 	Expired
 	PartialFilledCancelled
 	PartialFilledExpired
+	_
+	Killed
+	PartialFilledKilled
 )
 
 func (p OrderStatus) String() string {
@@ -36,6 +39,10 @@ func (p OrderStatus) String() string {
 		return "PartialFilledCancelled"
 	case PartialFilledExpired:
 		return "PartialFilledExpired"
+	case Killed:
+		return "Killed"
+	case PartialFilledKilled:
+		return "PartialFilledKilled"
 	default:
 		return "Unknown"
 	}
@@ -50,8 +57,29 @@ const (
 	OrderStatusPartialFilledCancelled = 4
 	OrderStatusPartialFilledExpired   = 5
 	//OrderStatusPartialFilled          = 6
+	OrderStatusKilled              = 7
+	OrderStatusPartialFilledKilled = 8
 )
 
+// nolint
+const (
+	OrderTypeLimit    = ""          // good till expired
+	OrderTypeIOC      = "IOC"       // immediate or cancel, the unfilled part is killed after matching
+	OrderTypeFOK      = "FOK"       // fill or kill, the order is killed if it can not be fully filled
+	OrderTypePostOnly = "POST_ONLY" // rejected if it would cross with the depth book
+	OrderTypeMarket   = "MARKET"    // price is the worst price, the unfilled part is killed after matching
+)
+
+// IsValidOrderType returns whether the order type is supported
+func IsValidOrderType(orderType string) bool {
+	switch orderType {
+	case OrderTypeLimit, OrderTypeIOC, OrderTypeFOK, OrderTypePostOnly, OrderTypeMarket:
+		return true
+	default:
+		return false
+	}
+}
+
 // nolint
 const (
 	OrderExtraInfoKeyNewFee     = "newFee"
@@ -77,7 +105,9 @@ type Order struct {
 	Timestamp         int64          `json:"timestamp"`        // created timestamp
 	OrderExpireBlocks int64          `json:"order_expire_blocks"`
 	FeePerBlock       sdk.SysCoin    `json:"fee_per_block"`
-	ExtraInfo         string         `json:"extra_info"` // extra info of order in json format
+	ExtraInfo         string         `json:"extra_info"`              // extra info of order in json format
+	Type              string         `json:"type"`                    // order type, see OrderTypeXXX
+	ReferralCode      string         `json:"referral_code,omitempty"` // referral code of the operator's referrer
 }
 
 // nolint
@@ -216,6 +246,20 @@ func (order *Order) Expire() {
 	}
 }
 
+// Kill : the unfilled part of an immediate order is killed after matching
+func (order *Order) Kill() {
+	if order.RemainQuantity.Equal(order.Quantity) {
+		order.Status = OrderStatusKilled
+	} else {
+		order.Status = OrderStatusPartialFilledKilled
+	}
+}
+
+// IsImmediate returns whether the unfilled part of the order should be killed after matching
+func (order *Order) IsImmediate() bool {
+	return order.Type == OrderTypeIOC || order.Type == OrderTypeFOK || order.Type == OrderTypeMarket
+}
+
 // NeedLockCoins : when place a new order, we should lock the coins of sender
 func (order *Order) NeedLockCoins() sdk.SysCoins {
 	if order.Side == BuyOrder {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
@@ -18,8 +19,13 @@ const (
 	DefaultFeeAmountPerBlock     = "0" // okt
 	DefaultFeeDenomPerBlock      = common.NativeToken
 	DefaultFeeRateTrade          = "0.001" // percentage
+	DefaultFeeRateMaker          = "0.001" // percentage
 	DefaultNewOrderMsgGasUnit    = 40000
 	DefaultCancelOrderMsgGasUnit = 30000
+
+	// Auction types
+	AuctionTypePeriodic   = "periodicauction"
+	AuctionTypeContinuous = "continuousauction"
 )
 
 // nolint : Parameter keys
@@ -30,6 +36,10 @@ var (
 	KeyTradeFeeRate          = []byte("TradeFeeRate")
 	KeyNewOrderMsgGasUnit    = []byte("NewOrderMsgGasUnit")
 	KeyCancelOrderMsgGasUnit = []byte("CancelOrderMsgGasUnit")
+	KeyContinuousProducts    = []byte("ContinuousAuctionProducts")
+	KeyMakerFeeRate          = []byte("MakerFeeRate")
+	KeyFeeTiers              = []byte("FeeTiers")
+	KeyPriceBands            = []byte("PriceBands")
 	DefaultFeePerBlock       = sdk.NewDecCoinFromDec(DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr(DefaultFeeAmountPerBlock))
 )
 
@@ -41,9 +51,29 @@ type Params struct {
 	OrderExpireBlocks     int64       `json:"order_expire_blocks"`
 	MaxDealsPerBlock      int64       `json:"max_deals_per_block"`
 	FeePerBlock           sdk.SysCoin `json:"fee_per_block"`
-	TradeFeeRate          sdk.Dec     `json:"trade_fee_rate"`
+	TradeFeeRate          sdk.Dec     `json:"trade_fee_rate"` // deal fee rate of the taker
 	NewOrderMsgGasUnit    uint64      `json:"new_order_msg_gas_unit"`
 	CancelOrderMsgGasUnit uint64      `json:"cancel_order_msg_gas_unit"`
+	// products matched by the continuous auction engine, others are matched by the periodic auction engine
+	ContinuousAuctionProducts []string `json:"continuous_auction_products"`
+	MakerFeeRate              sdk.Dec  `json:"maker_fee_rate"` // deal fee rate of the maker
+	// fee rates of the addresses with large deal volume in the last DealVolumeWindowDays days
+	FeeTiers []FeeTier `json:"fee_tiers"`
+	// price protections of the products, products not listed here are unprotected
+	PriceBands []PriceBand `json:"price_bands"`
+}
+
+// FeeTier is the deal fee rates of the addresses whose deal volume, in amount of the quote token of the product
+// traded, reaches MinVolume in the last DealVolumeWindowDays days
+type FeeTier struct {
+	MinVolume    sdk.Dec `json:"min_volume"`
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// String implements the stringer interface.
+func (tier FeeTier) String() string {
+	return fmt.Sprintf("%s:%s/%s", tier.MinVolume, tier.MakerFeeRate, tier.TakerFeeRate)
 }
 
 // ParamKeyTable for auth module
@@ -56,6 +86,84 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateAuctionProducts(value interface{}) error {
+	products, ok := value.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	productSet := make(map[string]struct{}, len(products))
+	for _, product := range products {
+		symbols := strings.Split(product, "_")
+		if len(symbols) != 2 || symbols[0] == "" || symbols[1] == "" || symbols[0] == symbols[1] {
+			return fmt.Errorf("invalid continuous auction product: %s", product)
+		}
+		if _, ok := productSet[product]; ok {
+			return fmt.Errorf("duplicated continuous auction product: %s", product)
+		}
+		productSet[product] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateFeeTiers(value interface{}) error {
+	tiers, ok := value.([]FeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return fmt.Errorf("min volume of fee tier %d should be positive", i)
+		}
+		if i > 0 && tier.MinVolume.LTE(tiers[i-1].MinVolume) {
+			return fmt.Errorf("min volume of fee tier %d should be greater than the previous tier", i)
+		}
+		if err := common.ValidateRateNotNeg("maker fee rate")(tier.MakerFeeRate); err != nil {
+			return err
+		}
+		if err := common.ValidateRateNotNeg("taker fee rate")(tier.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePriceBands(value interface{}) error {
+	bands, ok := value.([]PriceBand)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	productSet := make(map[string]struct{}, len(bands))
+	for _, band := range bands {
+		if err := validateAuctionProducts([]string{band.Product}); err != nil {
+			return fmt.Errorf("invalid price band product: %s", band.Product)
+		}
+		if _, ok := productSet[band.Product]; ok {
+			return fmt.Errorf("duplicated price band product: %s", band.Product)
+		}
+		productSet[band.Product] = struct{}{}
+
+		if band.BandRate.IsNil() || band.HaltThreshold.IsNil() {
+			return fmt.Errorf("band rate and halt threshold of product %s should be set", band.Product)
+		}
+		if err := common.ValidateRateNotNeg("band rate")(band.BandRate); err != nil {
+			return err
+		}
+		if band.HaltThreshold.IsNegative() {
+			return fmt.Errorf("halt threshold of product %s should not be negative", band.Product)
+		}
+		if band.HaltBlocks < 0 {
+			return fmt.Errorf("halt blocks of product %s should not be negative", band.Product)
+		}
+	}
+
+	return nil
+}
+
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 // nolint
@@ -67,6 +175,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyTradeFeeRate, &p.TradeFeeRate, common.ValidateRateNotNeg("trade fee rate")},
 		{KeyNewOrderMsgGasUnit, &p.NewOrderMsgGasUnit, common.ValidateUint64Positive("new order msg gas unit")},
 		{KeyCancelOrderMsgGasUnit, &p.CancelOrderMsgGasUnit, common.ValidateUint64Positive("cancel order msg gas unit")},
+		{KeyContinuousProducts, &p.ContinuousAuctionProducts, validateAuctionProducts},
+		{KeyMakerFeeRate, &p.MakerFeeRate, common.ValidateRateNotNeg("maker fee rate")},
+		{KeyFeeTiers, &p.FeeTiers, validateFeeTiers},
+		{KeyPriceBands, &p.PriceBands, validatePriceBands},
 	}
 }
 
@@ -79,18 +191,65 @@ func DefaultParams() Params {
 		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
 		NewOrderMsgGasUnit:    DefaultNewOrderMsgGasUnit,
 		CancelOrderMsgGasUnit: DefaultCancelOrderMsgGasUnit,
+		MakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateMaker),
+	}
+}
+
+// GetAuctionType returns the auction type which the product is matched with
+func (p Params) GetAuctionType(product string) string {
+	for _, continuousProduct := range p.ContinuousAuctionProducts {
+		if continuousProduct == product {
+			return AuctionTypeContinuous
+		}
+	}
+	return AuctionTypePeriodic
+}
+
+// GetFeeTier returns the highest fee tier reached by the deal volume, or nil if no tier is reached
+func (p Params) GetFeeTier(volume sdk.Dec) *FeeTier {
+	var feeTier *FeeTier
+	for i := range p.FeeTiers {
+		if volume.LT(p.FeeTiers[i].MinVolume) {
+			break
+		}
+		feeTier = &p.FeeTiers[i]
+	}
+	return feeTier
+}
+
+// GetPriceBand returns the price band of the product, or nil if the product is unprotected
+func (p Params) GetPriceBand(product string) *PriceBand {
+	for i := range p.PriceBands {
+		if p.PriceBands[i].Product == product {
+			return &p.PriceBands[i]
+		}
 	}
+	return nil
 }
 
 // String implements the stringer interface.
 func (p Params) String() string {
+	feeTiers := make([]string, 0, len(p.FeeTiers))
+	for _, tier := range p.FeeTiers {
+		feeTiers = append(feeTiers, tier.String())
+	}
+	priceBands := make([]string, 0, len(p.PriceBands))
+	for _, band := range p.PriceBands {
+		priceBands = append(priceBands, band.String())
+	}
 	return fmt.Sprintf(`Order Params:
   OrderExpireBlocks: %d
   MaxDealsPerBlock: %d
   FeePerBlock: %s
   TradeFeeRate: %s
   NewOrderMsgGasUnit: %d
-  CancelOrderMsgGasUnit: %d`, p.OrderExpireBlocks,
+  CancelOrderMsgGasUnit: %d
+  ContinuousAuctionProducts: %s
+  MakerFeeRate: %s
+  FeeTiers: %s
+  PriceBands: %s`, p.OrderExpireBlocks,
 		p.MaxDealsPerBlock, p.FeePerBlock,
-		p.TradeFeeRate, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit)
+		p.TradeFeeRate, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit,
+		strings.Join(p.ContinuousAuctionProducts, ","), p.MakerFeeRate, strings.Join(feeTiers, ","),
+		strings.Join(priceBands, ","))
 }
@@ -54,6 +54,76 @@ func TestParamsString(t *testing.T) {
   FeePerBlock: 0.000000000000000000` + common.NativeToken + `
   TradeFeeRate: 0.001000000000000000
   NewOrderMsgGasUnit: 40000
-  CancelOrderMsgGasUnit: 30000`
+  CancelOrderMsgGasUnit: 30000
+  ContinuousAuctionProducts: 
+  MakerFeeRate: 0.001000000000000000
+  FeeTiers: 
+  PriceBands: `
 	require.EqualValues(t, expectString, param.String())
 }
+
+func TestParamsGetAuctionType(t *testing.T) {
+	param := DefaultParams()
+	require.Equal(t, AuctionTypePeriodic, param.GetAuctionType(TestTokenPair))
+
+	param.ContinuousAuctionProducts = []string{TestTokenPair}
+	require.Equal(t, AuctionTypeContinuous, param.GetAuctionType(TestTokenPair))
+	require.Equal(t, AuctionTypePeriodic, param.GetAuctionType("btc_"+common.NativeToken))
+
+	require.NoError(t, validateAuctionProducts(param.ContinuousAuctionProducts))
+	require.Error(t, validateAuctionProducts([]string{TestTokenPair, TestTokenPair}))
+	require.Error(t, validateAuctionProducts([]string{"btc"}))
+	require.Error(t, validateAuctionProducts("btc_"+common.NativeToken))
+}
+
+func TestParamsFeeTiers(t *testing.T) {
+	params := DefaultParams()
+	require.Nil(t, params.GetFeeTier(sdk.MustNewDecFromStr("100")))
+
+	params.FeeTiers = []FeeTier{
+		{MinVolume: sdk.MustNewDecFromStr("100"), MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.MustNewDecFromStr("0.0008")},
+		{MinVolume: sdk.MustNewDecFromStr("1000"), MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.MustNewDecFromStr("0.0005")},
+	}
+	require.Nil(t, validateFeeTiers(params.FeeTiers))
+	require.Nil(t, params.GetFeeTier(sdk.MustNewDecFromStr("99")))
+	require.EqualValues(t, params.FeeTiers[0], *params.GetFeeTier(sdk.MustNewDecFromStr("100")))
+	require.EqualValues(t, params.FeeTiers[1], *params.GetFeeTier(sdk.MustNewDecFromStr("5000")))
+
+	// min volume should be ascending
+	params.FeeTiers[1].MinVolume = sdk.MustNewDecFromStr("100")
+	require.Error(t, validateFeeTiers(params.FeeTiers))
+	params.FeeTiers[1].MinVolume = sdk.MustNewDecFromStr("1000")
+	params.FeeTiers[1].TakerFeeRate = sdk.MustNewDecFromStr("1.1")
+	require.Error(t, validateFeeTiers(params.FeeTiers))
+}
+
+func TestParamsPriceBands(t *testing.T) {
+	params := DefaultParams()
+	require.Nil(t, params.GetPriceBand(TestTokenPair))
+
+	params.PriceBands = []PriceBand{
+		{Product: TestTokenPair, BandRate: sdk.MustNewDecFromStr("0.1"),
+			HaltThreshold: sdk.MustNewDecFromStr("0.05"), HaltBlocks: 10},
+	}
+	require.Nil(t, validatePriceBands(params.PriceBands))
+	band := params.GetPriceBand(TestTokenPair)
+	require.NotNil(t, band)
+
+	minPrice, maxPrice := band.Limits(sdk.MustNewDecFromStr("10"))
+	require.EqualValues(t, sdk.MustNewDecFromStr("9"), minPrice)
+	require.EqualValues(t, sdk.MustNewDecFromStr("11"), maxPrice)
+	require.False(t, band.IsHaltTriggered(sdk.MustNewDecFromStr("10"), sdk.MustNewDecFromStr("10.5")))
+	require.True(t, band.IsHaltTriggered(sdk.MustNewDecFromStr("10"), sdk.MustNewDecFromStr("10.6")))
+	require.True(t, band.IsHaltTriggered(sdk.MustNewDecFromStr("10"), sdk.MustNewDecFromStr("9.4")))
+	require.False(t, band.IsHaltTriggered(sdk.ZeroDec(), sdk.MustNewDecFromStr("9.4")))
+
+	// duplicated product
+	params.PriceBands = append(params.PriceBands, params.PriceBands[0])
+	require.Error(t, validatePriceBands(params.PriceBands))
+	params.PriceBands = params.PriceBands[:1]
+	params.PriceBands[0].HaltBlocks = -1
+	require.Error(t, validatePriceBands(params.PriceBands))
+	params.PriceBands[0].HaltBlocks = 10
+	params.PriceBands[0].BandRate = sdk.MustNewDecFromStr("1.1")
+	require.Error(t, validatePriceBands(params.PriceBands))
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceBand is the price protection of a product, relative to its last price.
+// Orders priced beyond last price * (1 ± BandRate) are rejected, and the matching of both the periodic and the
+// continuous auction is halted for HaltBlocks blocks once the match price moves more than HaltThreshold from the
+// last price.
+// A zero BandRate or HaltThreshold disables the corresponding protection.
+type PriceBand struct {
+	Product       string  `json:"product"`
+	BandRate      sdk.Dec `json:"band_rate"`
+	HaltThreshold sdk.Dec `json:"halt_threshold"`
+	HaltBlocks    int64   `json:"halt_blocks"`
+}
+
+// String implements the stringer interface.
+func (band PriceBand) String() string {
+	return fmt.Sprintf("%s:%s/%s/%d", band.Product, band.BandRate, band.HaltThreshold, band.HaltBlocks)
+}
+
+// Limits returns the lowest and highest order prices allowed around the reference price
+func (band PriceBand) Limits(refPrice sdk.Dec) (minPrice, maxPrice sdk.Dec) {
+	minPrice = refPrice.Mul(sdk.OneDec().Sub(band.BandRate))
+	maxPrice = refPrice.Mul(sdk.OneDec().Add(band.BandRate))
+	return
+}
+
+// IsHaltTriggered returns whether the move from the reference price to the clearing price exceeds the halt threshold
+func (band PriceBand) IsHaltTriggered(refPrice, matchPrice sdk.Dec) bool {
+	if !band.HaltThreshold.IsPositive() || band.HaltBlocks <= 0 || !refPrice.IsPositive() {
+		return false
+	}
+	return matchPrice.Sub(refPrice).Abs().Quo(refPrice).GT(band.HaltThreshold)
+}
+
+// TradingHalt records a volatility halt of a product. Matching is paused until EndHeight, and the record is
+// removed by the first auction which is executed after the halt ends, or at the end of the first block after it.
+type TradingHalt struct {
+	Product     string  `json:"product"`
+	StartHeight int64   `json:"start_height"`
+	EndHeight   int64   `json:"end_height"`
+	RefPrice    sdk.Dec `json:"ref_price"`
+	MatchPrice  sdk.Dec `json:"match_price"`
+}
+
+// IsActive returns whether matching of the product is still paused at the block height
+func (halt TradingHalt) IsActive(blockHeight int64) bool {
+	return blockHeight <= halt.EndHeight
+}
+
+// String implements the stringer interface.
+func (halt TradingHalt) String() string {
+	return fmt.Sprintf(`TradingHalt:
+  Product:     %s
+  StartHeight: %d
+  EndHeight:   %d
+  RefPrice:    %s
+  MatchPrice:  %s`, halt.Product, halt.StartHeight, halt.EndHeight, halt.RefPrice, halt.MatchPrice)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var referralCodeRegex = regexp.MustCompile(`^[a-zA-Z0-9]{4,32}$`)
+
+// IsValidReferralCode returns whether the referral code consists of 4 to 32 letters or digits
+func IsValidReferralCode(code string) bool {
+	return referralCodeRegex.MatchString(code)
+}
+
+// ReferralCode is registered by a dex operator for a referrer, who receives a slice of the operator's deal fees
+// of the orders placed with the code
+type ReferralCode struct {
+	Code       string         `json:"code"`
+	Operator   sdk.AccAddress `json:"operator"`
+	Referrer   sdk.AccAddress `json:"referrer"`
+	RebateRate sdk.Dec        `json:"rebate_rate"`
+}
+
+// String implements the stringer interface.
+func (code ReferralCode) String() string {
+	return fmt.Sprintf(`ReferralCode:
+  Code:       %s
+  Operator:   %s
+  Referrer:   %s
+  RebateRate: %s`, code.Code, code.Operator, code.Referrer, code.RebateRate)
+}
+
+// ReferralEarnings is the total rebates earned by a referrer, which is used for the genesis export
+type ReferralEarnings struct {
+	Referrer sdk.AccAddress `json:"referrer"`
+	Earnings sdk.SysCoins   `json:"earnings"`
+}
+
+// MsgRegisterReferralCode is used by a dex operator to register or update a referral code
+type MsgRegisterReferralCode struct {
+	Operator   sdk.AccAddress `json:"operator"`
+	Code       string         `json:"code"`
+	Referrer   sdk.AccAddress `json:"referrer"`
+	RebateRate sdk.Dec        `json:"rebate_rate"`
+}
+
+// NewMsgRegisterReferralCode is a constructor function for MsgRegisterReferralCode
+func NewMsgRegisterReferralCode(operator sdk.AccAddress, code string, referrer sdk.AccAddress,
+	rebateRate sdk.Dec) MsgRegisterReferralCode {
+	return MsgRegisterReferralCode{
+		Operator:   operator,
+		Code:       code,
+		Referrer:   referrer,
+		RebateRate: rebateRate,
+	}
+}
+
+// nolint
+func (msg MsgRegisterReferralCode) Route() string { return "order" }
+
+// nolint
+func (msg MsgRegisterReferralCode) Type() string { return "register_referral_code" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgRegisterReferralCode) ValidateBasic() sdk.Error {
+	if msg.Operator.Empty() {
+		return ErrInvalidAddress(msg.Operator.String())
+	}
+	if msg.Referrer.Empty() {
+		return ErrInvalidAddress(msg.Referrer.String())
+	}
+	if !IsValidReferralCode(msg.Code) {
+		return ErrInvalidReferralCode(msg.Code)
+	}
+	if msg.RebateRate.IsNil() || msg.RebateRate.IsNegative() || msg.RebateRate.GT(sdk.OneDec()) {
+		return ErrInvalidRebateRate(msg.RebateRate)
+	}
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgRegisterReferralCode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterReferralCode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
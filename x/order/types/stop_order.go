@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	StopTypeStopLoss   = "STOP_LOSS"
+	StopTypeTakeProfit = "TAKE_PROFIT"
+)
+
+// StopOrder is a conditional order, it locks coins like a normal order but stays outside the depth book
+// until the last price of the product crosses the trigger price
+type StopOrder struct {
+	StopOrderID       string         `json:"stop_order_id"`             // stop order id
+	TxHash            string         `json:"txhash"`                    // txHash of the place stop order tx
+	Sender            sdk.AccAddress `json:"sender"`                    // order maker address
+	Product           string         `json:"product"`                   // product for trading pair
+	Side              string         `json:"side"`                      // BUY/SELL
+	Price             sdk.Dec        `json:"price"`                     // price of the order placed when triggered
+	Quantity          sdk.Dec        `json:"quantity"`                  // quantity of the order
+	StopType          string         `json:"stop_type"`                 // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice      sdk.Dec        `json:"trigger_price"`             // the order is triggered when last price crosses it
+	Timestamp         int64          `json:"timestamp"`                 // created timestamp
+	ExpireBlockHeight int64          `json:"expire_block_height"`       // the stop order is quit after this height
+	CreationHeight    int64          `json:"creation_height,omitempty"` // block height when the stop order is placed
+	FeePerBlock       sdk.SysCoin    `json:"fee_per_block,omitempty"`   // fee charged per block before quitting
+}
+
+// nolint
+func NewStopOrder(txHash string, sender sdk.AccAddress, product, side string, price, quantity sdk.Dec,
+	stopType string, triggerPrice sdk.Dec, timestamp int64, expireBlockHeight int64) *StopOrder {
+	return &StopOrder{
+		TxHash:            txHash,
+		Sender:            sender,
+		Product:           product,
+		Side:              side,
+		Price:             price,
+		Quantity:          quantity,
+		StopType:          stopType,
+		TriggerPrice:      triggerPrice,
+		Timestamp:         timestamp,
+		ExpireBlockHeight: expireBlockHeight,
+	}
+}
+
+// IsTriggered returns whether the last price crosses the trigger price.
+// A stop loss order sells when the price falls or buys when the price rises,
+// and a take profit order works the other way round.
+func (stopOrder *StopOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	if !lastPrice.IsPositive() {
+		return false
+	}
+	if stopOrder.IsTriggeredByFall() {
+		return lastPrice.LTE(stopOrder.TriggerPrice)
+	}
+	return lastPrice.GTE(stopOrder.TriggerPrice)
+}
+
+// IsTriggeredByFall returns whether the stop order is triggered when the last price falls to the trigger price,
+// otherwise it's triggered when the last price rises to the trigger price
+func (stopOrder *StopOrder) IsTriggeredByFall() bool {
+	if stopOrder.StopType == StopTypeStopLoss {
+		return stopOrder.Side == SellOrder
+	}
+	return stopOrder.Side == BuyOrder
+}
+
+// NeedLockCoins : the same coins as the triggered order are locked
+func (stopOrder *StopOrder) NeedLockCoins() sdk.SysCoins {
+	return stopOrder.toOrder().NeedLockCoins()
+}
+
+func (stopOrder *StopOrder) toOrder() *Order {
+	return &Order{
+		Product:  stopOrder.Product,
+		Side:     stopOrder.Side,
+		Price:    stopOrder.Price,
+		Quantity: stopOrder.Quantity,
+	}
+}
+
+// nolint
+func FormatStopOrderID(seq uint64) string {
+	return fmt.Sprintf("STOP%d", seq)
+}
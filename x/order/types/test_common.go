@@ -14,6 +14,7 @@ func DefaultTestParams() Params {
 		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+		MakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateMaker),
 	}
 }
 
@@ -0,0 +1,28 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateParamSet sets the params of the param set unset in the subspace to the values in the param set, since
+// getting the params panics if any param added after the genesis is unset. The params set in store are kept
+func MigrateParamSet(ctx sdk.Context, subspace Subspace, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		if !subspace.Has(ctx, pair.Key) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// ParamGetter is the subspace which the params set in store are got from
+type ParamGetter interface {
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+}
+
+// GetParamSetIfExists gets the params of the param set which are set in the subspace. The params unset in store, e.g.
+// the ones added by an upgrade not applied yet, keep the values in the param set instead of panicking
+func GetParamSetIfExists(ctx sdk.Context, subspace ParamGetter, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		subspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
@@ -80,7 +80,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
-			//distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
@@ -91,8 +91,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			//don't slashing tokens, just jail the validator
-			//k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
 			k.sk.Jail(ctx, consAddr)
 			k.GetStakingKeeper().AppendAbandonedValidatorAddrs(ctx, consAddr)
 
@@ -13,15 +13,19 @@ import (
 )
 
 const (
-	DefaultParamspace = keeper.DefaultParamspace
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	TStoreKey         = types.TStoreKey
-	QuerierRoute      = types.QuerierRoute
-	RouterKey         = types.RouterKey
-	NotBondedPoolName = types.NotBondedPoolName
-	BondedPoolName    = types.BondedPoolName
-	QueryParameters   = types.QueryParameters
+	DefaultParamspace      = keeper.DefaultParamspace
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	TStoreKey              = types.TStoreKey
+	QuerierRoute           = types.QuerierRoute
+	RouterKey              = types.RouterKey
+	NotBondedPoolName      = types.NotBondedPoolName
+	BondedPoolName         = types.BondedPoolName
+	QueryParameters        = types.QueryParameters
+	QuerySlashes           = types.QuerySlashes
+	QueryPendingRebalance  = types.QueryPendingRebalance
+	QueryPendingRebalances = types.QueryPendingRebalances
+	EventTypeSlash         = types.EventTypeSlash
 )
 
 var (
@@ -40,12 +44,14 @@ var (
 	GetValidatorsByPowerIndexKey       = types.GetValidatorsByPowerIndexKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
+	NewMsgEditValidatorCommissionRate  = types.NewMsgEditValidatorCommissionRate
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgWithdraw                     = types.NewMsgWithdraw
 	DefaultParams                      = types.DefaultParams
 	NewValidator                       = types.NewValidator
 	NewDescription                     = types.NewDescription
 	NewMsgAddShares                    = types.NewMsgAddShares
+	NewMsgRebalanceShares              = types.NewMsgRebalanceShares
 	NewGenesisState                    = types.NewGenesisState
 	DelegatorAddSharesInvariant        = keeper.DelegatorAddSharesInvariant
 
@@ -55,15 +61,21 @@ var (
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	GenesisState              = types.GenesisState
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	ValidatorExport           = types.ValidatorExported
-	Description               = types.Description
-	ValidatorI                = exported.ValidatorI
-	Delegator                 = types.Delegator
-	UndelegationInfo          = types.UndelegationInfo
-	ProxyDelegatorKeyExported = types.ProxyDelegatorKeyExported
-	SharesResponses           = types.SharesResponses
+	Keeper                         = keeper.Keeper
+	GenesisState                   = types.GenesisState
+	Validator                      = types.Validator
+	Validators                     = types.Validators
+	ValidatorExport                = types.ValidatorExported
+	Description                    = types.Description
+	ValidatorI                     = exported.ValidatorI
+	Delegator                      = types.Delegator
+	UndelegationInfo               = types.UndelegationInfo
+	ProxyDelegatorKeyExported      = types.ProxyDelegatorKeyExported
+	SharesResponses                = types.SharesResponses
+	SlashRecord                    = types.SlashRecord
+	SlashRecords                   = types.SlashRecords
+	MsgRebalanceShares             = types.MsgRebalanceShares
+	MsgEditValidatorCommissionRate = types.MsgEditValidatorCommissionRate
+	PendingRebalance               = types.PendingRebalance
+	PendingRebalances              = types.PendingRebalances
 )
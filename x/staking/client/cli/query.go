@@ -32,6 +32,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
 		GetCmdQueryProxy(queryRoute, cdc),
+		GetCmdQuerySlashes(queryRoute, cdc),
+		GetCmdQueryPendingRebalance(queryRoute, cdc),
+		GetCmdQueryPendingRebalances(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
@@ -386,3 +389,129 @@ $ %s query staking shares-added-to okexchainvaloper1alq9na49n9yycysh889rl90g9nhe
 		},
 	}
 }
+
+// GetCmdQuerySlashes gets command for querying the slashing history of a validator or of all the validators
+func GetCmdQuerySlashes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "slashes [validator-addr]",
+		Short: "query the slashing history of a validator or of all the validators",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slashing history of a validator, or of all the validators without the address.
+
+Example:
+$ %s query staking slashes okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var valAddr sdk.ValAddress
+			if len(args) == 1 {
+				var err error
+				if valAddr, err = sdk.ValAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySlashes)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var records types.SlashRecords
+			if err := cdc.UnmarshalJSON(resp, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryPendingRebalance gets command for querying the pending rebalance of a delegator
+func GetCmdQueryPendingRebalance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-rebalance [delegator-addr]",
+		Short: "query the pending rebalance of a delegator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending rebalance of a delegator, which keeps it from rebalancing shares again until
+the completion time.
+
+Example:
+$ %s query staking pending-rebalance okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingRebalance)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var pending types.PendingRebalance
+			if err := cdc.UnmarshalJSON(resp, &pending); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(pending)
+		},
+	}
+}
+
+// GetCmdQueryPendingRebalances gets command for querying all the pending rebalances
+func GetCmdQueryPendingRebalances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-rebalances",
+		Short: "query all the pending rebalances",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the pending rebalances of the delegators.
+
+Example:
+$ %s query staking pending-rebalances
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingRebalances)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pendings types.PendingRebalances
+			if err := cdc.UnmarshalJSON(resp, &pendings); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(pendings)
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/okex/okexchain/x/common"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/okex/okexchain/x/staking/types"
@@ -40,9 +42,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 			GetCmdCreateValidator(cdc),
 			GetCmdDestroyValidator(cdc),
 			GetCmdEditValidator(cdc),
+			GetCmdEditValidatorCommissionRate(cdc),
 			GetCmdDeposit(cdc),
 			GetCmdWithdraw(cdc),
 			GetCmdAddShares(cdc),
+			GetCmdRebalanceShares(cdc),
 		)...)
 
 	stakingTxCmd.AddCommand(GetCmdProxy(cdc))
@@ -134,6 +138,39 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdEditValidatorCommissionRate gets the edit validator commission rate command
+func GetCmdEditValidatorCommissionRate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit-validator-commission-rate [commission-rate]",
+		Args:  cobra.ExactArgs(1),
+		Short: "edit the commission rate of an existing validator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the commission rate of an existing validator account, the delegators share the rewards
+out of the commission by the shares they added. The rate can be changed once a day at most.
+
+Example:
+$ %s tx staking edit-validator-commission-rate 0.8 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid commission rate: %s", err)
+			}
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgEditValidatorCommissionRate(sdk.ValAddress(valAddr), rate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
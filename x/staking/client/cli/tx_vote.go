@@ -144,6 +144,43 @@ func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdRebalanceShares gets command for moving the shares from a part of the validators to others
+func GetCmdRebalanceShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebalance-shares [src-validator-addrs] [dst-validator-addrs] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "move the shares from a part of the validators to others while keeping the rest",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the shares from a part of the validators to others without unbonding, while the shares on
+the rest of the validators are kept. It's not allowed to rebalance again until the cool-down completes.
+
+Example:
+$ %s tx staking rebalance-shares okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg `+
+				`okexchainvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5,okexchainvaloper10q0rk5qnyag7wfvvt7rtphlw589m7frshchly8 --from mykey
+`,
+				version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			srcValAddrs, err := getValsSet(args[0])
+			if err != nil {
+				return err
+			}
+			dstValAddrs, err := getValsSet(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRebalanceShares(delAddr, srcValAddrs, dstValAddrs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdProxy gets subcommands for proxy voting
 func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 
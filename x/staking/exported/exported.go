@@ -28,6 +28,7 @@ type ValidatorI interface {
 	GetCommission() sdk.Dec                                 // validator commission rate
 	GetMinSelfDelegation() sdk.Dec                          // validator minimum self delegation
 	GetDelegatorShares() sdk.Dec                            // total outstanding delegator shares
+	GetSharesAddedByDelegators() sdk.Dec                    // shares added by delegators, excluding the shares of msd
 	TokensFromShares(sdk.Dec) sdk.Dec                       // token worth of provided delegator shares
 	TokensFromSharesTruncated(sdk.Dec) sdk.Dec              // token worth of provided delegator shares, truncated
 	TokensFromSharesRoundUp(sdk.Dec) sdk.Dec                // token worth of provided delegator shares, rounded up
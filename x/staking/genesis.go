@@ -45,6 +45,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, proxyDelegatorKeyExported := range data.ProxyDelegatorKeys {
 		keeper.SetProxyBinding(ctx, proxyDelegatorKeyExported.ProxyAddr, proxyDelegatorKeyExported.DelAddr, false)
 	}
+	for _, pending := range data.PendingRebalances {
+		keeper.SetPendingRebalance(ctx, pending)
+		keeper.SetRebalanceQueueTimeKey(ctx, pending.GetReleaseTime(), pending.DelegatorAddress)
+	}
 
 	checkPools(ctx, keeper, sdk.NewDecCoinFromDec(data.Params.BondDenom, bondedTokens),
 		sdk.NewDecCoinFromDec(data.Params.BondDenom, notBondedTokens), data.Exported)
@@ -164,6 +168,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return false
 	})
 
+	pendingRebalances := keeper.GetPendingRebalances(ctx)
+
 	return types.GenesisState{
 		Params:               params,
 		LastTotalPower:       lastTotalPower,
@@ -174,6 +180,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		AllShares:            sharesExportedSlice,
 		ProxyDelegatorKeys:   proxyDelegatorKeys,
 		Exported:             true,
+		PendingRebalances:    pendingRebalances,
 	}
 }
 
@@ -20,12 +20,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateValidator(ctx, msg, k)
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
+		case types.MsgEditValidatorCommissionRate:
+			return handleMsgEditValidatorCommissionRate(ctx, msg, k)
 		case types.MsgDeposit:
 			return handleMsgDeposit(ctx, msg, k)
 		case types.MsgWithdraw:
 			return handleMsgWithdraw(ctx, msg, k)
 		case types.MsgAddShares:
 			return handleMsgAddShares(ctx, msg, k)
+		case types.MsgRebalanceShares:
+			return handleMsgRebalanceShares(ctx, msg, k)
 		case types.MsgBindProxy:
 			return handleMsgBindProxy(ctx, msg, k)
 		case types.MsgUnbindProxy:
@@ -86,6 +90,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			return false
 		})
 
+	// release the delegators whose rebalance cool-down has completed
+	k.CompleteMatureRebalances(ctx, ctx.BlockHeader().Time)
+
 	return validatorUpdates
 }
 
@@ -178,3 +185,36 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgEditValidatorCommissionRate(ctx sdk.Context, msg types.MsgEditValidatorCommissionRate,
+	k keeper.Keeper) (*sdk.Result, error) {
+	// validator must already be registered
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return nil, ErrNoValidatorFound(msg.ValidatorAddress.String())
+	}
+
+	// the delegators share the rewards out of the commission, so the validator lowers the rate to pay them
+	blockTime := ctx.BlockHeader().Time
+	if err := validator.Commission.ValidateNewRate(msg.CommissionRate, blockTime); err != nil {
+		return nil, err
+	}
+
+	k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+	validator.Commission.Rate = msg.CommissionRate
+	validator.Commission.UpdateTime = blockTime
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeEditCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.CommissionRate.String()),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -209,6 +209,16 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgRebalanceShares(ctx sdk.Context, msg types.MsgRebalanceShares, k keeper.Keeper) (*sdk.Result, error) {
+	pending, sdkErr := k.RebalanceShares(ctx, msg.DelAddr, msg.SrcValAddrs, msg.DstValAddrs)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	ctx.EventManager().EmitEvent(buildEventForHandlerRebalanceShares(pending))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 // validateSharesAdding gives a quick validity of target validators before shares adding
 func validateSharesAdding(vals types.Validators) error {
 	if len(vals) == 0 {
@@ -257,6 +267,22 @@ func buildEventForHandlerAddShares(delegator types.Delegator) sdk.Event {
 	return sdk.NewEvent(types.EventTypeAddShares, attributes...)
 }
 
+func buildEventForHandlerRebalanceShares(pending types.PendingRebalance) sdk.Event {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDelegator, pending.DelegatorAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, pending.Shares.String()),
+		sdk.NewAttribute(types.AttributeKeyCompletionTime, pending.CompletionTime.Format(time.RFC3339)),
+	}
+	for _, valAddr := range pending.SrcValidatorAddresses {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySrcValidator, valAddr.String()))
+	}
+	for _, valAddr := range pending.DstValidatorAddresses {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDstValidator, valAddr.String()))
+	}
+
+	return sdk.NewEvent(types.EventTypeRebalanceShares, attributes...)
+}
+
 func handleMsgDeposit(ctx sdk.Context, msg types.MsgDeposit, k keeper.Keeper) (*sdk.Result, error) {
 
 	if msg.Amount.Denom != k.BondDenom(ctx) {
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -155,6 +156,39 @@ func TestInvalidPubKeyTypeMsgCreateValidator(t *testing.T) {
 	require.Nil(t, err, "%v", got)
 }
 
+func TestEditValidatorCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mKeeper.Keeper
+	handler := NewHandler(keeper)
+	got, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], DefaultMSD))
+	require.Nil(t, err, "expected create-validator to be ok, got %v", got)
+
+	// the validator lowers the commission rate to share the rewards with the delegators
+	newRate := sdk.NewDecWithPrec(8, 1)
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(validatorAddr, newRate))
+	require.Nil(t, err)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.GetCommission())
+	require.Equal(t, ctx.BlockHeader().Time, validator.Commission.UpdateTime)
+
+	// the rate can't be changed again within a day
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(validatorAddr, sdk.NewDecWithPrec(5, 1)))
+	require.NotNil(t, err)
+
+	// the rate can be lowered a day later, but can't be raised over the max change rate
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(validatorAddr, sdk.NewDecWithPrec(9, 1)))
+	require.NotNil(t, err)
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(validatorAddr, sdk.NewDecWithPrec(5, 1)))
+	require.Nil(t, err)
+
+	// the validator not found
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(sdk.ValAddress(keep.Addrs[1]), newRate))
+	require.NotNil(t, err)
+}
+
 // TODO: msd is fixed now. nothing could change it!!!
 func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
@@ -84,6 +84,14 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		return time.Time{}, types.ErrInvalidProxyWithdrawTotal(delAddr.String())
 	}
 
+	// the validators that the withdrawn tokens were added shares to, directly or by the proxy
+	valAddrs := delegator.ValidatorAddresses
+	if delegator.HasProxy() {
+		if proxy, found := k.GetDelegator(ctx, delegator.ProxyAddress); found {
+			valAddrs = proxy.ValidatorAddresses
+		}
+	}
+
 	// 1.some okt transfer bondPool into unbondPool
 	k.bondedTokensToNotBonded(ctx, token)
 
@@ -120,12 +128,12 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
 	undelegation, found := k.GetUndelegating(ctx, delAddr)
 	if !found {
-		undelegation = types.NewUndelegationInfo(delAddr, quantity, completionTime)
+		undelegation = types.NewUndelegationInfo(delAddr, sdk.ZeroDec(), completionTime)
 	} else {
 		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
-		undelegation.Quantity = undelegation.Quantity.Add(quantity)
 		undelegation.CompletionTime = completionTime
 	}
+	undelegation.AddEntry(ctx.BlockHeight(), quantity, valAddrs)
 	k.SetUndelegating(ctx, undelegation)
 	k.SetAddrByTimeKeyWithNilValue(ctx, completionTime, delAddr)
 
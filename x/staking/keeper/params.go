@@ -46,6 +46,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.ParamsMaxValsToAddShares(ctx),
 		k.ParamsMinDelegation(ctx),
 		k.ParamsMinSelfDelegation(ctx),
+		k.ParamsRebalanceCooldown(ctx),
 	)
 }
 
@@ -119,3 +120,10 @@ func (k Keeper) ParamsMinSelfDelegation(ctx sdk.Context) (num sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyMinSelfDelegation, &num)
 	return
 }
+
+// ParamsRebalanceCooldown returns the param RebalanceCooldown, which is the default value before the upgrade sets it
+func (k Keeper) ParamsRebalanceCooldown(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultRebalanceCooldown
+	k.paramstore.GetIfExists(ctx, types.KeyRebalanceCooldown, &res)
+	return
+}
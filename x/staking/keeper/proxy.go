@@ -69,6 +69,8 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		return types.ErrNoDelegatorExisted(delAddr.String())
 	}
 
+	valAddrs := getValAddrs(vals)
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
 	for i := 0; i < lenVals; i++ {
 		if vals[i].MinSelfDelegation.IsZero() {
 			return types.ErrAddSharesToDismission(vals[i].OperatorAddress.String())
@@ -89,6 +91,7 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 	// update the delegator struct
 	delegator.Shares = shares
 	k.SetDelegator(ctx, delegator)
+	k.AfterDelegationModified(ctx, delAddr, valAddrs)
 
 	return nil
 }
@@ -101,9 +104,12 @@ func (k Keeper) AddSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, v
 	if sdkErr != nil {
 		return
 	}
+	valAddrs := getValAddrs(vals)
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
 	for i := 0; i < lenVals; i++ {
 		k.addShares(ctx, delAddr, vals[i], shares)
 	}
+	k.AfterDelegationModified(ctx, delAddr, valAddrs)
 	return
 }
 
@@ -111,9 +117,12 @@ func (k Keeper) AddSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, v
 func (k Keeper) WithdrawLastShares(ctx sdk.Context, delAddr sdk.AccAddress, lastValsAddedSharesTo types.Validators,
 	lastShares types.Shares) {
 	lenLastVals := len(lastValsAddedSharesTo)
+	valAddrs := getValAddrs(lastValsAddedSharesTo)
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
 	for i := 0; i < lenLastVals; i++ {
 		k.withdrawShares(ctx, delAddr, lastValsAddedSharesTo[i], lastShares)
 	}
+	k.AfterDelegationModified(ctx, delAddr, valAddrs)
 }
 
 func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
@@ -187,3 +196,12 @@ func (k Keeper) GetValidatorsToAddShares(ctx sdk.Context, valAddrs []sdk.ValAddr
 
 	return vals, nil
 }
+
+// getValAddrs gets the operator addresses of the validators
+func getValAddrs(vals types.Validators) []sdk.ValAddress {
+	valAddrs := make([]sdk.ValAddress, len(vals))
+	for i := range vals {
+		valAddrs[i] = vals[i].OperatorAddress
+	}
+	return valAddrs
+}
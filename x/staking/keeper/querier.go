@@ -40,6 +40,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxy(ctx, req, k)
 		case types.QueryDelegator:
 			return queryDelegator(ctx, req, k)
+		case types.QuerySlashes:
+			return querySlashes(ctx, req, k)
+		case types.QueryPendingRebalance:
+			return queryPendingRebalance(ctx, req, k)
+		case types.QueryPendingRebalances:
+			return queryPendingRebalances(ctx, k)
 		default:
 			return nil, types.ErrUnknownStakingQueryType()
 		}
@@ -186,6 +192,55 @@ func queryValidatorAllShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) (
 	return resp, nil
 }
 
+func querySlashes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	records := k.GetSlashRecords(ctx, params.ValidatorAddr)
+	if records == nil {
+		records = types.SlashRecords{}
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPendingRebalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	pending, found := k.GetPendingRebalance(ctx, params.DelegatorAddr)
+	if !found {
+		return nil, types.ErrNoPendingRebalance(params.DelegatorAddr.String())
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pending)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPendingRebalances(ctx sdk.Context, k Keeper) ([]byte, error) {
+	pendings := k.GetPendingRebalances(ctx)
+	if pendings == nil {
+		pendings = types.PendingRebalances{}
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pendings)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return res, nil
+}
+
 func queryUndelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
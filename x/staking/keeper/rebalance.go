@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+// RebalanceShares moves the shares of a delegator from a part of the validators it added shares to onto other
+// validators, while the shares on the rest of the validators are kept. The delegator isn't allowed to rebalance
+// again until the cool-down completes
+func (k Keeper) RebalanceShares(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddrs, dstValAddrs []sdk.ValAddress) (
+	types.PendingRebalance, error) {
+	// 0. check whether the delegator has added shares
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found || delegator.Tokens.IsZero() {
+		return types.PendingRebalance{}, types.ErrNoDelegationToAddShares(delAddr.String())
+	}
+	if delegator.HasProxy() {
+		return types.PendingRebalance{}, types.ErrAddSharesDuringProxy(delAddr.String(),
+			delegator.ProxyAddress.String())
+	}
+	if len(delegator.ValidatorAddresses) == 0 {
+		return types.PendingRebalance{}, types.ErrNoDelegationToAddShares(delAddr.String())
+	}
+
+	// 1. check the cool-down of the last rebalance
+	if pending, found := k.GetPendingRebalance(ctx, delAddr); found && pending.CompletionTime.After(ctx.BlockTime()) {
+		return types.PendingRebalance{}, types.ErrRebalanceInCooldown(delAddr.String(),
+			pending.CompletionTime.Format(time.RFC3339))
+	}
+
+	// 2. the source validators must be added shares to by the delegator while the destination ones mustn't
+	for _, srcValAddr := range srcValAddrs {
+		if !containsValAddr(delegator.ValidatorAddresses, srcValAddr) {
+			return types.PendingRebalance{}, types.ErrNotAddedSharesTo(delAddr.String(), srcValAddr.String())
+		}
+	}
+	for _, dstValAddr := range dstValAddrs {
+		if containsValAddr(delegator.ValidatorAddresses, dstValAddr) {
+			return types.PendingRebalance{}, types.ErrAlreadyAddedSharesTo(delAddr.String(), dstValAddr.String())
+		}
+	}
+	keptValAddrs := make([]sdk.ValAddress, 0, len(delegator.ValidatorAddresses))
+	for _, valAddr := range delegator.ValidatorAddresses {
+		if !containsValAddr(srcValAddrs, valAddr) {
+			keptValAddrs = append(keptValAddrs, valAddr)
+		}
+	}
+	maxValsToAddShares := int(k.ParamsMaxValsToAddShares(ctx))
+	if len(keptValAddrs)+len(dstValAddrs) > maxValsToAddShares {
+		return types.PendingRebalance{}, types.ErrExceedValidatorAddrs(maxValsToAddShares)
+	}
+
+	// 3. get the destination validators (if the validator doesn't exist or is dismissed, return error)
+	dstVals, err := k.GetValidatorsToAddShares(ctx, dstValAddrs)
+	if err != nil {
+		return types.PendingRebalance{}, err
+	}
+	for _, val := range dstVals {
+		if val.MinSelfDelegation.IsZero() {
+			return types.PendingRebalance{}, types.ErrAddSharesToDismission(val.OperatorAddress.String())
+		}
+	}
+
+	// 4. move the shares from the source validators that still exist to the destination validators
+	var srcVals types.Validators
+	for _, srcValAddr := range srcValAddrs {
+		if val, found := k.GetValidator(ctx, srcValAddr); found {
+			srcVals = append(srcVals, val)
+		}
+	}
+	valAddrs := append(getValAddrs(srcVals), dstValAddrs...)
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
+	for _, val := range srcVals {
+		k.withdrawShares(ctx, delAddr, val, delegator.Shares)
+	}
+	for _, val := range dstVals {
+		k.addShares(ctx, delAddr, val, delegator.Shares)
+	}
+	k.AfterDelegationModified(ctx, delAddr, valAddrs)
+
+	// 5. update the delegator entity, start the cool-down and keep the rebalance for the unbonding time to slash
+	// the delegator for the infractions committed before it
+	delegator.ValidatorAddresses = append(keptValAddrs, dstValAddrs...)
+	k.SetDelegator(ctx, delegator)
+
+	completionTime := ctx.BlockTime().Add(k.ParamsRebalanceCooldown(ctx))
+	pending := types.NewPendingRebalance(delAddr, srcValAddrs, dstValAddrs, delegator.Shares, ctx.BlockHeight(),
+		completionTime)
+	if lastPending, found := k.GetPendingRebalance(ctx, delAddr); found {
+		k.DeleteRebalanceQueueTimeKey(ctx, lastPending.GetReleaseTime(), delAddr)
+		pending.Entries = lastPending.Entries
+	}
+	pending.AddEntry(types.NewRebalanceEntry(srcValAddrs, dstValAddrs, ctx.BlockHeight(),
+		ctx.BlockTime().Add(k.UnbondingTime(ctx))), ctx.BlockTime())
+	k.SetPendingRebalance(ctx, pending)
+	k.SetRebalanceQueueTimeKey(ctx, pending.GetReleaseTime(), delAddr)
+
+	return pending, nil
+}
+
+// GetPendingRebalance gets the pending rebalance of a delegator
+func (k Keeper) GetPendingRebalance(ctx sdk.Context, delAddr sdk.AccAddress) (pending types.PendingRebalance,
+	found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRebalanceKey(delAddr))
+	if bz == nil {
+		return pending, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pending)
+	return pending, true
+}
+
+// SetPendingRebalance sets the pending rebalance of a delegator
+func (k Keeper) SetPendingRebalance(ctx sdk.Context, pending types.PendingRebalance) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pending)
+	ctx.KVStore(k.storeKey).Set(types.GetRebalanceKey(pending.DelegatorAddress), bz)
+}
+
+// DeletePendingRebalance deletes the pending rebalance of a delegator
+func (k Keeper) DeletePendingRebalance(ctx sdk.Context, delAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetRebalanceKey(delAddr))
+}
+
+// IteratePendingRebalances iterates through all the pending rebalances
+func (k Keeper) IteratePendingRebalances(ctx sdk.Context,
+	fn func(index int64, pending types.PendingRebalance) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RebalanceKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var pending types.PendingRebalance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pending)
+		if stop := fn(i, pending); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetPendingRebalances returns all the pending rebalances
+func (k Keeper) GetPendingRebalances(ctx sdk.Context) (pendings types.PendingRebalances) {
+	k.IteratePendingRebalances(ctx, func(_ int64, pending types.PendingRebalance) (stop bool) {
+		pendings = append(pendings, pending)
+		return false
+	})
+	return
+}
+
+// SetRebalanceQueueTimeKey sets the time+delAddr key of the rebalance queue into store with an empty value
+func (k Keeper) SetRebalanceQueueTimeKey(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetRebalanceTimeWithAddrKey(timestamp, delAddr), []byte{})
+}
+
+// DeleteRebalanceQueueTimeKey deletes the time+delAddr key of the rebalance queue from store
+func (k Keeper) DeleteRebalanceQueueTimeKey(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetRebalanceTimeWithAddrKey(timestamp, delAddr))
+}
+
+// CompleteMatureRebalances removes the pending rebalances whose cool-down and entries have all completed by the
+// current time and returns the addresses of the delegators
+func (k Keeper) CompleteMatureRebalances(ctx sdk.Context, currentTime time.Time) (delAddrs []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RebalanceQueueKey, sdk.PrefixEndBytes(types.GetRebalanceTimeKey(currentTime)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		_, delAddr := types.SplitCompleteTimeWithAddrKey(key)
+		store.Delete(key)
+		k.DeletePendingRebalance(ctx, delAddr)
+		delAddrs = append(delAddrs, delAddr)
+	}
+	return
+}
+
+// containsValAddr tells whether the validator address is among the addresses
+func containsValAddr(valAddrs []sdk.ValAddress, valAddr sdk.ValAddress) bool {
+	for _, addr := range valAddrs {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+func TestRebalanceShares(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr1, vAddr2, vAddr3 := sdk.ValAddress(Addrs[1]), sdk.ValAddress(Addrs[2]), sdk.ValAddress(Addrs[3])
+	bondDenom := k.BondDenom(ctx)
+
+	// create validators
+	for i, valAddr := range []sdk.ValAddress{vAddr1, vAddr2, vAddr3} {
+		validator := types.NewValidator(valAddr, PKs[i+1], types.Description{}, types.DefaultMinSelfDelegation)
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByConsAddr(ctx, validator)
+		k.SetNewValidatorByPowerIndex(ctx, validator)
+		err := k.AddSharesAsMinSelfDelegation(ctx, sdk.AccAddress(valAddr), &validator,
+			sdk.NewDecCoinFromDec(bondDenom, validator.MinSelfDelegation))
+		require.Nil(t, err)
+	}
+
+	// rebalancing without any shares added fails
+	_, err := k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr1}, []sdk.ValAddress{vAddr3})
+	require.NotNil(t, err)
+
+	// deposit and add shares to the validator 1 and 2
+	err = k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr1, vAddr2})
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegator.Tokens)
+	require.Nil(t, err)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr1, vAddr2}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// the source must be added shares to and the destination mustn't
+	_, err = k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr3}, []sdk.ValAddress{vAddr1})
+	require.NotNil(t, err)
+	_, err = k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr1}, []sdk.ValAddress{vAddr2})
+	require.NotNil(t, err)
+
+	// move the shares from the validator 1 to the validator 3 and keep the ones on the validator 2
+	val3, found := k.GetValidator(ctx, vAddr3)
+	require.True(t, found)
+	pending, err := k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr1}, []sdk.ValAddress{vAddr3})
+	require.Nil(t, err)
+	require.Equal(t, shares, pending.Shares)
+	require.Equal(t, ctx.BlockTime().Add(k.ParamsRebalanceCooldown(ctx)), pending.CompletionTime)
+
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, []sdk.ValAddress{vAddr2, vAddr3}, delegator.ValidatorAddresses)
+	require.Equal(t, shares, delegator.Shares)
+	_, found = k.GetShares(ctx, dAddr, vAddr1)
+	require.False(t, found)
+	for _, valAddr := range delegator.ValidatorAddresses {
+		valShares, found := k.GetShares(ctx, dAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, shares, valShares)
+	}
+	newVal3, found := k.GetValidator(ctx, vAddr3)
+	require.True(t, found)
+	require.Equal(t, val3.DelegatorShares.Add(shares), newVal3.DelegatorShares)
+
+	for _, invariant := range []func(Keeper) sdk.Invariant{
+		DelegatorAddSharesInvariant, PositiveDelegatorInvariant, ModuleAccountInvariantsCustom,
+	} {
+		_, broken := invariant(k)(ctx)
+		require.False(t, broken)
+	}
+
+	// rebalancing again during the cool-down fails
+	_, err = k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr3}, []sdk.ValAddress{vAddr1})
+	require.NotNil(t, err)
+
+	// query the pending rebalances
+	querier := NewQuerier(k)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryDelegatorParams(dAddr))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QueryPendingRebalance}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var queried types.PendingRebalance
+	types.ModuleCdc.MustUnmarshalJSON(res, &queried)
+	require.Equal(t, dAddr, queried.DelegatorAddress)
+	require.Equal(t, []sdk.ValAddress{vAddr3}, queried.DstValidatorAddresses)
+
+	res, err = querier(ctx, []string{types.QueryPendingRebalances}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var queriedAll types.PendingRebalances
+	types.ModuleCdc.MustUnmarshalJSON(res, &queriedAll)
+	require.Equal(t, 1, len(queriedAll))
+
+	// the rebalance is kept for slashing after the cool-down completes
+	require.Equal(t, 1, len(pending.Entries))
+	require.Equal(t, ctx.BlockTime().Add(k.UnbondingTime(ctx)), pending.GetReleaseTime())
+	ctx = ctx.WithBlockTime(pending.CompletionTime.Add(time.Second))
+	require.Nil(t, k.CompleteMatureRebalances(ctx, ctx.BlockTime()))
+	_, err = querier(ctx, []string{types.QueryPendingRebalance}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+
+	// rebalancing again after the cool-down keeps both of the rebalances
+	pending, err = k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr3}, []sdk.ValAddress{vAddr1})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(pending.Entries))
+	require.Equal(t, []sdk.ValAddress{vAddr2, vAddr1},
+		pending.GetValidatorsBefore([]sdk.ValAddress{vAddr2, vAddr1}, vAddr1, 0))
+
+	// the pending rebalance is removed after the unbonding time of the latest rebalance
+	ctx = ctx.WithBlockTime(pending.GetReleaseTime().Add(time.Second))
+	require.Equal(t, []sdk.AccAddress{dAddr}, k.CompleteMatureRebalances(ctx, ctx.BlockTime()))
+	_, found = k.GetPendingRebalance(ctx, dAddr)
+	require.False(t, found)
+	_, err = querier(ctx, []string{types.QueryPendingRebalance}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+}
@@ -4,10 +4,228 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
 )
 
+// Slash slashes the stake on a validator proportionally by the slashFactor for an infraction at infractionHeight.
+// The msd of the validator, the tokens of the delegators who added shares to it and the undelegations withdrawn from it
+// since the infraction are slashed. The tokens of a delegator are shared equally by the validators it added shares to,
+// so only the part on the slashed validator is slashed. The delegators who rebalanced their shares away from the
+// validator since the infraction are slashed as if they hadn't. The slashed tokens are burned from the pools.
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+	logger := k.Logger(ctx)
+
+	if slashFactor.IsNegative() || slashFactor.GT(sdk.OneDec()) {
+		panic(fmt.Errorf("attempted to slash with an invalid slash factor: %s", slashFactor))
+	}
+	// the infraction can't be in the future
+	if infractionHeight > ctx.BlockHeight() {
+		panic(fmt.Sprintf("impossible attempt to slash future infraction at height %d but we are at height %d",
+			infractionHeight, ctx.BlockHeight()))
+	}
+	if !slashFactor.IsPositive() {
+		return
+	}
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		// the validator might have been removed after the infraction
+		logger.Error(fmt.Sprintf("WARNING: ignored attempt to slash a nonexistent validator with address %s", consAddr))
+		return
+	}
+	valAddr := validator.OperatorAddress
+
+	// 1.slash the msd of the validator
+	burnedBonded := validator.MinSelfDelegation.Mul(slashFactor)
+	validator.MinSelfDelegation = validator.MinSelfDelegation.Sub(burnedBonded)
+	k.SetValidator(ctx, validator)
+
+	// 2.slash the delegators who added shares to the validator
+	for _, sharesResp := range k.GetValidatorAllShares(ctx, valAddr) {
+		burnedBonded = burnedBonded.Add(k.slashDelegator(ctx, sharesResp.DelAddr, slashFactor))
+	}
+
+	// 3.slash the delegators who rebalanced their shares away from the validator since the infraction
+	burnedBonded = burnedBonded.Add(k.slashRebalancedDelegators(ctx, valAddr, infractionHeight, slashFactor))
+
+	// 4.slash the undelegations withdrawn from the validator since the infraction
+	burnedUnbonding := k.slashUndelegations(ctx, valAddr, infractionHeight, slashFactor)
+
+	// 5.burn the slashed tokens
+	bondDenom := k.BondDenom(ctx)
+	k.burnFromPool(ctx, types.BondedPoolName, sdk.NewDecCoinFromDec(bondDenom, burnedBonded))
+	k.burnFromPool(ctx, types.NotBondedPoolName, sdk.NewDecCoinFromDec(bondDenom, burnedUnbonding))
+
+	// 6.record the slash
+	record := types.NewSlashRecord(valAddr, ctx.BlockHeight(), infractionHeight, power, slashFactor, burnedBonded,
+		burnedUnbonding)
+	k.AppendSlashRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeySlashFactor, slashFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, record.Burned().String()),
+		),
+	)
+
+	logger.Info(fmt.Sprintf("validator %s slashed by slash factor of %s, %s burned from bonded pool and %s burned "+
+		"from not bonded pool", valAddr, slashFactor, burnedBonded, burnedUnbonding))
+}
+
+// slashDelegator slashes the part of tokens on one validator of a delegator and returns the amount of slashed tokens.
+// The tokens delegated to a proxy are slashed too, and the shares are reduced by the same fraction.
+func (k Keeper) slashDelegator(ctx sdk.Context, delAddr sdk.AccAddress, slashFactor sdk.Dec) sdk.Dec {
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found || len(delegator.ValidatorAddresses) == 0 {
+		return sdk.ZeroDec()
+	}
+	return k.slashDelegatorByFraction(ctx, delegator, slashFactor.QuoInt64(int64(len(delegator.ValidatorAddresses))))
+}
+
+// slashDelegatorByFraction slashes the fraction of tokens of a delegator and returns the amount of slashed tokens
+func (k Keeper) slashDelegatorByFraction(ctx sdk.Context, delegator types.Delegator, fraction sdk.Dec) sdk.Dec {
+	delAddr := delegator.DelegatorAddress
+
+	// 1.slash the tokens of the delegator
+	slashed := delegator.Tokens.Mul(fraction)
+	delegator.Tokens = delegator.Tokens.Sub(slashed)
+
+	// 2.slash the tokens of the delegators who bound to the proxy
+	if delegator.IsProxy {
+		slashedDelegated := sdk.ZeroDec()
+		k.IterateProxy(ctx, delAddr, false, func(_ int64, boundAddr, _ sdk.AccAddress) (stop bool) {
+			boundDelegator, found := k.GetDelegator(ctx, boundAddr)
+			if found {
+				boundSlashed := boundDelegator.Tokens.Mul(fraction)
+				boundDelegator.Tokens = boundDelegator.Tokens.Sub(boundSlashed)
+				k.SetDelegator(ctx, boundDelegator)
+				slashedDelegated = slashedDelegated.Add(boundSlashed)
+			}
+			return false
+		})
+		delegator.TotalDelegatedTokens = delegator.TotalDelegatedTokens.Sub(slashedDelegated)
+		slashed = slashed.Add(slashedDelegated)
+	}
+
+	// 3.reduce the shares on all the validators, the shares aren't recalculated by the weight of the current time
+	// because the weight grows over time
+	vals, lastShares := k.GetLastValsAddedSharesExisted(ctx, delAddr)
+	shares := lastShares.Mul(sdk.OneDec().Sub(fraction))
+	valAddrs := getValAddrs(vals)
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
+	for i := 0; i < len(vals); i++ {
+		k.DeleteValidatorByPowerIndex(ctx, vals[i])
+		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, shares)
+		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares).Add(shares)
+		k.SetValidator(ctx, vals[i])
+		k.SetValidatorByPowerIndex(ctx, vals[i])
+	}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+	k.AfterDelegationModified(ctx, delAddr, valAddrs)
+
+	return slashed
+}
+
+// slashRebalancedDelegators slashes the delegators whose rebalances kept moved the shares away from the validator at
+// or after the infraction height, by the part of tokens on the validator before the rebalance, and returns the amount
+// of slashed tokens
+func (k Keeper) slashRebalancedDelegators(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64,
+	slashFactor sdk.Dec) sdk.Dec {
+	pendings := k.GetPendingRebalances(ctx)
+
+	slashed := sdk.ZeroDec()
+	for _, pending := range pendings {
+		delegator, found := k.GetDelegator(ctx, pending.DelegatorAddress)
+		// the delegator who added shares to the validator again has been slashed along with the others
+		if !found || containsValAddr(delegator.ValidatorAddresses, valAddr) {
+			continue
+		}
+		// the tokens were shared by the validators before the rebalance
+		valAddrs := pending.GetValidatorsBefore(delegator.ValidatorAddresses, valAddr, infractionHeight)
+		if len(valAddrs) == 0 {
+			continue
+		}
+		slashed = slashed.Add(k.slashDelegatorByFraction(ctx, delegator, slashFactor.QuoInt64(int64(len(valAddrs)))))
+	}
+
+	return slashed
+}
+
+// slashUndelegations slashes the withdrawings in flight which were withdrawn from the validator at or after
+// the infraction height and returns the amount of slashed tokens
+func (k Keeper) slashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64,
+	slashFactor sdk.Dec) sdk.Dec {
+	var undelegations []types.UndelegationInfo
+	k.IterateUndelegationInfo(ctx, func(_ int64, undelegation types.UndelegationInfo) (stop bool) {
+		if undelegation.CreationHeight >= infractionHeight && undelegation.HasValidatorAddress(valAddr) {
+			undelegations = append(undelegations, undelegation)
+		}
+		return false
+	})
+
+	slashed := sdk.ZeroDec()
+	for _, undelegation := range undelegations {
+		// the undelegation merged before the entries are recorded is slashed as a whole
+		if len(undelegation.Entries) == 0 {
+			undelegation.Entries = []types.UndelegationEntry{{
+				CreationHeight:     undelegation.CreationHeight,
+				Quantity:           undelegation.Quantity,
+				ValidatorAddresses: undelegation.ValidatorAddresses,
+			}}
+		}
+		for i, entry := range undelegation.Entries {
+			if entry.CreationHeight < infractionHeight || !entry.HasValidatorAddress(valAddr) {
+				continue
+			}
+			// the withdrawn tokens were shared equally by the validators too
+			amount := entry.Quantity.Mul(slashFactor).QuoInt64(int64(len(entry.ValidatorAddresses)))
+			undelegation.Entries[i].Quantity = entry.Quantity.Sub(amount)
+			undelegation.Quantity = undelegation.Quantity.Sub(amount)
+			slashed = slashed.Add(amount)
+		}
+		k.SetUndelegating(ctx, undelegation)
+	}
+
+	return slashed
+}
+
+// burnFromPool burns the slashed tokens from the bonded or not bonded pool
+func (k Keeper) burnFromPool(ctx sdk.Context, poolName string, token sdk.SysCoin) {
+	if !token.IsPositive() {
+		return
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, poolName, token.ToCoins()); err != nil {
+		panic(err)
+	}
+}
+
+// AppendSlashRecord saves a slash record into the slashing history of the validator
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(types.SlashRecordSeqKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &seq)
+	}
+	seq++
+	store.Set(types.SlashRecordSeqKey, k.cdc.MustMarshalBinaryBare(seq))
+	store.Set(types.GetSlashRecordKey(record.ValidatorAddress, seq), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+// GetSlashRecords returns the slashing history of a validator, or of all the validators if the address is empty
+func (k Keeper) GetSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) (records types.SlashRecords) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetSlashRecordsKey(valAddr))
+	defer iterator.Close()
 
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
 }
 
 // Jail sents a validator to jail
@@ -1 +1,239 @@
 package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+func TestSlash(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr1, vAddr2 := sdk.ValAddress(Addrs[1]), sdk.ValAddress(Addrs[2])
+	bondDenom := k.BondDenom(ctx)
+
+	// create validators
+	for i, valAddr := range []sdk.ValAddress{vAddr1, vAddr2} {
+		validator := types.NewValidator(valAddr, PKs[i+1], types.Description{}, types.DefaultMinSelfDelegation)
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByConsAddr(ctx, validator)
+		k.SetNewValidatorByPowerIndex(ctx, validator)
+		err := k.AddSharesAsMinSelfDelegation(ctx, sdk.AccAddress(valAddr), &validator,
+			sdk.NewDecCoinFromDec(bondDenom, validator.MinSelfDelegation))
+		require.Nil(t, err)
+	}
+
+	// deposit and add shares to both of the validators
+	err := k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr1, vAddr2})
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegator.Tokens)
+	require.Nil(t, err)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr1, vAddr2}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// withdraw at height 10
+	ctx = ctx.WithBlockHeight(10)
+	_, err = k.Withdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100)))
+	require.Nil(t, err)
+	undelegation, found := k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, int64(10), undelegation.CreationHeight)
+	require.Equal(t, []sdk.ValAddress{vAddr1, vAddr2}, undelegation.ValidatorAddresses)
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	lastShares := delegator.Shares
+
+	// slash the validator 1 for an infraction before the withdrawing
+	ctx = ctx.WithBlockHeight(12)
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 5, 100, sdk.NewDecWithPrec(1, 1))
+
+	validator, found := k.GetValidator(ctx, vAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(9000), validator.MinSelfDelegation)
+	// the tokens of the delegator are shared by two validators
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(855), delegator.Tokens)
+	require.Equal(t, lastShares.Mul(sdk.NewDecWithPrec(95, 2)), delegator.Shares)
+	for _, valAddr := range delegator.ValidatorAddresses {
+		valShares, found := k.GetShares(ctx, dAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, delegator.Shares, valShares)
+	}
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(95), undelegation.Quantity)
+
+	// the slashed tokens are burned from the pools
+	require.Equal(t, sdk.NewDec(19855), k.GetBondedPool(ctx).GetCoins().AmountOf(bondDenom))
+	require.Equal(t, sdk.NewDec(95), k.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom))
+
+	// slash the validator 2 for an infraction after the withdrawing, the undelegation isn't slashed
+	k.Slash(ctx, sdk.ConsAddress(PKs[2].Address()), 11, 100, sdk.NewDecWithPrec(2, 1))
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(95), undelegation.Quantity)
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(7695, 1), delegator.Tokens)
+
+	for _, invariant := range []func(Keeper) sdk.Invariant{
+		DelegatorAddSharesInvariant, PositiveDelegatorInvariant, ModuleAccountInvariantsCustom,
+	} {
+		_, broken := invariant(k)(ctx)
+		require.False(t, broken)
+	}
+
+	// query the slashing history
+	require.Equal(t, 2, len(k.GetSlashRecords(ctx, nil)))
+	records := k.GetSlashRecords(ctx, vAddr1)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, int64(5), records[0].InfractionHeight)
+	require.Equal(t, sdk.NewDec(1045), records[0].BurnedBonded)
+	require.Equal(t, sdk.NewDec(5), records[0].BurnedUnbonding)
+
+	querier := NewQuerier(k)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryValidatorParams(vAddr2))
+	require.Nil(t, err)
+	res, err := querier(ctx, []string{types.QuerySlashes}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var queriedRecords types.SlashRecords
+	types.ModuleCdc.MustUnmarshalJSON(res, &queriedRecords)
+	require.Equal(t, 1, len(queriedRecords))
+	require.Equal(t, vAddr2, queriedRecords[0].ValidatorAddress)
+}
+
+func TestSlashRebalancedDelegator(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr1, vAddr2, vAddr3 := sdk.ValAddress(Addrs[1]), sdk.ValAddress(Addrs[2]), sdk.ValAddress(Addrs[3])
+	bondDenom := k.BondDenom(ctx)
+
+	// create validators
+	for i, valAddr := range []sdk.ValAddress{vAddr1, vAddr2, vAddr3} {
+		validator := types.NewValidator(valAddr, PKs[i+1], types.Description{}, types.DefaultMinSelfDelegation)
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByConsAddr(ctx, validator)
+		k.SetNewValidatorByPowerIndex(ctx, validator)
+		err := k.AddSharesAsMinSelfDelegation(ctx, sdk.AccAddress(valAddr), &validator,
+			sdk.NewDecCoinFromDec(bondDenom, validator.MinSelfDelegation))
+		require.Nil(t, err)
+	}
+
+	// deposit and add shares to the validator 1 and 2
+	err := k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr1, vAddr2})
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegator.Tokens)
+	require.Nil(t, err)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr1, vAddr2}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// move the shares from the validator 1 to the validator 3 at height 10
+	ctx = ctx.WithBlockHeight(10)
+	_, err = k.RebalanceShares(ctx, dAddr, []sdk.ValAddress{vAddr1}, []sdk.ValAddress{vAddr3})
+	require.Nil(t, err)
+
+	// slash the validator 1 for an infraction after the rebalance, the delegator isn't slashed
+	ctx = ctx.WithBlockHeight(12)
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 11, 100, sdk.NewDecWithPrec(1, 1))
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000), delegator.Tokens)
+
+	// slash the validator 1 for an infraction before the rebalance, the part of tokens on it is slashed
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 5, 100, sdk.NewDecWithPrec(1, 1))
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(950), delegator.Tokens)
+	require.Equal(t, shares.Mul(sdk.NewDecWithPrec(95, 2)), delegator.Shares)
+	for _, valAddr := range delegator.ValidatorAddresses {
+		valShares, found := k.GetShares(ctx, dAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, delegator.Shares, valShares)
+	}
+	records := k.GetSlashRecords(ctx, vAddr1)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, sdk.NewDec(950), records[1].BurnedBonded)
+
+	// the delegator is still slashed after the cool-down completes
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(k.ParamsRebalanceCooldown(ctx) + time.Second))
+	k.CompleteMatureRebalances(ctx, ctx.BlockTime())
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 5, 100, sdk.NewDecWithPrec(1, 1))
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(9025, 1), delegator.Tokens)
+
+	for _, invariant := range []func(Keeper) sdk.Invariant{
+		DelegatorAddSharesInvariant, PositiveDelegatorInvariant, ModuleAccountInvariantsCustom,
+	} {
+		_, broken := invariant(k)(ctx)
+		require.False(t, broken)
+	}
+}
+
+func TestSlashUndelegationEntries(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr := sdk.ValAddress(Addrs[1])
+	bondDenom := k.BondDenom(ctx)
+
+	validator := types.NewValidator(vAddr, PKs[1], types.Description{}, types.DefaultMinSelfDelegation)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	err := k.AddSharesAsMinSelfDelegation(ctx, sdk.AccAddress(vAddr), &validator,
+		sdk.NewDecCoinFromDec(bondDenom, validator.MinSelfDelegation))
+	require.Nil(t, err)
+
+	// deposit and add shares to the validator
+	err = k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr})
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegator.Tokens)
+	require.Nil(t, err)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// withdraw at height 10 and 20, which are merged into one undelegation
+	for _, height := range []int64{10, 20} {
+		ctx = ctx.WithBlockHeight(height)
+		_, err = k.Withdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100)))
+		require.Nil(t, err)
+	}
+	undelegation, found := k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(200), undelegation.Quantity)
+	require.Equal(t, 2, len(undelegation.Entries))
+
+	// slash the validator for an infraction between the withdrawings, only the later one is slashed
+	ctx = ctx.WithBlockHeight(22)
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 15, 100, sdk.NewDecWithPrec(1, 1))
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(190), undelegation.Quantity)
+	require.Equal(t, sdk.NewDec(100), undelegation.Entries[0].Quantity)
+	require.Equal(t, sdk.NewDec(90), undelegation.Entries[1].Quantity)
+	require.Equal(t, sdk.NewDec(190), k.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom))
+}
@@ -88,8 +88,10 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(types.MsgCreateValidator{}, "test/staking/CreateValidator", nil)
 	cdc.RegisterConcrete(types.MsgDestroyValidator{}, "test/staking/DestroyValidator", nil)
 	cdc.RegisterConcrete(types.MsgEditValidator{}, "test/staking/EditValidator", nil)
+	cdc.RegisterConcrete(types.MsgEditValidatorCommissionRate{}, "test/staking/EditValidatorCommissionRate", nil)
 	cdc.RegisterConcrete(types.MsgWithdraw{}, "test/staking/MsgWithdraw", nil)
 	cdc.RegisterConcrete(types.MsgAddShares{}, "test/staking/MsgAddShares", nil)
+	cdc.RegisterConcrete(types.MsgRebalanceShares{}, "test/staking/MsgRebalanceShares", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
@@ -316,3 +318,7 @@ func (dk mockDistributionKeeper) AfterValidatorBeginUnbonding(ctx sdk.Context, c
 }
 func (dk mockDistributionKeeper) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 }
+func (dk mockDistributionKeeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) {
+}
+func (dk mockDistributionKeeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) {
+}
@@ -13,6 +13,30 @@ type UndelegationInfo struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	Quantity         sdk.Dec        `json:"quantity" yaml:"quantity"`
 	CompletionTime   time.Time      `json:"completion_time"`
+	// the height of the latest withdrawing and the validators that the withdrawn tokens were added shares to,
+	// which are used to slash the undelegation for the infractions committed before it
+	CreationHeight     int64            `json:"creation_height,omitempty" yaml:"creation_height"`
+	ValidatorAddresses []sdk.ValAddress `json:"validator_addresses,omitempty" yaml:"validator_addresses"`
+	// the withdrawings merged into the undelegation, each of which is slashed only for the infractions committed
+	// before it
+	Entries []UndelegationEntry `json:"entries,omitempty" yaml:"entries"`
+}
+
+// UndelegationEntry is the record of a single withdrawing merged into the undelegation
+type UndelegationEntry struct {
+	CreationHeight     int64            `json:"creation_height" yaml:"creation_height"`
+	Quantity           sdk.Dec          `json:"quantity" yaml:"quantity"`
+	ValidatorAddresses []sdk.ValAddress `json:"validator_addresses" yaml:"validator_addresses"`
+}
+
+// HasValidatorAddress returns whether the withdrawn tokens of the entry were added shares to the validator
+func (entry UndelegationEntry) HasValidatorAddress(valAddr sdk.ValAddress) bool {
+	for _, addr := range entry.ValidatorAddresses {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
 }
 
 // NewUndelegationInfo creates a new delegation object
@@ -24,6 +48,45 @@ func NewUndelegationInfo(delegatorAddr sdk.AccAddress, sharesQuantity Shares, co
 	}
 }
 
+// AddEntry merges a withdrawing into the undelegation. The undelegation merged before the entries are recorded is
+// kept as the first entry
+func (ud *UndelegationInfo) AddEntry(creationHeight int64, quantity sdk.Dec, valAddrs []sdk.ValAddress) {
+	if len(ud.Entries) == 0 && ud.Quantity.IsPositive() {
+		ud.Entries = append(ud.Entries, UndelegationEntry{
+			CreationHeight:     ud.CreationHeight,
+			Quantity:           ud.Quantity,
+			ValidatorAddresses: ud.ValidatorAddresses,
+		})
+	}
+	ud.Entries = append(ud.Entries, UndelegationEntry{
+		CreationHeight:     creationHeight,
+		Quantity:           quantity,
+		ValidatorAddresses: valAddrs,
+	})
+	ud.Quantity = ud.Quantity.Add(quantity)
+	ud.CreationHeight = creationHeight
+	ud.AddValidatorAddresses(valAddrs)
+}
+
+// AddValidatorAddresses adds the validator addresses that don't exist in the undelegation yet
+func (ud *UndelegationInfo) AddValidatorAddresses(valAddrs []sdk.ValAddress) {
+	for _, valAddr := range valAddrs {
+		if !ud.HasValidatorAddress(valAddr) {
+			ud.ValidatorAddresses = append(ud.ValidatorAddresses, valAddr)
+		}
+	}
+}
+
+// HasValidatorAddress returns whether the withdrawn tokens of the undelegation were added shares to the validator
+func (ud UndelegationInfo) HasValidatorAddress(valAddr sdk.ValAddress) bool {
+	for _, addr := range ud.ValidatorAddresses {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
 // MustUnMarshalUndelegationInfo must return the UndelegationInfo object by unmarshaling
 func MustUnMarshalUndelegationInfo(cdc *codec.Codec, value []byte) UndelegationInfo {
 	undelegationInfo, err := UnmarshalUndelegationInfo(cdc, value)
@@ -44,13 +107,17 @@ func (ud UndelegationInfo) String() string {
 	return fmt.Sprintf(`UnDelegation:
   Delegator: %s
   Quantity:    %s
-  CompletionTime:    %s`,
-		ud.DelegatorAddress, ud.Quantity, ud.CompletionTime.Format(time.RFC3339))
+  CompletionTime:    %s
+  CreationHeight:    %d
+  Validators:    %s`,
+		ud.DelegatorAddress, ud.Quantity, ud.CompletionTime.Format(time.RFC3339), ud.CreationHeight,
+		ud.ValidatorAddresses)
 }
 
 // DefaultUndelegation returns default entity for UndelegationInfo
 func DefaultUndelegation() UndelegationInfo {
 	return UndelegationInfo{
-		nil, sdk.ZeroDec(), time.Unix(0, 0).UTC(),
+		Quantity:       sdk.ZeroDec(),
+		CompletionTime: time.Unix(0, 0).UTC(),
 	}
 }
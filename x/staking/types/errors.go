@@ -58,6 +58,10 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeRebalanceInCooldown             uint32 = 67047
+	CodeNotAddedSharesTo                uint32 = 67048
+	CodeAlreadyAddedSharesTo            uint32 = 67049
+	CodeNoPendingRebalance              uint32 = 67050
 )
 
 // ErrNoValidatorFound returns an error when a validator doesn't exist
@@ -312,3 +316,27 @@ func ErrAlreadyBound(delAddr string) sdk.EnvelopedErr {
 		fmt.Sprintf("failed. %s has already bound a proxy. it's necessary to unbind before proxy register",
 			delAddr))}
 }
+
+// ErrRebalanceInCooldown returns an error when a delegator rebalances its shares before the last rebalance completes
+func ErrRebalanceInCooldown(delAddr string, completionTime string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeRebalanceInCooldown,
+		fmt.Sprintf("failed. delegator %s isn't allowed to rebalance shares until %s", delAddr, completionTime))}
+}
+
+// ErrNotAddedSharesTo returns an error when a delegator tries to move the shares from a validator it didn't add to
+func ErrNotAddedSharesTo(delAddr, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotAddedSharesTo,
+		fmt.Sprintf("failed. delegator %s hasn't added shares to validator %s", delAddr, valAddr))}
+}
+
+// ErrAlreadyAddedSharesTo returns an error when a delegator tries to move the shares to a validator it has added to
+func ErrAlreadyAddedSharesTo(delAddr, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAlreadyAddedSharesTo,
+		fmt.Sprintf("failed. delegator %s has already added shares to validator %s", delAddr, valAddr))}
+}
+
+// ErrNoPendingRebalance returns an error when the pending rebalance of a delegator doesn't exist
+func ErrNoPendingRebalance(delAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoPendingRebalance,
+		fmt.Sprintf("failed. delegator %s has no pending rebalance", delAddr))
+}
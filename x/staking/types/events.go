@@ -5,6 +5,7 @@ const (
 	EventTypeCompleteUnbonding = "complete_unbonding"
 	EventTypeCreateValidator   = "create_validator"
 	EventTypeEditValidator     = "edit_validator"
+	EventTypeEditCommission    = "edit_commission"
 	EventTypeDelegate          = "delegate"
 	EventTypeUnbond            = "unbond"
 
@@ -19,4 +20,15 @@ const (
 
 	AttributeKeyValidatorToAddShares = "validator_to_add_shares"
 	AttributeKeyShares              = "shares"
+
+	EventTypeRebalanceShares = "rebalance_shares"
+
+	AttributeKeySrcValidator = "src_validator"
+	AttributeKeyDstValidator = "dst_validator"
+
+	EventTypeSlash = "slash"
+
+	AttributeKeyInfractionHeight = "infraction_height"
+	AttributeKeySlashFactor      = "slash_factor"
+	AttributeKeyBurned           = "burned"
 )
@@ -86,4 +86,8 @@ type StakingHooks interface {
 	// required by okexchain
 	// Must be called when a validator is destroyed by tx
 	AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)
+	// Must be called before the shares of a delegator on the validators are modified
+	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress)
+	// Must be called after the shares of a delegator on the validators are modified
+	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress)
 }
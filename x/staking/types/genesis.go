@@ -18,6 +18,7 @@ type GenesisState struct {
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
 	Exported             bool                        `json:"exported" yaml:"exported"`
+	PendingRebalances    []PendingRebalance          `json:"pending_rebalances,omitempty" yaml:"pending_rebalances"`
 }
 
 // LastValidatorPower is needed for validator set update logic
@@ -55,3 +55,18 @@ func (h MultiStakingHooks) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk
 		h[i].AfterValidatorDestroyed(ctx, consAddr, valAddr)
 	}
 }
+
+// BeforeDelegationSharesModified handles the hooks before the shares of a delegator modified
+func (h MultiStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddrs []sdk.ValAddress) {
+	for i := range h {
+		h[i].BeforeDelegationSharesModified(ctx, delAddr, valAddrs)
+	}
+}
+
+// AfterDelegationModified handles the hooks after the shares of a delegator modified
+func (h MultiStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterDelegationModified(ctx, delAddr, valAddrs)
+	}
+}
@@ -48,10 +48,16 @@ var (
 	UnDelegationInfoKey = []byte{0x53}
 	UnDelegateQueueKey  = []byte{0x54}
 	ProxyKey            = []byte{0x55}
+	RebalanceKey        = []byte{0x56}
+	RebalanceQueueKey   = []byte{0x57}
 
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
 
+	// prefix key for the slash records of validators and the sequence of them
+	SlashRecordKey    = []byte{0x70}
+	SlashRecordSeqKey = []byte{0x71}
+
 	lenTime = len(sdk.FormatTimeBytes(time.Now()))
 )
 
@@ -165,6 +171,34 @@ func SplitCompleteTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress) {
 	return endTime, delAddr
 }
 
+// GetRebalanceKey gets the key for the pending rebalance of a delegator
+func GetRebalanceKey(delAddr sdk.AccAddress) []byte {
+	return append(RebalanceKey, delAddr.Bytes()...)
+}
+
+// GetRebalanceTimeKey gets the prefix of the rebalance queue for the completion time
+func GetRebalanceTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RebalanceQueueKey, bz...)
+}
+
+// GetRebalanceTimeWithAddrKey gets the key of the rebalance queue for the completion time with delegator address
+func GetRebalanceTimeWithAddrKey(timestamp time.Time, delAddr sdk.AccAddress) []byte {
+	return append(GetRebalanceTimeKey(timestamp), delAddr.Bytes()...)
+}
+
+// GetSlashRecordsKey gets the prefix for all the slash records of a validator
+func GetSlashRecordsKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashRecordKey, valAddr.Bytes()...)
+}
+
+// GetSlashRecordKey gets the key for a slash record of a validator with the sequence
+func GetSlashRecordKey(valAddr sdk.ValAddress, seq uint64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, seq)
+	return append(GetSlashRecordsKey(valAddr), seqBytes...)
+}
+
 // Bech32ifyConsPub returns a Bech32 encoded string containing the
 // Bech32PrefixConsPub prefixfor a given consensus node's PubKey.
 func Bech32ifyConsPub(pub crypto.PubKey) (string, error) {
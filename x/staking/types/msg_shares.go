@@ -197,6 +197,53 @@ func (msg MsgAddShares) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgRebalanceShares - struct for moving the shares from a part of the validators to others
+type MsgRebalanceShares struct {
+	DelAddr     sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	SrcValAddrs []sdk.ValAddress `json:"src_validator_addresses" yaml:"src_validator_addresses"`
+	DstValAddrs []sdk.ValAddress `json:"dst_validator_addresses" yaml:"dst_validator_addresses"`
+}
+
+// NewMsgRebalanceShares creates a msg of moving the shares from the source vals to the destination vals
+func NewMsgRebalanceShares(delAddr sdk.AccAddress, srcValAddrs, dstValAddrs []sdk.ValAddress) MsgRebalanceShares {
+	return MsgRebalanceShares{
+		DelAddr:     delAddr,
+		SrcValAddrs: srcValAddrs,
+		DstValAddrs: dstValAddrs,
+	}
+}
+
+// nolint
+func (MsgRebalanceShares) Route() string { return RouterKey }
+func (MsgRebalanceShares) Type() string  { return "rebalance_shares" }
+func (msg MsgRebalanceShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelAddr}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgRebalanceShares) ValidateBasic() error {
+	if msg.DelAddr.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+
+	if len(msg.SrcValAddrs) == 0 || len(msg.DstValAddrs) == 0 {
+		return ErrBadValidatorAddr()
+	}
+
+	// a validator can't be both the source and the destination
+	if isValsDuplicate(append(append([]sdk.ValAddress{}, msg.SrcValAddrs...), msg.DstValAddrs...)) {
+		return ErrTargetValsDuplicate()
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgRebalanceShares) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
 func isValsDuplicate(valAddrs []sdk.ValAddress) bool {
 	lenAddrs := len(valAddrs)
 	filter := make(map[string]struct{}, lenAddrs)
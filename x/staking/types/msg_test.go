@@ -129,6 +129,34 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgEditValidatorCommissionRate
+func TestMsgEditValidatorCommissionRate(t *testing.T) {
+	tests := []struct {
+		name          string
+		rate          sdk.Dec
+		validatorAddr sdk.ValAddress
+		expectPass    bool
+	}{
+		{"basic good", sdk.NewDecWithPrec(5, 1), valAddr1, true},
+		{"zero rate", sdk.ZeroDec(), valAddr1, true},
+		{"full rate", sdk.OneDec(), valAddr1, true},
+		{"nil rate", sdk.Dec{}, valAddr1, false},
+		{"negative rate", sdk.NewDecWithPrec(-1, 1), valAddr1, false},
+		{"huge rate", sdk.NewDecWithPrec(11, 1), valAddr1, false},
+		{"empty address", sdk.NewDecWithPrec(5, 1), emptyAddr, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgEditValidatorCommissionRate(tc.validatorAddr, tc.rate)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "edit_validator_commission_rate")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func checkMsg(t *testing.T, msg sdk.Msg, expType string) {
 	require.Contains(t, msg.Route(), RouterKey)
 	require.Contains(t, msg.Type(), expType)
@@ -292,6 +320,37 @@ func TestMsgAddShares(t *testing.T) {
 
 }
 
+// test ValidateBasic for MsgRebalanceShares
+func TestMsgRebalanceShares(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		dlgAddr     sdk.AccAddress
+		srcValAddrs []sdk.ValAddress
+		dstValAddrs []sdk.ValAddress
+		expectPass  bool
+	}{
+		{"basic good", dlgAddr1, []sdk.ValAddress{valAddr1}, []sdk.ValAddress{valAddr2}, true},
+		{"basic good2", dlgAddr2, []sdk.ValAddress{valAddr2}, []sdk.ValAddress{valAddr1}, true},
+		{"src as dst", dlgAddr1, []sdk.ValAddress{valAddr1}, []sdk.ValAddress{valAddr2, valAddr1}, false},
+		{"duplicate src", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr1}, []sdk.ValAddress{valAddr2}, false},
+		{"empty src", dlgAddr1, nil, []sdk.ValAddress{valAddr2}, false},
+		{"empty dst", dlgAddr1, []sdk.ValAddress{valAddr1}, nil, false},
+		{"empty delegator", nil, []sdk.ValAddress{valAddr1}, []sdk.ValAddress{valAddr2}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRebalanceShares(tc.dlgAddr, tc.srcValAddrs, tc.dstValAddrs)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "rebalance_shares")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+}
+
 //// test ValidateBasic for MsgUnbond
 //func TestMsgBeginRedelegate(t *testing.T) {
 //	tests := []struct {
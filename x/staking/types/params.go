@@ -32,6 +32,9 @@ const (
 
 	DefaultEpoch              uint16 = DefaultBlocksPerEpoch
 	DefaultMaxValsToAddShares uint16 = DefaultMaxValsToVote
+
+	// Default cool-down of a delegator between two rebalances of shares, 1 day
+	DefaultRebalanceCooldown time.Duration = time.Hour * 24
 )
 
 var (
@@ -52,6 +55,7 @@ var (
 	KeyMaxValsToAddShares = []byte("MaxValsToAddShares")
 	KeyMinDelegation      = []byte("MinDelegation")
 	KeyMinSelfDelegation  = []byte("MinSelfDelegation")
+	KeyRebalanceCooldown  = []byte("RebalanceCooldown")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -72,11 +76,13 @@ type Params struct {
 	MinDelegation sdk.Dec `json:"min_delegation" yaml:"min_delegation"`
 	// validator's self declared minimum self delegation
 	MinSelfDelegation sdk.Dec `json:"min_self_delegation" yaml:"min_self_delegation"`
+	// time duration before a delegator is allowed to rebalance its shares again
+	RebalanceCooldown time.Duration `json:"rebalance_cooldown" yaml:"rebalance_cooldown"`
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators uint16, bondDenom string, epoch uint16, maxValsToAddShares uint16,
-	minDelegation sdk.Dec, minSelfDelegation sdk.Dec) Params {
+	minDelegation sdk.Dec, minSelfDelegation sdk.Dec, rebalanceCooldown time.Duration) Params {
 
 	return Params{
 		UnbondingTime:      unbondingTime,
@@ -86,6 +92,7 @@ func NewParams(unbondingTime time.Duration, maxValidators uint16, bondDenom stri
 		MaxValsToAddShares: maxValsToAddShares,
 		MinDelegation:      minDelegation,
 		MinSelfDelegation:  minSelfDelegation,
+		RebalanceCooldown:  rebalanceCooldown,
 	}
 }
 
@@ -104,6 +111,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMaxValsToAddShares, Value: &p.MaxValsToAddShares, ValidatorFn: common.ValidateUint16Positive("max vals to add shares")},
 		{Key: KeyMinDelegation, Value: &p.MinDelegation, ValidatorFn: common.ValidateDecPositive("min delegation")},
 		{Key: KeyMinSelfDelegation, Value: &p.MinSelfDelegation, ValidatorFn: common.ValidateDecPositive("min self delegation")},
+		{Key: KeyRebalanceCooldown, Value: &p.RebalanceCooldown, ValidatorFn: common.ValidateDurationPositive("rebalance cooldown")},
 	}
 }
 
@@ -121,7 +129,7 @@ func DefaultParams() Params {
 		DefaultUnbondingTime, DefaultMaxValidators,
 		sdk.DefaultBondDenom, DefaultEpoch,
 		DefaultMaxValsToAddShares, DefaultMinDelegation,
-		DefaultMinSelfDelegation,
+		DefaultMinSelfDelegation, DefaultRebalanceCooldown,
 	)
 }
 
@@ -134,8 +142,10 @@ func (p Params) String() string {
   Bonded Coin Denom: 		%s
   MaxValsToAddShares:       %d
   MinDelegation				%d
-  MinSelfDelegation         %d`,
-		p.UnbondingTime, p.MaxValidators, p.Epoch, p.BondDenom, p.MaxValsToAddShares, p.MinDelegation, p.MinSelfDelegation)
+  MinSelfDelegation         %d
+  RebalanceCooldown:        %s`,
+		p.UnbondingTime, p.MaxValidators, p.Epoch, p.BondDenom, p.MaxValsToAddShares, p.MinDelegation, p.MinSelfDelegation,
+		p.RebalanceCooldown)
 }
 
 // Validate gives a quick validity check for a set of params
@@ -152,6 +162,12 @@ func (p Params) Validate() error {
 	if p.MaxValsToAddShares == 0 {
 		return fmt.Errorf("staking parameter MaxValsToAddShares must be a positive integer")
 	}
+	if p.RebalanceCooldown <= 0 {
+		return fmt.Errorf("staking parameter RebalanceCooldown must be a positive duration")
+	}
+	if p.RebalanceCooldown > p.UnbondingTime {
+		return fmt.Errorf("staking parameter RebalanceCooldown must not be longer than UnbondingTime")
+	}
 
 	return nil
 }
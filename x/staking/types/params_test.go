@@ -37,4 +37,12 @@ func TestParams(t *testing.T) {
 	p2.MaxValsToAddShares = 0
 	require.Error(t, p2.Validate())
 
+	p2 = p1
+	p2.RebalanceCooldown = 0
+	require.Error(t, p2.Validate())
+
+	p2 = p1
+	p2.RebalanceCooldown = p2.UnbondingTime + 1
+	require.Error(t, p2.Validate())
+
 }
@@ -17,6 +17,9 @@ const (
 	QueryProxy               = "proxy"
 	QueryValidatorAllShares  = "validatorAllShares"
 	QueryDelegator           = "delegator"
+	QuerySlashes             = "slashes"
+	QueryPendingRebalance    = "pendingRebalance"
+	QueryPendingRebalances   = "pendingRebalances"
 )
 
 // QueryDelegatorParams defines the params for the following queries:
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingRebalance is the record of the rebalances of a delegator. The latest rebalance keeps the delegator from
+// rebalancing its shares again until the completion time, while each rebalance is kept as an entry for the unbonding
+// time, so that the delegator is slashed for the infractions committed before it
+type PendingRebalance struct {
+	DelegatorAddress      sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	SrcValidatorAddresses []sdk.ValAddress `json:"src_validator_addresses" yaml:"src_validator_addresses"`
+	DstValidatorAddresses []sdk.ValAddress `json:"dst_validator_addresses" yaml:"dst_validator_addresses"`
+	Shares                Shares           `json:"shares" yaml:"shares"`
+	CreationHeight        int64            `json:"creation_height" yaml:"creation_height"`
+	CompletionTime        time.Time        `json:"completion_time" yaml:"completion_time"`
+	Entries               []RebalanceEntry `json:"entries,omitempty" yaml:"entries"`
+}
+
+// RebalanceEntry is the record of a single rebalance, which is kept until the completion time
+type RebalanceEntry struct {
+	SrcValidatorAddresses []sdk.ValAddress `json:"src_validator_addresses" yaml:"src_validator_addresses"`
+	DstValidatorAddresses []sdk.ValAddress `json:"dst_validator_addresses" yaml:"dst_validator_addresses"`
+	CreationHeight        int64            `json:"creation_height" yaml:"creation_height"`
+	CompletionTime        time.Time        `json:"completion_time" yaml:"completion_time"`
+}
+
+// NewRebalanceEntry creates a new RebalanceEntry object
+func NewRebalanceEntry(srcValAddrs, dstValAddrs []sdk.ValAddress, creationHeight int64,
+	completionTime time.Time) RebalanceEntry {
+	return RebalanceEntry{
+		SrcValidatorAddresses: srcValAddrs,
+		DstValidatorAddresses: dstValAddrs,
+		CreationHeight:        creationHeight,
+		CompletionTime:        completionTime,
+	}
+}
+
+// revert returns the validators that the delegator added shares to before the rebalance of the entry
+func (entry RebalanceEntry) revert(valAddrs []sdk.ValAddress) []sdk.ValAddress {
+	reverted := make([]sdk.ValAddress, 0, len(valAddrs)+len(entry.SrcValidatorAddresses))
+	for _, valAddr := range valAddrs {
+		if !containsValAddr(entry.DstValidatorAddresses, valAddr) {
+			reverted = append(reverted, valAddr)
+		}
+	}
+	for _, valAddr := range entry.SrcValidatorAddresses {
+		if !containsValAddr(reverted, valAddr) {
+			reverted = append(reverted, valAddr)
+		}
+	}
+	return reverted
+}
+
+// NewPendingRebalance creates a new PendingRebalance object
+func NewPendingRebalance(delAddr sdk.AccAddress, srcValAddrs, dstValAddrs []sdk.ValAddress, shares Shares,
+	creationHeight int64, completionTime time.Time) PendingRebalance {
+	return PendingRebalance{
+		DelegatorAddress:      delAddr,
+		SrcValidatorAddresses: srcValAddrs,
+		DstValidatorAddresses: dstValAddrs,
+		Shares:                shares,
+		CreationHeight:        creationHeight,
+		CompletionTime:        completionTime,
+	}
+}
+
+// AddEntry records a rebalance into the pending rebalance, and drops the entries completed by the current time
+func (pr *PendingRebalance) AddEntry(entry RebalanceEntry, currentTime time.Time) {
+	entries := make([]RebalanceEntry, 0, len(pr.Entries)+1)
+	for _, e := range pr.Entries {
+		if e.CompletionTime.After(currentTime) {
+			entries = append(entries, e)
+		}
+	}
+	pr.Entries = append(entries, entry)
+}
+
+// GetReleaseTime returns the time when both the cool-down and all the entries of the pending rebalance complete
+func (pr PendingRebalance) GetReleaseTime() time.Time {
+	releaseTime := pr.CompletionTime
+	for _, entry := range pr.Entries {
+		if entry.CompletionTime.After(releaseTime) {
+			releaseTime = entry.CompletionTime
+		}
+	}
+	return releaseTime
+}
+
+// GetValidatorsBefore returns the validators that the delegator added shares to right before the first rebalance at
+// or after the height which moved the shares away from the validator, by reverting the rebalances since then from
+// the current validators of the delegator. It returns nil if there is no such rebalance
+func (pr PendingRebalance) GetValidatorsBefore(valAddrs []sdk.ValAddress, valAddr sdk.ValAddress,
+	height int64) []sdk.ValAddress {
+	first := -1
+	for i, entry := range pr.Entries {
+		if entry.CreationHeight >= height && containsValAddr(entry.SrcValidatorAddresses, valAddr) {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+
+	reverted := valAddrs
+	for i := len(pr.Entries) - 1; i >= first; i-- {
+		reverted = pr.Entries[i].revert(reverted)
+	}
+	return reverted
+}
+
+// String returns a human readable string representation of PendingRebalance
+func (pr PendingRebalance) String() string {
+	return fmt.Sprintf(`PendingRebalance:
+  Delegator:    %s
+  SrcValidators:    %s
+  DstValidators:    %s
+  Shares:    %s
+  CreationHeight:    %d
+  CompletionTime:    %s
+  Entries:    %d`,
+		pr.DelegatorAddress, pr.SrcValidatorAddresses, pr.DstValidatorAddresses, pr.Shares, pr.CreationHeight,
+		pr.CompletionTime.Format(time.RFC3339), len(pr.Entries))
+}
+
+// containsValAddr tells whether the validator address is among the addresses
+func containsValAddr(valAddrs []sdk.ValAddress, valAddr sdk.ValAddress) bool {
+	for _, addr := range valAddrs {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingRebalances is the type alias of the PendingRebalance slice
+type PendingRebalances []PendingRebalance
+
+// String returns a human readable string representation of PendingRebalances
+func (prs PendingRebalances) String() (out string) {
+	for _, pr := range prs {
+		out += pr.String() + "\n"
+	}
+	if len(out) > 0 {
+		out = out[:len(out)-1]
+	}
+	return
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashRecord is the record of a slash on a validator for the slashing history
+type SlashRecord struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Height           int64          `json:"height" yaml:"height"`
+	InfractionHeight int64          `json:"infraction_height" yaml:"infraction_height"`
+	Power            int64          `json:"power" yaml:"power"`
+	SlashFactor      sdk.Dec        `json:"slash_factor" yaml:"slash_factor"`
+	// tokens burned from the bonded pool, including the msd and the tokens of delegators
+	BurnedBonded sdk.Dec `json:"burned_bonded" yaml:"burned_bonded"`
+	// tokens burned from the not bonded pool, namely the undelegations in flight
+	BurnedUnbonding sdk.Dec `json:"burned_unbonding" yaml:"burned_unbonding"`
+}
+
+// NewSlashRecord creates a new SlashRecord object
+func NewSlashRecord(valAddr sdk.ValAddress, height, infractionHeight, power int64, slashFactor, burnedBonded,
+	burnedUnbonding sdk.Dec) SlashRecord {
+	return SlashRecord{
+		ValidatorAddress: valAddr,
+		Height:           height,
+		InfractionHeight: infractionHeight,
+		Power:            power,
+		SlashFactor:      slashFactor,
+		BurnedBonded:     burnedBonded,
+		BurnedUnbonding:  burnedUnbonding,
+	}
+}
+
+// Burned returns the total tokens burned by the slash
+func (sr SlashRecord) Burned() sdk.Dec {
+	return sr.BurnedBonded.Add(sr.BurnedUnbonding)
+}
+
+// String returns a human readable string representation of SlashRecord
+func (sr SlashRecord) String() string {
+	return fmt.Sprintf(`SlashRecord:
+  Validator:    %s
+  Height:    %d
+  InfractionHeight:    %d
+  Power:    %d
+  SlashFactor:    %s
+  BurnedBonded:    %s
+  BurnedUnbonding:    %s`,
+		sr.ValidatorAddress, sr.Height, sr.InfractionHeight, sr.Power, sr.SlashFactor, sr.BurnedBonded,
+		sr.BurnedUnbonding)
+}
+
+// SlashRecords is the type alias of the SlashRecord slice
+type SlashRecords []SlashRecord
+
+// String returns a human readable string representation of SlashRecords
+func (srs SlashRecords) String() (out string) {
+	for _, sr := range srs {
+		out += sr.String() + "\n"
+	}
+	if len(out) > 0 {
+		out = out[:len(out)-1]
+	}
+	return
+}
@@ -451,3 +451,11 @@ func (v Validator) GetConsensusPower() int64      { return v.ConsensusPower() }
 func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
 func (v Validator) GetMinSelfDelegation() sdk.Dec { return v.MinSelfDelegation }
 func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+
+// GetSharesAddedByDelegators gets the shares added by delegators, which excludes the shares of msd (any msd -> 1 shares)
+func (v Validator) GetSharesAddedByDelegators() sdk.Dec {
+	if v.MinSelfDelegation.IsZero() {
+		return v.DelegatorShares
+	}
+	return v.DelegatorShares.Sub(sdk.OneDec())
+}
@@ -29,6 +29,16 @@ type (
 	Params = types.Params
 	// MsgSend send token message
 	MsgSend = types.MsgSend
+	// MsgVestedSend send token message with a vesting schedule
+	MsgVestedSend = types.MsgVestedSend
+	// VestingSchedule vesting schedule created by a vested send
+	VestingSchedule = types.VestingSchedule
+	// MsgTokenFreeze freeze token message
+	MsgTokenFreeze = types.MsgTokenFreeze
+	// MsgTokenUnfreeze unfreeze token message
+	MsgTokenUnfreeze = types.MsgTokenUnfreeze
+	// FrozenAccount account whose token is frozen
+	FrozenAccount = types.FrozenAccount
 	// AccountResponse response for query account
 	AccountResponse = types.AccountResponse
 	// CoinInfo coin info for query token
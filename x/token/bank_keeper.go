@@ -0,0 +1,78 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/okex/okexchain/x/token/types"
+)
+
+var _ bank.Keeper = FreezeBankKeeper{}
+
+// FreezeBankKeeper wraps the bank keeper to keep the frozen tokens from leaving their accounts, which covers the
+// transfers by bank as well as the coins locked or escrowed by the other modules through supply.
+// The module accounts are never frozen, so that the coins escrowed in them are always released to their owners
+type FreezeBankKeeper struct {
+	bank.Keeper
+	tokenStoreKey  sdk.StoreKey
+	moduleAccAddrs map[string]bool
+}
+
+// NewFreezeBankKeeper creates a new instance of FreezeBankKeeper
+func NewFreezeBankKeeper(bankKeeper bank.Keeper, tokenStoreKey sdk.StoreKey,
+	moduleAccAddrs map[string]bool) FreezeBankKeeper {
+	return FreezeBankKeeper{
+		Keeper:         bankKeeper,
+		tokenStoreKey:  tokenStoreKey,
+		moduleAccAddrs: moduleAccAddrs,
+	}
+}
+
+// SendCoins moves coins from one account to another unless any of the coins is frozen in the sender
+func (k FreezeBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkFrozenCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins handles a list of inputs and outputs unless any of the coins is frozen in its input account
+func (k FreezeBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) error {
+	for _, input := range inputs {
+		if err := k.checkFrozenCoins(ctx, input.Address, input.Coins); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SubtractCoins subtracts coins from an account unless any of the coins is frozen in it
+func (k FreezeBankKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	if err := k.checkFrozenCoins(ctx, addr, amt); err != nil {
+		return nil, err
+	}
+	return k.Keeper.SubtractCoins(ctx, addr, amt)
+}
+
+// DelegateCoins delegates coins to a module account unless any of the coins is frozen in the delegator
+func (k FreezeBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress,
+	amt sdk.Coins) error {
+	if err := k.checkFrozenCoins(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+	return k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+// checkFrozenCoins returns an error if any of the coins is frozen in the account which isn't a module account
+func (k FreezeBankKeeper) checkFrozenCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if k.moduleAccAddrs[addr.String()] {
+		return nil
+	}
+
+	store := ctx.KVStore(k.tokenStoreKey)
+	for _, coin := range coins {
+		if store.Has(types.GetFrozenAccountKey(addr, coin.Denom)) {
+			return types.ErrAccountIsFrozen(addr, coin.Denom)
+		}
+	}
+	return nil
+}
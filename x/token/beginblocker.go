@@ -12,4 +12,5 @@ func beginBlocker(ctx sdk.Context, keeper Keeper) {
 	defer perf.GetPerf().OnBeginBlockExit(ctx, types.ModuleName, seq)
 
 	keeper.ResetCache(ctx)
+	keeper.ReleaseVestedCoins(ctx)
 }
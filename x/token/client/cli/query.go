@@ -26,12 +26,60 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	queryCmd.AddCommand(flags.GetCommands(
 		getCmdQueryParams(queryRoute, cdc),
 		getCmdTokenInfo(queryRoute, cdc),
+		getCmdQueryVesting(queryRoute, cdc),
+		getCmdQueryFrozen(queryRoute, cdc),
 		//getAccountCmd(queryRoute, cdc),
 	)...)
 
 	return queryCmd
 }
 
+// getCmdQueryVesting queries the vesting status of a recipient
+func getCmdQueryVesting(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting [address]",
+		Short: "query the locked vesting coins and vesting schedules of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryVesting, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var status types.VestingStatus
+			cdc.MustUnmarshalJSON(res, &status)
+			return cliCtx.PrintOutput(status)
+		},
+	}
+}
+
+// getCmdQueryFrozen queries the frozen accounts of a token
+func getCmdQueryFrozen(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen [<symbol>]",
+		Short: "query the frozen accounts of a token, or of all the tokens without symbol",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFrozen)
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var frozenAccounts []types.FrozenAccount
+			cdc.MustUnmarshalJSON(res, &frozenAccounts)
+			return cliCtx.PrintOutput(frozenAccounts)
+		},
+	}
+}
+
 // getCmdTokenInfo queries token info by address
 func getCmdTokenInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	var owner string
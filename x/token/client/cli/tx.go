@@ -31,6 +31,15 @@ const (
 	Mintable      = "mintable"
 	Transfers     = "transfers"
 	TransfersFile = "transfers-file"
+	VestingType   = "vesting-type"
+	StartTime     = "start-time"
+	EndTime       = "end-time"
+	Decimals      = "decimals"
+	URI           = "uri"
+	Website       = "website"
+	Attributes    = "attributes"
+	MaxSupply     = "max-supply"
+	Freezable     = "freezable"
 )
 
 const (
@@ -49,6 +58,9 @@ var (
 	errTransfersFileNotValid  = errors.New("transfers file not valid")
 	errSign                   = errors.New("sign not succeed")
 	errParam                  = errors.New("can't get token desc or whole name")
+	errMetadataNotValid       = errors.New("token metadata not valid")
+	errMaxSupplyNotValid      = errors.New("max supply not valid")
+	errFreezableNotValid      = errors.New("freezable not valid")
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -68,7 +80,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		getCmdTokenMultiSend(cdc),
 		getCmdTransferOwnership(cdc),
 		getCmdConfirmOwnership(cdc),
+		getCmdTokenFreeze(cdc),
+		getCmdTokenUnfreeze(cdc),
 		getCmdTokenEdit(cdc),
+		getCmdVestedSend(cdc),
 	)...)
 
 	return distTxCmd
@@ -127,10 +142,20 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 				return errMintableNotValid
 			}
 
+			maxSupply, err := flags.GetString(MaxSupply)
+			if err != nil {
+				return errMaxSupplyNotValid
+			}
+			freezable, err := flags.GetBool(Freezable)
+			if err != nil {
+				return errFreezableNotValid
+			}
+
 			var symbol string
 
 			// totalSupply int64 ,coins bigint
-			msg := types.NewMsgTokenIssue(tokenDesc, symbol, originalSymbol, wholeName, totalSupply, cliCtx.FromAddress, mintable)
+			msg := types.NewMsgTokenIssue(tokenDesc, symbol, originalSymbol, wholeName, totalSupply, cliCtx.FromAddress, mintable).
+				WithIssuerControls(maxSupply, freezable)
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -141,6 +166,8 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(TokenDesc, "", "describe of the token")
 	cmd.Flags().StringP(TotalSupply, "n", "0", "total supply of the new token")
 	cmd.Flags().Bool(Mintable, false, "whether the token can be minted")
+	cmd.Flags().String(MaxSupply, "", "max supply of a mintable token, no cap if it's empty")
+	cmd.Flags().Bool(Freezable, false, "whether the owner can freeze the token of accounts")
 
 	return cmd
 }
@@ -360,11 +387,61 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// getCmdVestedSend is the CLI command for sending a VestedSend transaction
+func getCmdVestedSend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vested-send [to_address] [amount]",
+		Short: "send coins which are unlocked to the recipient over time",
+		Long: strings.TrimSpace(`Send coins which are unlocked to the recipient over time. The coins are unlocked
+linearly from the start time to the end time, or all at once at the end time with the cliff vesting type.
+
+$ okexchaincli tx token vested-send okexchain1... 1000xxb-781 --vesting-type linear --start-time 1609459200 --end-time 1640995200 --from mykey
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+			coins, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			vestingType, err := flags.GetString(VestingType)
+			if err != nil {
+				return err
+			}
+			startTime, err := flags.GetInt64(StartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := flags.GetInt64(EndTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVestedSend(cliCtx.GetFromAddress(), to, coins, vestingType, startTime, endTime)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(VestingType, types.VestingTypeLinear, "vesting type of the coins, linear or cliff")
+	cmd.Flags().Int64(StartTime, 0, "unix time in seconds when the vesting starts")
+	cmd.Flags().Int64(EndTime, 0, "unix time in seconds when all of the coins are unlocked")
+
+	return cmd
+}
+
 // getCmdTokenEdit is the CLI command for sending a TokenEdit transaction
 func getCmdTokenEdit(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "edit",
-		Short: "edit a token's whole name and desc",
+		Short: "edit a token's whole name, desc and metadata",
 		//Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -412,21 +489,83 @@ func getCmdTokenEdit(cdc *codec.Codec) *cobra.Command {
 					return errTokenWholeNameNotValid
 				}
 			}
-			if !isWholeNameEdit && !isDescEdit {
+			isMetadataEdit := false
+			for _, flagName := range []string{Decimals, URI, Website, Attributes} {
+				if f := flags.Lookup(flagName); f != nil && f.Changed {
+					isMetadataEdit = true
+				}
+			}
+			if !isWholeNameEdit && !isDescEdit && !isMetadataEdit {
 				return errParam
 			}
 
 			msg := types.NewMsgTokenModify(symbol, tokenDesc, wholeName, isDescEdit, isWholeNameEdit, cliCtx.FromAddress)
+			if isMetadataEdit {
+				metadata, err := getEditedMetadata(cliCtx, cmd, symbol)
+				if err != nil {
+					return err
+				}
+				msg = msg.WithMetadata(metadata)
+			}
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
 	cmd.Flags().StringP(WholeName, "w", "", "whole name of the token")
 	cmd.Flags().String(TokenDesc, "", "description of the token")
+	cmd.Flags().Int64(Decimals, 0, "display decimals of the token")
+	cmd.Flags().String(URI, "", "logo uri of the token")
+	cmd.Flags().String(Website, "", "website of the token")
+	cmd.Flags().String(Attributes, "", "extended attributes of the token, e.g. \"twitter=https://twitter.com/okex,github=\", "+
+		"an attribute with an empty value is removed")
 
 	return cmd
 }
 
+// getEditedMetadata merges the changed metadata flags into the current metadata of the token
+func getEditedMetadata(cliCtx context.CLIContext, cmd *cobra.Command, symbol string) (types.TokenMetadata, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryInfo, symbol), nil)
+	if err != nil {
+		return types.TokenMetadata{}, err
+	}
+	var token types.TokenResp
+	if err := cliCtx.Codec.UnmarshalJSON(res, &token); err != nil {
+		return types.TokenMetadata{}, err
+	}
+
+	metadata := token.Metadata
+	flags := cmd.Flags()
+	if flags.Changed(Decimals) {
+		if metadata.Decimals, err = flags.GetInt64(Decimals); err != nil {
+			return metadata, errMetadataNotValid
+		}
+	}
+	if flags.Changed(URI) {
+		if metadata.URI, err = flags.GetString(URI); err != nil {
+			return metadata, errMetadataNotValid
+		}
+	}
+	if flags.Changed(Website) {
+		if metadata.Website, err = flags.GetString(Website); err != nil {
+			return metadata, errMetadataNotValid
+		}
+	}
+	if flags.Changed(Attributes) {
+		attributes, err := flags.GetString(Attributes)
+		if err != nil {
+			return metadata, errMetadataNotValid
+		}
+		for _, attr := range strings.Split(attributes, ",") {
+			kv := strings.SplitN(attr, "=", 2)
+			if len(kv) != 2 {
+				return metadata, errMetadataNotValid
+			}
+			metadata.SetAttribute(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+		}
+	}
+	return metadata, nil
+}
+
 // getCmdConfirmOwnership is the CLI command for sending a ConfirmOwnership transaction
 func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -460,3 +599,51 @@ func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP("symbol", "s", "", "symbol of the token to be transferred")
 	return cmd
 }
+
+// getCmdTokenFreeze is the CLI command for sending a TokenFreeze transaction
+func getCmdTokenFreeze(cdc *codec.Codec) *cobra.Command {
+	return getCmdFreezeOrUnfreeze(cdc, true)
+}
+
+// getCmdTokenUnfreeze is the CLI command for sending a TokenUnfreeze transaction
+func getCmdTokenUnfreeze(cdc *codec.Codec) *cobra.Command {
+	return getCmdFreezeOrUnfreeze(cdc, false)
+}
+
+func getCmdFreezeOrUnfreeze(cdc *codec.Codec, freeze bool) *cobra.Command {
+	use, short := "unfreeze [address]", "unfreeze the token of an account"
+	if freeze {
+		use, short = "freeze [address]", "freeze the token of an account, which can't send or receive the token"
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(Symbol)
+			if err != nil {
+				return errSymbolNotValid
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg = types.NewMsgTokenUnfreeze(symbol, addr, cliCtx.GetFromAddress())
+			if freeze {
+				msg = types.NewMsgTokenFreeze(symbol, addr, cliCtx.GetFromAddress())
+			}
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	return cmd
+}
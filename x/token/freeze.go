@@ -0,0 +1,64 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/token/types"
+)
+
+// FreezeAccount freezes the token of an account
+func (k Keeper) FreezeAccount(ctx sdk.Context, addr sdk.AccAddress, symbol string) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	store.Set(types.GetFrozenAccountKey(addr, symbol), []byte{})
+}
+
+// UnfreezeAccount unfreezes the token of an account
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, addr sdk.AccAddress, symbol string) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	store.Delete(types.GetFrozenAccountKey(addr, symbol))
+}
+
+// IsAccountFrozen returns whether the token of an account is frozen
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, addr sdk.AccAddress, symbol string) bool {
+	store := ctx.KVStore(k.tokenStoreKey)
+	return store.Has(types.GetFrozenAccountKey(addr, symbol))
+}
+
+// IterateFrozenAccounts iterates over all the frozen accounts and performs a callback function
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, cb func(frozen types.FrozenAccount) (stop bool)) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PrefixFrozenAccountKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.PrefixFrozenAccountKey):]
+		frozen := types.FrozenAccount{
+			Address: sdk.AccAddress(key[:sdk.AddrLen]),
+			Symbol:  string(key[sdk.AddrLen:]),
+		}
+		if cb(frozen) {
+			break
+		}
+	}
+}
+
+// GetFrozenAccounts returns the frozen accounts of a token, or of all the tokens if the symbol is empty
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, symbol string) (frozenAccounts []types.FrozenAccount) {
+	k.IterateFrozenAccounts(ctx, func(frozen types.FrozenAccount) bool {
+		if symbol == "" || frozen.Symbol == symbol {
+			frozenAccounts = append(frozenAccounts, frozen)
+		}
+		return false
+	})
+	return frozenAccounts
+}
+
+// checkFrozenCoins returns an error if any of the coins is frozen in any of the accounts
+func (k Keeper) checkFrozenCoins(ctx sdk.Context, coins sdk.SysCoins, addrs ...sdk.AccAddress) error {
+	for _, coin := range coins {
+		for _, addr := range addrs {
+			if k.IsAccountFrozen(ctx, addr, coin.Denom) {
+				return types.ErrAccountIsFrozen(addr, coin.Denom)
+			}
+		}
+	}
+	return nil
+}
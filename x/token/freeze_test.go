@@ -0,0 +1,91 @@
+package token
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/token/types"
+)
+
+func TestIssuerControls(t *testing.T) {
+	genAccs, testAccounts := CreateGenAccounts(2,
+		sdk.SysCoins{
+			sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100000)),
+		})
+
+	app, keeper, _ := getMockDexApp(t, 0)
+	mock.SetGenesis(app.App, types.DecAccountArrToBaseAccountArr(genAccs))
+	owner, holder := testAccounts[0].baseAccount.Address, testAccounts[1].baseAccount.Address
+	tokenModuleAddr := supply.NewModuleAddress(types.ModuleName)
+	feeCollectorAddr := supply.NewModuleAddress(auth.FeeCollectorName)
+
+	ctx := app.NewContext(true, abci.Header{})
+	var tokenMsgs []auth.StdTx
+
+	tokenIssueMsg := types.NewMsgTokenIssue("usdk", "usdk", "usdk", "usdk coin", "1000", owner, true).
+		WithIssuerControls("1500", true)
+	tokenMsgs = append(tokenMsgs, createTokenMsg(t, app, ctx, testAccounts[0], tokenIssueMsg))
+	ctx = mockApplyBlock(t, app, tokenMsgs, 3)
+
+	symbol := getTokenSymbol(ctx, keeper, "usdk")
+	token := keeper.GetTokenInfo(ctx, symbol)
+	require.True(t, token.Freezable)
+	require.EqualValues(t, sdk.NewDec(1500), token.MaxSupply)
+
+	// mint up to the max supply, and freeze the holder
+	tokenMsgs = tokenMsgs[:0]
+	tokenMsgs = append(tokenMsgs,
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenMint(sdk.NewDecCoinFromDec(symbol, sdk.NewDec(400)), owner)),
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenMint(sdk.NewDecCoinFromDec(symbol, sdk.NewDec(200)), owner)),
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenFreeze(symbol, holder, owner)),
+		// only the owner can freeze
+		createTokenMsg(t, app, ctx, testAccounts[1], types.NewMsgTokenFreeze(symbol, owner, holder)),
+		// the module accounts can't be frozen
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenFreeze(symbol, tokenModuleAddr, owner)),
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenFreeze(symbol, feeCollectorAddr, owner)),
+	)
+	ctx = mockApplyBlock(t, app, tokenMsgs, 4)
+	require.EqualValues(t, sdk.NewDec(1400), keeper.GetTokenTotalSupply(ctx, symbol))
+	require.True(t, keeper.IsAccountFrozen(ctx, holder, symbol))
+	require.False(t, keeper.IsAccountFrozen(ctx, owner, symbol))
+	require.False(t, keeper.IsAccountFrozen(ctx, tokenModuleAddr, symbol))
+	require.False(t, keeper.IsAccountFrozen(ctx, feeCollectorAddr, symbol))
+
+	// the frozen holder can't receive the token
+	coins := sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, sdk.NewDec(100))}
+	tokenMsgs = tokenMsgs[:0]
+	tokenMsgs = append(tokenMsgs,
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenSend(owner, holder, coins)),
+		createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgMultiSend(owner,
+			[]types.TransferUnit{{To: holder, Coins: coins}})),
+	)
+	ctx = mockApplyBlock(t, app, tokenMsgs, 5)
+	require.True(t, keeper.GetCoins(ctx, holder).AmountOf(symbol).IsZero())
+
+	// query the frozen accounts
+	querier := NewQuerier(keeper)
+	res, err := querier(ctx, []string{types.QueryFrozen, symbol}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var frozenAccounts []types.FrozenAccount
+	keeper.cdc.MustUnmarshalJSON(res, &frozenAccounts)
+	require.EqualValues(t, []types.FrozenAccount{{Symbol: symbol, Address: holder}}, frozenAccounts)
+
+	// the holder receives the token after it's unfrozen
+	tokenMsgs = tokenMsgs[:0]
+	tokenMsgs = append(tokenMsgs, createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenUnfreeze(symbol, holder, owner)))
+	ctx = mockApplyBlock(t, app, tokenMsgs, 6)
+	require.False(t, keeper.IsAccountFrozen(ctx, holder, symbol))
+
+	tokenMsgs = tokenMsgs[:0]
+	tokenMsgs = append(tokenMsgs, createTokenMsg(t, app, ctx, testAccounts[0], types.NewMsgTokenSend(owner, holder, coins)))
+	ctx = mockApplyBlock(t, app, tokenMsgs, 7)
+	require.EqualValues(t, sdk.NewDec(100), keeper.GetCoins(ctx, holder).AmountOf(symbol))
+	require.EqualValues(t, 0, len(keeper.GetFrozenAccounts(ctx, "")))
+}
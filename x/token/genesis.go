@@ -18,6 +18,9 @@ type GenesisState struct {
 	Tokens       []types.Token    `json:"tokens"`
 	LockedAssets []types.AccCoins `json:"locked_assets"`
 	LockedFees   []types.AccCoins `json:"locked_fees"`
+
+	VestingSchedules []types.VestingSchedule `json:"vesting_schedules"`
+	FrozenAccounts   []types.FrozenAccount   `json:"frozen_accounts"`
 }
 
 // default GenesisState used by Cosmos Hub
@@ -46,6 +49,7 @@ func defaultGenesisStateOKT() types.Token {
 		OriginalTotalSupply: totalSupply,
 		Owner:               addr,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 }
 
@@ -58,6 +62,11 @@ func validateGenesis(data GenesisState) error {
 			token.OriginalTotalSupply.String(),
 			token.Owner,
 			token.Mintable)
+		var maxSupply string
+		if token.HasMaxSupply() {
+			maxSupply = token.MaxSupply.String()
+		}
+		msg = msg.WithIssuerControls(maxSupply, token.Freezable)
 
 		err := msg.ValidateBasic()
 		if err != nil {
@@ -93,6 +102,29 @@ func initGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+
+	// the locked vesting coins are restored from the vesting schedules
+	var maxVestingID uint64
+	for i := range data.VestingSchedules {
+		schedule := data.VestingSchedules[i]
+		keeper.SetVestingSchedule(ctx, &schedule)
+		keeper.InsertVestingQueue(ctx, schedule.NextReleaseTime(ctx.BlockTime().Unix()), &schedule)
+		if err := keeper.updateLockedCoins(ctx, schedule.Recipient, schedule.LockedCoins(), true,
+			types.LockCoinsTypeVesting); err != nil {
+			panic(err)
+		}
+		if schedule.ID > maxVestingID {
+			maxVestingID = schedule.ID
+		}
+	}
+	if maxVestingID > 0 {
+		store := ctx.KVStore(keeper.lockStoreKey)
+		store.Set(types.VestingScheduleSeqKey, keeper.cdc.MustMarshalBinaryBare(maxVestingID))
+	}
+
+	for _, frozen := range data.FrozenAccounts {
+		keeper.FreezeAccount(ctx, frozen.Address, frozen.Symbol)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -113,10 +145,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	var vestingSchedules []types.VestingSchedule
+	keeper.IterateVestingSchedules(ctx, func(schedule *types.VestingSchedule) bool {
+		vestingSchedules = append(vestingSchedules, *schedule)
+		return false
+	})
+
 	return GenesisState{
-		Params:       params,
-		Tokens:       tokens,
-		LockedAssets: lockedAsset,
-		LockedFees:   lockedFees,
+		Params:           params,
+		Tokens:           tokens,
+		LockedAssets:     lockedAsset,
+		LockedFees:       lockedFees,
+		VestingSchedules: vestingSchedules,
+		FrozenAccounts:   keeper.GetFrozenAccounts(ctx, ""),
 	}
 }
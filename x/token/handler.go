@@ -6,6 +6,7 @@ import (
 	"github.com/okex/okexchain/x/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/okex/okexchain/x/common/perf"
 	"github.com/okex/okexchain/x/common/version"
 	"github.com/okex/okexchain/x/token/types"
@@ -52,6 +53,12 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 				return handleMsgSend(ctx, keeper, msg, logger)
 			}
 
+		case types.MsgVestedSend:
+			name = "handleMsgVestedSend"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgVestedSend(ctx, keeper, msg, logger)
+			}
+
 		case types.MsgTransferOwnership:
 			name = "handleMsgTransferOwnership"
 			handlerFun = func() (*sdk.Result, error) {
@@ -68,6 +75,18 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenModify(ctx, keeper, msg, logger)
 			}
+
+		case types.MsgTokenFreeze:
+			name = "handleMsgTokenFreeze"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenFreeze(ctx, keeper, msg, logger)
+			}
+
+		case types.MsgTokenUnfreeze:
+			name = "handleMsgTokenUnfreeze"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenUnfreeze(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Unrecognized token Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -99,6 +118,14 @@ func handleMsgTokenIssue(ctx sdk.Context, keeper Keeper, msg types.MsgTokenIssue
 		OriginalTotalSupply: totalSupply,
 		Owner:               msg.Owner,
 		Mintable:            msg.Mintable,
+		MaxSupply:           sdk.ZeroDec(),
+		Freezable:           msg.Freezable,
+	}
+	if len(msg.MaxSupply) != 0 {
+		token.MaxSupply, err = sdk.NewDecFromStr(msg.MaxSupply)
+		if err != nil {
+			return types.ErrGetDecimalFromDecimalStringFailed(err.Error()).Result()
+		}
 	}
 
 	// generate a random symbol
@@ -135,10 +162,10 @@ func handleMsgTokenIssue(ctx sdk.Context, keeper Keeper, msg types.MsgTokenIssue
 	var name = "handleMsgTokenIssue"
 	if logger != nil {
 		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
-			"                           msg<Description:%s,Symbol:%s,OriginalSymbol:%s,TotalSupply:%s,Owner:%v,Mintable:%v>\n"+
+			"                           msg<Description:%s,Symbol:%s,OriginalSymbol:%s,TotalSupply:%s,Owner:%v,Mintable:%v,MaxSupply:%s,Freezable:%v>\n"+
 			"                           result<Owner have enough okts to issue %s>\n",
 			ctx.BlockHeight(), name,
-			msg.Description, msg.Symbol, msg.OriginalSymbol, msg.TotalSupply, msg.Owner, msg.Mintable,
+			msg.Description, msg.Symbol, msg.OriginalSymbol, msg.TotalSupply, msg.Owner, msg.Mintable, msg.MaxSupply, msg.Freezable,
 			token.Symbol))
 	}
 
@@ -148,6 +175,8 @@ func handleMsgTokenIssue(ctx sdk.Context, keeper Keeper, msg types.MsgTokenIssue
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyFee, keeper.GetParams(ctx).FeeIssue.String()),
 			sdk.NewAttribute("symbol", token.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, token.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyFreezable, fmt.Sprintf("%v", token.Freezable)),
 		),
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
@@ -218,6 +247,10 @@ func handleMsgTokenMint(ctx sdk.Context, keeper Keeper, msg types.MsgTokenMint,
 	if totalSupplyAfterMint.GT(sdk.NewDec(types.TotalSupplyUpperbound)) {
 		return types.ErrCodeTotalsupplyExceedsTheUpperLimit(totalSupplyAfterMint, types.TotalSupplyUpperbound).Result()
 	}
+	// check the max supply chosen by the issuer
+	if token.HasMaxSupply() && totalSupplyAfterMint.GT(token.MaxSupply) {
+		return types.ErrExceedsMaxSupply(totalSupplyAfterMint, token.MaxSupply).Result()
+	}
 
 	mintCoins := msg.Amount.ToCoins()
 	// set supply
@@ -254,6 +287,8 @@ func handleMsgTokenMint(ctx sdk.Context, keeper Keeper, msg types.MsgTokenMint,
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyFee, feeDecCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, totalSupplyAfterMint.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, token.MaxSupply.String()),
 		),
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
@@ -318,6 +353,54 @@ func handleMsgSend(ctx sdk.Context, keeper Keeper, msg types.MsgSend, logger log
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgVestedSend(ctx sdk.Context, keeper Keeper, msg types.MsgVestedSend, logger log.Logger) (*sdk.Result, error) {
+	if !keeper.bankKeeper.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled().Result()
+	}
+	if keeper.bankKeeper.BlacklistedAddr(msg.ToAddress) {
+		return types.ErrBlockedRecipient(msg.ToAddress.String()).Result()
+	}
+	if msg.EndTime <= ctx.BlockTime().Unix() {
+		return types.ErrInvalidVestingSchedule(fmt.Sprintf("end time(%d) should be after the block time(%d)",
+			msg.EndTime, ctx.BlockTime().Unix())).Result()
+	}
+	if err := keeper.checkFrozenCoins(ctx, msg.Amount, msg.FromAddress, msg.ToAddress); err != nil {
+		return nil, err
+	}
+
+	schedule := &types.VestingSchedule{
+		Sender:      msg.FromAddress,
+		Recipient:   msg.ToAddress,
+		VestingType: msg.VestingType,
+		Amount:      msg.Amount,
+		Released:    sdk.SysCoins{},
+		StartTime:   msg.StartTime,
+		EndTime:     msg.EndTime,
+	}
+	if err := keeper.CreateVestingSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	var name = "handleMsgVestedSend"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<From:%s,To:%s,Amount:%s,VestingType:%s,StartTime:%d,EndTime:%d>\n"+
+			"                           result<vesting schedule %d is created>\n",
+			ctx.BlockHeight(), name,
+			msg.FromAddress, msg.ToAddress, msg.Amount, msg.VestingType, msg.StartTime, msg.EndTime,
+			schedule.ID))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyVestingID, fmt.Sprintf("%d", schedule.ID)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership, logger log.Logger) (*sdk.Result, error) {
 	tokenInfo := keeper.GetTokenInfo(ctx, msg.Symbol)
 
@@ -417,7 +500,7 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	if !token.Owner.Equals(msg.Owner) {
 		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
 	}
-	if !msg.IsWholeNameModified && !msg.IsDescriptionModified {
+	if !msg.IsWholeNameModified && !msg.IsDescriptionModified && msg.Metadata == nil {
 		return types.ErrWholeNameAndDescriptionIsNotModified().Result()
 	}
 	// modify
@@ -427,6 +510,9 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	if msg.IsDescriptionModified {
 		token.Description = msg.Description
 	}
+	if msg.Metadata != nil {
+		token.Metadata = *msg.Metadata
+	}
 
 	keeper.UpdateToken(ctx, token)
 
@@ -456,3 +542,85 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgTokenFreeze(ctx sdk.Context, keeper Keeper, msg types.MsgTokenFreeze, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Freezable {
+		return types.ErrTokenIsNotFreezable(msg.Symbol).Result()
+	}
+	// the coins escrowed in the module accounts belong to the others, which can't be frozen
+	if keeper.bankKeeper.BlacklistedAddr(msg.Address) {
+		return types.ErrModuleAccountCannotBeFrozen(msg.Address).Result()
+	}
+	if _, ok := keeper.accountKeeper.GetAccount(ctx, msg.Address).(supplyexported.ModuleAccountI); ok {
+		return types.ErrModuleAccountCannotBeFrozen(msg.Address).Result()
+	}
+	if keeper.IsAccountFrozen(ctx, msg.Address, msg.Symbol) {
+		return types.ErrAccountIsFrozen(msg.Address, msg.Symbol).Result()
+	}
+
+	keeper.FreezeAccount(ctx, msg.Address, msg.Symbol)
+
+	name := "handleMsgTokenFreeze"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Address:%s>\n"+
+			"                           result<%s of %s is frozen>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Address,
+			msg.Symbol, msg.Address))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreeze,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenUnfreeze(ctx sdk.Context, keeper Keeper, msg types.MsgTokenUnfreeze, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !keeper.IsAccountFrozen(ctx, msg.Address, msg.Symbol) {
+		return types.ErrAccountIsNotFrozen(msg.Address, msg.Symbol).Result()
+	}
+
+	keeper.UnfreezeAccount(ctx, msg.Address, msg.Symbol)
+
+	name := "handleMsgTokenUnfreeze"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Address:%s>\n"+
+			"                           result<%s of %s is unfrozen>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Address,
+			msg.Symbol, msg.Address))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreeze,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
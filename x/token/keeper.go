@@ -187,6 +187,10 @@ func (k Keeper) SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccA
 		return types.ErrBlockedContractRecipient(to.String())
 	}
 
+	if err := k.checkFrozenCoins(ctx, amt, from, to); err != nil {
+		return err
+	}
+
 	return k.bankKeeper.SendCoins(ctx, from, to, amt)
 }
 
@@ -207,6 +211,8 @@ func (k Keeper) updateLockedCoins(ctx sdk.Context, addr sdk.AccAddress, coins sd
 		key = types.GetLockAddress(addr.Bytes())
 	case types.LockCoinsTypeFee:
 		key = types.GetLockFeeAddress(addr.Bytes())
+	case types.LockCoinsTypeVesting:
+		key = types.GetLockVestingAddress(addr.Bytes())
 	default:
 		return types.ErrUnrecognizedLockCoinsType(lockCoinsType)
 	}
@@ -31,6 +31,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTokensV2(ctx, path[1:], req, keeper)
 		case types.QueryTokenV2:
 			return queryTokenV2(ctx, path[1:], req, keeper)
+		case types.QueryVesting:
+			return queryVesting(ctx, path[1:], keeper)
+		case types.QueryFrozen:
+			return queryFrozen(ctx, path[1:], keeper)
 		default:
 			return nil, types.ErrUnknownTokenQueryType()
 		}
@@ -167,3 +171,43 @@ func queryKeysNum(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+// queryVesting queries the locked vesting coins and the vesting schedules of a recipient, with path address
+func queryVesting(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrAddressIsRequired()
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, common.ErrCreateAddrFromBech32Failed(path[0], err.Error())
+	}
+
+	status := types.VestingStatus{
+		Address:   addr,
+		Locked:    keeper.GetLockedVestingCoins(ctx, addr),
+		Schedules: keeper.GetVestingSchedules(ctx, addr),
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, status)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryFrozen queries the frozen accounts of a token with path symbol, or of all the tokens without it
+func queryFrozen(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	var symbol string
+	if len(path) > 0 {
+		symbol = path[0]
+	}
+
+	frozenAccounts := keeper.GetFrozenAccounts(ctx, symbol)
+	if frozenAccounts == nil {
+		frozenAccounts = []types.FrozenAccount{}
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, frozenAccounts)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
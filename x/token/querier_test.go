@@ -29,6 +29,7 @@ func TestQueryOrder(t *testing.T) {
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		Owner:               testAccounts[0].baseAccount.Address,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 
 	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(token.Symbol, token.OriginalTotalSupply))
@@ -68,6 +69,7 @@ func TestQueryTokens(t *testing.T) {
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		Owner:               testAccounts[0].baseAccount.Address,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 
 	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(token.Symbol, token.OriginalTotalSupply))
@@ -125,8 +127,9 @@ func TestQueryTokens(t *testing.T) {
 		OriginalSymbol:      common.NativeToken,
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		//TotalSupply:         sdk.NewDec(1000000000),
-		Owner:    []byte("abc"),
-		Mintable: true,
+		Owner:     []byte("abc"),
+		Mintable:  true,
+		MaxSupply: sdk.ZeroDec(),
 	}
 
 	keeper.NewToken(ctx, token)
@@ -154,6 +157,7 @@ func TestQueryUserTokens(t *testing.T) {
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		Owner:               testAccounts[0].baseAccount.Address,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 
 	coins := sdk.NewCoins(sdk.NewDecCoinFromDec(token.Symbol, token.OriginalTotalSupply))
@@ -193,6 +197,7 @@ func TestQueryCurrency(t *testing.T) {
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		Owner:               testAccounts[0].baseAccount.Address,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 
 	keeper.NewToken(ctx, token)
@@ -235,6 +240,7 @@ func TestQueryAccount(t *testing.T) {
 		OriginalTotalSupply: sdk.NewDec(1000000000),
 		Owner:               testAccounts[0].baseAccount.Address,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 
 	originalCoinsInfo := types.CoinsInfo{
@@ -325,6 +331,7 @@ func TestQueryAccount_ShowAll(t *testing.T) {
 			OriginalTotalSupply: sdk.NewDec(1000000000),
 			Owner:               testAccounts[0].baseAccount.Address,
 			Mintable:            true,
+			MaxSupply:           sdk.ZeroDec(),
 		},
 		{
 			Description:         "not_exist",
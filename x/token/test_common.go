@@ -105,5 +105,6 @@ func InitTestTokenWithOwner(name string, owner sdk.AccAddress) types.Token {
 		Owner:               owner,
 		Type:                1,
 		Mintable:            true,
+		MaxSupply:           sdk.ZeroDec(),
 	}
 }
@@ -870,6 +870,20 @@ bnbbbbbbbbbbbnbbbbbbbbbbnbbbbbbbbbbbnbbbbbbbbb1234`, "whole name7", true, true,
 	token = keeper.GetTokenInfo(ctx, btcTokenSymbol)
 	require.EqualValues(t, "desc2", token.Description)
 	require.EqualValues(t, "whole name1", token.WholeName)
+
+	// metadata only
+	metadata := types.TokenMetadata{Decimals: 8, URI: "https://www.okex.com/btc.png", Website: "https://bitcoin.org"}
+	metadata.SetAttribute("twitter", "https://twitter.com/bitcoin")
+	tokenMsgs = tokenMsgs[:0]
+	tokenEditMsg = types.NewMsgTokenModify(btcTokenSymbol, "", "", false, false,
+		testAccounts[0].baseAccount.Address).WithMetadata(metadata)
+	tokenMsgs = append(tokenMsgs, createTokenMsg(t, app, ctx, testAccounts[0], tokenEditMsg))
+	ctx = mockApplyBlock(t, app, tokenMsgs, 12)
+
+	token = keeper.GetTokenInfo(ctx, btcTokenSymbol)
+	require.EqualValues(t, "desc2", token.Description)
+	require.EqualValues(t, metadata, token.Metadata)
+	require.EqualValues(t, metadata, types.GenTokenResp(token).Metadata)
 }
 
 func getMockAppToHandleFee(t *testing.T, initBalance int64, numAcc int) (app *MockDexApp, testAccounts TestAccounts) {
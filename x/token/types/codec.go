@@ -14,6 +14,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTransferOwnership{}, "okexchain/token/MsgTransferOwnership", nil)
 	cdc.RegisterConcrete(MsgConfirmOwnership{}, "okexchain/token/MsgConfirmOwnership", nil)
 	cdc.RegisterConcrete(MsgTokenModify{}, "okexchain/token/MsgModify", nil)
+	cdc.RegisterConcrete(MsgVestedSend{}, "okexchain/token/MsgVestedSend", nil)
+	cdc.RegisterConcrete(MsgTokenFreeze{}, "okexchain/token/MsgFreeze", nil)
+	cdc.RegisterConcrete(MsgTokenUnfreeze{}, "okexchain/token/MsgUnfreeze", nil)
 
 	// for test
 	//cdc.RegisterConcrete(MsgTokenDestroy{}, "okexchain/token/MsgDestroy", nil)
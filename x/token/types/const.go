@@ -3,4 +3,20 @@ package types
 const (
 	LockCoinsTypeQuantity = 1
 	LockCoinsTypeFee      = 2
+	LockCoinsTypeVesting  = 3
+
+	// vesting release events
+	EventTypeVestingRelease   = "vesting_release"
+	AttributeKeyVestingID     = "vesting_id"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyReleaseAmount = "amount"
+
+	// issuer control events
+	EventTypeFreeze         = "freeze"
+	EventTypeUnfreeze       = "unfreeze"
+	AttributeKeySymbol      = "symbol"
+	AttributeKeyAddress     = "address"
+	AttributeKeyMaxSupply   = "max_supply"
+	AttributeKeyTotalSupply = "total_supply"
+	AttributeKeyFreezable   = "freezable"
 )
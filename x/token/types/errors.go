@@ -43,6 +43,14 @@ const (
 	CodeTotalsupplyExceedsTheUpperLimit            uint32 = 61032
 	CodeBlockedContractRecipient                   uint32 = 61033
 	CodeSendCoinsFromAccountToAccountFailed        uint32 = 61034
+	CodeInvalidVestingSchedule                     uint32 = 61035
+	CodeInvalidTokenMetadata                       uint32 = 61036
+	CodeInvalidMaxSupply                           uint32 = 61037
+	CodeExceedsMaxSupply                           uint32 = 61038
+	CodeTokenIsNotFreezable                        uint32 = 61039
+	CodeAccountIsFrozen                            uint32 = 61040
+	CodeAccountIsNotFrozen                         uint32 = 61041
+	CodeModuleAccountCannotBeFrozen                uint32 = 61042
 )
 
 var (
@@ -81,6 +89,14 @@ var (
 	errCodeConfirmOwnershipAddressNotEqualsMsgAddress = sdkerrors.Register(DefaultCodespace, CodeConfirmOwnershipAddressNotEqualsMsgAddress, "input address is not equal confirm ownership address")
 	errCodeGetDecimalFromDecimalStringFailed          = sdkerrors.Register(DefaultCodespace, CodeGetDecimalFromDecimalStringFailed, "create a decimal from an input decimal string failed")
 	errCodeTotalsupplyExceedsTheUpperLimit            = sdkerrors.Register(DefaultCodespace, CodeTotalsupplyExceedsTheUpperLimit, "total-supply exceeds the upper limit")
+	errCodeInvalidVestingSchedule                     = sdkerrors.Register(DefaultCodespace, CodeInvalidVestingSchedule, "invalid vesting schedule")
+	errCodeInvalidTokenMetadata                       = sdkerrors.Register(DefaultCodespace, CodeInvalidTokenMetadata, "invalid token metadata")
+	errCodeInvalidMaxSupply                           = sdkerrors.Register(DefaultCodespace, CodeInvalidMaxSupply, "invalid max supply")
+	errCodeExceedsMaxSupply                           = sdkerrors.Register(DefaultCodespace, CodeExceedsMaxSupply, "total supply exceeds the max supply")
+	errCodeTokenIsNotFreezable                        = sdkerrors.Register(DefaultCodespace, CodeTokenIsNotFreezable, "token is not freezable")
+	errCodeAccountIsFrozen                            = sdkerrors.Register(DefaultCodespace, CodeAccountIsFrozen, "account is frozen")
+	errCodeAccountIsNotFrozen                         = sdkerrors.Register(DefaultCodespace, CodeAccountIsNotFrozen, "account is not frozen")
+	errCodeModuleAccountCannotBeFrozen                = sdkerrors.Register(DefaultCodespace, CodeModuleAccountCannotBeFrozen, "module account can't be frozen")
 )
 
 // ErrBlockedContractRecipient returns an error when a transfer is tried on a blocked contract recipient
@@ -213,3 +229,35 @@ func ErrGetDecimalFromDecimalStringFailed(msg string) sdk.EnvelopedErr {
 func ErrCodeTotalsupplyExceedsTheUpperLimit(totalSupplyAfterMint sdk.Dec, TotalSupplyUpperbound int64) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTotalsupplyExceedsTheUpperLimit, fmt.Sprintf("total-supply(%s) exceeds the upper limit(%d)", totalSupplyAfterMint, TotalSupplyUpperbound))}
 }
+
+func ErrInvalidVestingSchedule(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidVestingSchedule, fmt.Sprintf("invalid vesting schedule: %s", msg))}
+}
+
+func ErrInvalidTokenMetadata(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidTokenMetadata, fmt.Sprintf("invalid token metadata: %s", msg))}
+}
+
+func ErrInvalidMaxSupply(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidMaxSupply, fmt.Sprintf("invalid max supply: %s", msg))}
+}
+
+func ErrExceedsMaxSupply(totalSupplyAfterMint, maxSupply sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeExceedsMaxSupply, fmt.Sprintf("total-supply(%s) exceeds the max supply(%s)", totalSupplyAfterMint, maxSupply))}
+}
+
+func ErrTokenIsNotFreezable(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenIsNotFreezable, fmt.Sprintf("token(%s) is not freezable", symbol))}
+}
+
+func ErrAccountIsFrozen(address sdk.AccAddress, symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAccountIsFrozen, fmt.Sprintf("token(%s) of account(%s) is frozen", symbol, address))}
+}
+
+func ErrAccountIsNotFrozen(address sdk.AccAddress, symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAccountIsNotFrozen, fmt.Sprintf("token(%s) of account(%s) is not frozen", symbol, address))}
+}
+
+func ErrModuleAccountCannotBeFrozen(address sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeModuleAccountCannotBeFrozen, fmt.Sprintf("module account(%s) can't be frozen", address))}
+}
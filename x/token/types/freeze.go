@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FrozenAccount is an account whose token is frozen by the owner of the token
+type FrozenAccount struct {
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// String implements the stringer interface.
+func (fa FrozenAccount) String() string {
+	return fmt.Sprintf(`FrozenAccount:
+  Symbol:  %s
+  Address: %s`, fa.Symbol, fa.Address)
+}
@@ -34,6 +34,9 @@ const (
 	QueryAccountV2 = "accountsV2"
 	QueryTokensV2  = "tokensV2"
 	QueryTokenV2   = "tokenV2"
+
+	QueryVesting = "vesting"
+	QueryFrozen  = "frozen"
 )
 
 var (
@@ -43,6 +46,11 @@ var (
 	PrefixUserTokenKey        = []byte{0x03} // the address prefix of the user-token relationship
 	LockedFeeKey              = []byte{0x04} // the address prefix of the locked order fee coins
 	PrefixConfirmOwnershipKey = []byte{0x05} // the prefix of the confirm ownership key
+	LockedVestingKey          = []byte{0x06} // the address prefix of the locked vesting coins
+	VestingScheduleKey        = []byte{0x07} // the prefix of the vesting schedules
+	VestingScheduleSeqKey     = []byte{0x08} // key for the sequence of the vesting schedule ids
+	PrefixFrozenAccountKey    = []byte{0x09} // the address prefix of the frozen tokens of accounts
+	VestingQueueKey           = []byte{0x0A} // the prefix of the vesting schedules queued by the next release time
 )
 
 func GetUserTokenPrefix(owner sdk.AccAddress) []byte {
@@ -69,3 +77,39 @@ func GetLockFeeAddress(addr sdk.AccAddress) []byte {
 func GetConfirmOwnershipKey(symbol string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(symbol)...)
 }
+
+// GetLockVestingAddress gets the key for the locked vesting coins with address
+func GetLockVestingAddress(addr sdk.AccAddress) []byte {
+	return append(LockedVestingKey, addr.Bytes()...)
+}
+
+// GetVestingSchedulePrefix gets the prefix of the vesting schedules of a recipient
+func GetVestingSchedulePrefix(recipient sdk.AccAddress) []byte {
+	return append(VestingScheduleKey, recipient.Bytes()...)
+}
+
+// GetVestingScheduleKey gets the key of a vesting schedule
+func GetVestingScheduleKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(GetVestingSchedulePrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFrozenAccountPrefix gets the prefix of the frozen tokens of an account
+func GetFrozenAccountPrefix(addr sdk.AccAddress) []byte {
+	return append(PrefixFrozenAccountKey, addr.Bytes()...)
+}
+
+// GetFrozenAccountKey gets the key of the frozen token of an account
+func GetFrozenAccountKey(addr sdk.AccAddress, symbol string) []byte {
+	return append(GetFrozenAccountPrefix(addr), []byte(symbol)...)
+}
+
+// GetVestingQueueTimePrefix gets the prefix of the vesting schedules queued to be released at the time
+func GetVestingQueueTimePrefix(releaseTime int64) []byte {
+	return append(VestingQueueKey, sdk.Uint64ToBigEndian(uint64(releaseTime))...)
+}
+
+// GetVestingQueueKey gets the key of a vesting schedule queued to be released at the time
+func GetVestingQueueKey(releaseTime int64, recipient sdk.AccAddress, id uint64) []byte {
+	key := append(GetVestingQueueTimePrefix(releaseTime), recipient.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxMetadataDecimals is the max display decimals of a token
+	MaxMetadataDecimals = 18
+	// MetadataLenLimit is the max length of the uri, the website and the attribute values
+	MetadataLenLimit = 256
+	// MetadataKeyLenLimit is the max length of the attribute keys
+	MetadataKeyLenLimit = 64
+	// MaxMetadataAttributes is the max number of the attributes of a token
+	MaxMetadataAttributes = 16
+)
+
+// MetadataAttribute is a free key/value attribute of a token, e.g. "twitter" -> "https://twitter.com/okex"
+type MetadataAttribute struct {
+	Key   string `json:"key" v2:"key"`
+	Value string `json:"value" v2:"value"`
+}
+
+// TokenMetadata is the display information of a token maintained by its owner
+type TokenMetadata struct {
+	Decimals   int64               `json:"decimals" v2:"decimals"` // e.g. 8, the display precision
+	URI        string              `json:"uri" v2:"uri"`           // e.g. the uri of the logo
+	Website    string              `json:"website" v2:"website"`   // e.g. "https://www.okex.com"
+	Attributes []MetadataAttribute `json:"attributes" v2:"attributes"`
+}
+
+// Validate checks the lengths of the metadata fields and the uniqueness of the attribute keys
+func (metadata TokenMetadata) Validate() sdk.Error {
+	if metadata.Decimals < 0 || metadata.Decimals > MaxMetadataDecimals {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("decimals should be in [0, %d]", MaxMetadataDecimals))
+	}
+	if len(metadata.URI) > MetadataLenLimit || len(metadata.Website) > MetadataLenLimit {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("uri and website should not be longer than %d", MetadataLenLimit))
+	}
+	if len(metadata.Attributes) > MaxMetadataAttributes {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("attributes should not be more than %d", MaxMetadataAttributes))
+	}
+
+	keys := make(map[string]struct{}, len(metadata.Attributes))
+	for _, attr := range metadata.Attributes {
+		if len(attr.Key) == 0 || len(attr.Key) > MetadataKeyLenLimit {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("attribute key should be 1 to %d characters", MetadataKeyLenLimit))
+		}
+		if len(attr.Value) > MetadataLenLimit {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("value of attribute %s should not be longer than %d",
+				attr.Key, MetadataLenLimit))
+		}
+		if _, ok := keys[attr.Key]; ok {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("duplicated attribute key: %s", attr.Key))
+		}
+		keys[attr.Key] = struct{}{}
+	}
+	return nil
+}
+
+// GetAttribute returns the value of the attribute key
+func (metadata TokenMetadata) GetAttribute(key string) (string, bool) {
+	for _, attr := range metadata.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// SetAttribute sets the value of the attribute key, the attribute is removed if the value is empty
+func (metadata *TokenMetadata) SetAttribute(key, value string) {
+	for i, attr := range metadata.Attributes {
+		if attr.Key == key {
+			if value == "" {
+				metadata.Attributes = append(metadata.Attributes[:i], metadata.Attributes[i+1:]...)
+			} else {
+				metadata.Attributes[i].Value = value
+			}
+			return
+		}
+	}
+	if value != "" {
+		metadata.Attributes = append(metadata.Attributes, MetadataAttribute{Key: key, Value: value})
+	}
+}
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
 )
@@ -23,6 +25,9 @@ type MsgTokenIssue struct {
 	TotalSupply    string         `json:"total_supply"`
 	Owner          sdk.AccAddress `json:"owner"`
 	Mintable       bool           `json:"mintable"`
+	// the issuer controls are omitted from the sign bytes if they are not chosen
+	MaxSupply string `json:"max_supply,omitempty"`
+	Freezable bool   `json:"freezable,omitempty"`
 }
 
 func NewMsgTokenIssue(tokenDescription, symbol, originalSymbol, wholeName, totalSupply string, owner sdk.AccAddress, mintable bool) MsgTokenIssue {
@@ -37,6 +42,14 @@ func NewMsgTokenIssue(tokenDescription, symbol, originalSymbol, wholeName, total
 	}
 }
 
+// WithIssuerControls returns the msg which issues a token with a max supply cap and the freeze control.
+// An empty max supply means no cap.
+func (msg MsgTokenIssue) WithIssuerControls(maxSupply string, freezable bool) MsgTokenIssue {
+	msg.MaxSupply = maxSupply
+	msg.Freezable = freezable
+	return msg
+}
+
 func (msg MsgTokenIssue) Route() string { return RouterKey }
 
 func (msg MsgTokenIssue) Type() string { return "issue" }
@@ -72,6 +85,19 @@ func (msg MsgTokenIssue) ValidateBasic() sdk.Error {
 	if totalSupply.GT(sdk.NewDec(TotalSupplyUpperbound)) || totalSupply.LTE(sdk.ZeroDec()) {
 		return ErrTotalSupplyOutOfRange()
 	}
+	// check maxSupply
+	if len(msg.MaxSupply) != 0 {
+		maxSupply, err := sdk.NewDecFromStr(msg.MaxSupply)
+		if err != nil {
+			return ErrInvalidMaxSupply(err.Error())
+		}
+		if !msg.Mintable {
+			return ErrInvalidMaxSupply("max supply is only for mintable tokens")
+		}
+		if maxSupply.LT(totalSupply) || maxSupply.GT(sdk.NewDec(TotalSupplyUpperbound)) {
+			return ErrInvalidMaxSupply(fmt.Sprintf("max supply should be in [%s, %d]", totalSupply, TotalSupplyUpperbound))
+		}
+	}
 	return nil
 }
 
@@ -304,6 +330,8 @@ type MsgTokenModify struct {
 	WholeName             string         `json:"whole_name"`
 	IsDescriptionModified bool           `json:"description_modified"`
 	IsWholeNameModified   bool           `json:"whole_name_modified"`
+	// the metadata replaces the current one if it's not nil
+	Metadata *TokenMetadata `json:"metadata,omitempty"`
 }
 
 func NewMsgTokenModify(symbol, desc, wholeName string, isDescEdit, isWholeNameEdit bool, owner sdk.AccAddress) MsgTokenModify {
@@ -317,6 +345,12 @@ func NewMsgTokenModify(symbol, desc, wholeName string, isDescEdit, isWholeNameEd
 	}
 }
 
+// WithMetadata returns the msg which also modifies the metadata of the token
+func (msg MsgTokenModify) WithMetadata(metadata TokenMetadata) MsgTokenModify {
+	msg.Metadata = &metadata
+	return msg
+}
+
 func (msg MsgTokenModify) Route() string { return RouterKey }
 
 func (msg MsgTokenModify) Type() string { return "edit" }
@@ -346,6 +380,12 @@ func (msg MsgTokenModify) ValidateBasic() sdk.Error {
 			return ErrDescLenBiggerThanLimit()
 		}
 	}
+	// check metadata
+	if msg.Metadata != nil {
+		if err := msg.Metadata.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -398,3 +438,135 @@ func (msg MsgConfirmOwnership) GetSignBytes() []byte {
 func (msg MsgConfirmOwnership) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Address}
 }
+
+// MsgVestedSend - sends coins which are unlocked to the recipient over time
+type MsgVestedSend struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.SysCoins   `json:"amount"`
+	VestingType string         `json:"vesting_type"`
+	StartTime   int64          `json:"start_time"`
+	EndTime     int64          `json:"end_time"`
+}
+
+func NewMsgVestedSend(from, to sdk.AccAddress, coins sdk.SysCoins, vestingType string,
+	startTime, endTime int64) MsgVestedSend {
+	return MsgVestedSend{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      coins,
+		VestingType: vestingType,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+func (msg MsgVestedSend) Route() string { return RouterKey }
+
+func (msg MsgVestedSend) Type() string { return "vested_send" }
+
+func (msg MsgVestedSend) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if msg.ToAddress.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if !msg.Amount.IsValid() {
+		return ErrInvalidCoins(msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return common.ErrInsufficientCoins(DefaultParamspace, msg.Amount.String())
+	}
+	if !IsValidVestingType(msg.VestingType) {
+		return ErrInvalidVestingSchedule(fmt.Sprintf("unknown vesting type: %s", msg.VestingType))
+	}
+	if msg.StartTime < 0 || msg.EndTime <= msg.StartTime {
+		return ErrInvalidVestingSchedule(fmt.Sprintf("end time(%d) should be after start time(%d)",
+			msg.EndTime, msg.StartTime))
+	}
+	return nil
+}
+
+func (msg MsgVestedSend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgVestedSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgTokenFreeze - the owner of a freezable token freezes the token of an account
+type MsgTokenFreeze struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewMsgTokenFreeze(symbol string, address, owner sdk.AccAddress) MsgTokenFreeze {
+	return MsgTokenFreeze{
+		Owner:   owner,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg MsgTokenFreeze) Route() string { return RouterKey }
+
+func (msg MsgTokenFreeze) Type() string { return "freeze" }
+
+func (msg MsgTokenFreeze) ValidateBasic() sdk.Error {
+	return validateFreezeMsg(msg.Owner, msg.Symbol, msg.Address)
+}
+
+func (msg MsgTokenFreeze) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTokenUnfreeze - the owner of a freezable token unfreezes the token of an account
+type MsgTokenUnfreeze struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewMsgTokenUnfreeze(symbol string, address, owner sdk.AccAddress) MsgTokenUnfreeze {
+	return MsgTokenUnfreeze{
+		Owner:   owner,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg MsgTokenUnfreeze) Route() string { return RouterKey }
+
+func (msg MsgTokenUnfreeze) Type() string { return "unfreeze" }
+
+func (msg MsgTokenUnfreeze) ValidateBasic() sdk.Error {
+	return validateFreezeMsg(msg.Owner, msg.Symbol, msg.Address)
+}
+
+func (msg MsgTokenUnfreeze) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func validateFreezeMsg(owner sdk.AccAddress, symbol string, address sdk.AccAddress) sdk.Error {
+	if owner.Empty() || address.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -375,3 +376,102 @@ bnbbbbbbbbbbbnbbbbbbbbbbnbbbbbbbbbbbnbbbbbbbbb1234`, "bnbbbbbbbbbb", false, fals
 	err := tokenEditMsg.ValidateBasic()
 	require.NoError(t, err)
 }
+
+func TestMsgTokenModifyMetadata(t *testing.T) {
+	common.InitConfig()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the sign bytes of the msg without metadata are not changed
+	msg := NewMsgTokenModify("bnb", "bnb", "bnb bnb", true, true, addr)
+	require.NotContains(t, string(msg.GetSignBytes()), "metadata")
+
+	metadata := TokenMetadata{Decimals: 8, URI: "https://www.okex.com/bnb.png", Website: "https://www.okex.com"}
+	metadata.SetAttribute("twitter", "https://twitter.com/okex")
+	metadata.SetAttribute("github", "https://github.com/okex")
+	require.NoError(t, msg.WithMetadata(metadata).ValidateBasic())
+	require.NoError(t, NewMsgTokenModify("bnb", "", "", false, false, addr).WithMetadata(metadata).ValidateBasic())
+
+	value, ok := metadata.GetAttribute("twitter")
+	require.True(t, ok)
+	require.EqualValues(t, "https://twitter.com/okex", value)
+	metadata.SetAttribute("twitter", "")
+	_, ok = metadata.GetAttribute("twitter")
+	require.False(t, ok)
+	require.EqualValues(t, 1, len(metadata.Attributes))
+
+	testCases := []TokenMetadata{
+		{Decimals: -1},
+		{Decimals: MaxMetadataDecimals + 1},
+		{URI: strings.Repeat("a", MetadataLenLimit+1)},
+		{Attributes: []MetadataAttribute{{Key: "", Value: "a"}}},
+		{Attributes: []MetadataAttribute{{Key: strings.Repeat("a", MetadataKeyLenLimit+1), Value: "a"}}},
+		{Attributes: []MetadataAttribute{{Key: "a", Value: "a"}, {Key: "a", Value: "b"}}},
+	}
+	for _, invalid := range testCases {
+		require.Error(t, msg.WithMetadata(invalid).ValidateBasic())
+	}
+}
+
+func TestMsgIssuerControls(t *testing.T) {
+	common.InitConfig()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the sign bytes of the msg without issuer controls are not changed
+	issueMsg := NewMsgTokenIssue("bnb", "bnb", "bnb", "binance coin", "1000", addr, true)
+	require.NotContains(t, string(issueMsg.GetSignBytes()), "max_supply")
+	require.NotContains(t, string(issueMsg.GetSignBytes()), "freezable")
+
+	require.NoError(t, issueMsg.WithIssuerControls("1000", true).ValidateBasic())
+	require.NoError(t, issueMsg.WithIssuerControls("", true).ValidateBasic())
+	require.Error(t, issueMsg.WithIssuerControls("999", false).ValidateBasic())
+	require.Error(t, issueMsg.WithIssuerControls("abc", false).ValidateBasic())
+	require.Error(t, issueMsg.WithIssuerControls(strconv.FormatInt(TotalSupplyUpperbound+1, 10), false).ValidateBasic())
+	issueMsg.Mintable = false
+	require.Error(t, issueMsg.WithIssuerControls("2000", false).ValidateBasic())
+
+	freezeMsg := NewMsgTokenFreeze("bnb", addr, addr)
+	require.NoError(t, freezeMsg.ValidateBasic())
+	require.EqualValues(t, "freeze", freezeMsg.Type())
+	require.EqualValues(t, []sdk.AccAddress{addr}, freezeMsg.GetSigners())
+	require.Error(t, NewMsgTokenFreeze("", addr, addr).ValidateBasic())
+	require.Error(t, NewMsgTokenFreeze("bnb", sdk.AccAddress{}, addr).ValidateBasic())
+
+	unfreezeMsg := NewMsgTokenUnfreeze("bnb", addr, addr)
+	require.NoError(t, unfreezeMsg.ValidateBasic())
+	require.EqualValues(t, "unfreeze", unfreezeMsg.Type())
+	require.Error(t, NewMsgTokenUnfreeze("bnb", addr, sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestNewMsgVestedSend(t *testing.T) {
+	from, to := sdk.AccAddress([]byte("from")), sdk.AccAddress([]byte("to"))
+	coins := sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100))}
+
+	msg := NewMsgVestedSend(from, to, coins, VestingTypeLinear, 1000, 2000)
+	require.Equal(t, "vested_send", msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{from}, msg.GetSigners())
+
+	msg = NewMsgVestedSend(from, to, coins, "unknown", 1000, 2000)
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgVestedSend(from, to, coins, VestingTypeCliff, 2000, 2000)
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgVestedSend(from, nil, coins, VestingTypeCliff, 1000, 2000)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestVestingSchedule_VestedCoins(t *testing.T) {
+	coins := sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100))}
+	schedule := VestingSchedule{VestingType: VestingTypeLinear, Amount: coins, StartTime: 1000, EndTime: 2000}
+	require.True(t, schedule.VestedCoins(900).IsZero())
+	require.EqualValues(t, "25.000000000000000000"+common.NativeToken, schedule.VestedCoins(1250).String())
+	require.EqualValues(t, coins, schedule.VestedCoins(2000))
+
+	schedule.Released = schedule.VestedCoins(1250)
+	require.EqualValues(t, "50.000000000000000000"+common.NativeToken, schedule.ReleasableCoins(1750).String())
+	require.EqualValues(t, "75.000000000000000000"+common.NativeToken, schedule.LockedCoins().String())
+
+	// cliff vesting unlocks all of the coins at the end time
+	schedule = VestingSchedule{VestingType: VestingTypeCliff, Amount: coins, StartTime: 1000, EndTime: 2000}
+	require.True(t, schedule.VestedCoins(1999).IsZero())
+	require.EqualValues(t, coins, schedule.VestedCoins(2000))
+}
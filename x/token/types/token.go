@@ -15,6 +15,14 @@ type Token struct {
 	Type                int            `json:"type"`                                             //e.g. 1 common token, 2 interest token
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`                                 // e.g. okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
 	Mintable            bool           `json:"mintable" v2:"mintable"`                           // e.g. false
+	Metadata            TokenMetadata  `json:"metadata" v2:"metadata"`                           // e.g. decimals, logo uri and website
+	MaxSupply           sdk.Dec        `json:"max_supply" v2:"max_supply"`                       // e.g. 21000000.00000000, zero means no cap
+	Freezable           bool           `json:"freezable" v2:"freezable"`                         // e.g. true, the owner can freeze accounts
+}
+
+// HasMaxSupply returns whether the total supply of the token is capped
+func (token Token) HasMaxSupply() bool {
+	return !token.MaxSupply.IsNil() && token.MaxSupply.IsPositive()
 }
 
 func (token Token) String() string {
@@ -35,6 +43,9 @@ type TokenResp struct {
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`
 	Mintable            bool           `json:"mintable" v2:"mintable"`
 	TotalSupply         sdk.Dec        `json:"total_supply" v2:"total_supply"`
+	Metadata            TokenMetadata  `json:"metadata" v2:"metadata"`
+	MaxSupply           sdk.Dec        `json:"max_supply" v2:"max_supply"`
+	Freezable           bool           `json:"freezable" v2:"freezable"`
 }
 
 func (token TokenResp) String() string {
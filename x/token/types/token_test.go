@@ -74,7 +74,7 @@ func TestToken(t *testing.T) {
 			Type:                0,
 			Owner:               nil,
 			Mintable:            false,
-		}, `{"description":"my token","symbol":"` + common.NativeToken + `","original_symbol":"` + common.NativeToken + `","whole_name":"btc","original_total_supply":"1000000.000000000000000000","type":0,"owner":"","mintable":false}`},
+		}, `{"description":"my token","symbol":"` + common.NativeToken + `","original_symbol":"` + common.NativeToken + `","whole_name":"btc","original_total_supply":"1000000.000000000000000000","type":0,"owner":"","mintable":false,"metadata":{"decimals":0,"uri":"","website":"","attributes":null},"max_supply":"0.000000000000000000","freezable":false}`},
 		{Token{
 			Description:         "okblockchain coin",
 			Symbol:              common.NativeToken,
@@ -84,7 +84,7 @@ func TestToken(t *testing.T) {
 			Type:                0,
 			Owner:               addr,
 			Mintable:            true,
-		}, `{"description":"okblockchain coin","symbol":"` + common.NativeToken + `","original_symbol":"` + common.NativeToken + `","whole_name":"ok coin","original_total_supply":"1000000000.000000000000000000","type":0,"owner":"okexchain1dfpljpe0g0206jch32fx95lyagq3z5ws850m6f","mintable":true}`},
+		}, `{"description":"okblockchain coin","symbol":"` + common.NativeToken + `","original_symbol":"` + common.NativeToken + `","whole_name":"ok coin","original_total_supply":"1000000000.000000000000000000","type":0,"owner":"okexchain1dfpljpe0g0206jch32fx95lyagq3z5ws850m6f","mintable":true,"metadata":{"decimals":0,"uri":"","website":"","attributes":null},"max_supply":"0.000000000000000000","freezable":false}`},
 	}
 	for _, tokenCase := range testCase {
 		b, err := json.Marshal(tokenCase.token)
@@ -181,5 +181,8 @@ func GenTokenResp(token Token) TokenResp {
 		Owner:               token.Owner,
 		Type:                token.Type,
 		Mintable:            token.Mintable,
+		Metadata:            token.Metadata,
+		MaxSupply:           token.MaxSupply,
+		Freezable:           token.Freezable,
 	}
 }
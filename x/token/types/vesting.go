@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// VestingTypeLinear unlocks the coins linearly from the start time to the end time
+	VestingTypeLinear = "linear"
+	// VestingTypeCliff unlocks all of the coins at the end time
+	VestingTypeCliff = "cliff"
+
+	// VestingReleaseInterval is the seconds between two releases of a linear vesting schedule
+	VestingReleaseInterval int64 = 3600
+)
+
+// IsValidVestingType returns whether the vesting type is supported
+func IsValidVestingType(vestingType string) bool {
+	return vestingType == VestingTypeLinear || vestingType == VestingTypeCliff
+}
+
+// VestingSchedule is created by a vested send. The coins are kept in the token module account,
+// and are unlocked to the recipient over time in BeginBlocker.
+type VestingSchedule struct {
+	ID          uint64         `json:"id"`
+	Sender      sdk.AccAddress `json:"sender"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	VestingType string         `json:"vesting_type"`
+	Amount      sdk.SysCoins   `json:"amount"`
+	Released    sdk.SysCoins   `json:"released"`
+	StartTime   int64          `json:"start_time"` // unix time in seconds
+	EndTime     int64          `json:"end_time"`   // unix time in seconds
+}
+
+// VestedCoins returns the coins vested at the block time, including the released ones
+func (s VestingSchedule) VestedCoins(blockTime int64) sdk.SysCoins {
+	if blockTime >= s.EndTime {
+		return s.Amount
+	}
+	if s.VestingType == VestingTypeCliff || blockTime <= s.StartTime {
+		return sdk.SysCoins{}
+	}
+
+	elapsed, duration := blockTime-s.StartTime, s.EndTime-s.StartTime
+	var vested sdk.SysCoins
+	for _, coin := range s.Amount {
+		amount := coin.Amount.MulInt64(elapsed).QuoInt64(duration)
+		if amount.IsPositive() {
+			vested = append(vested, sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return vested
+}
+
+// ReleasableCoins returns the vested coins which have not been released yet
+func (s VestingSchedule) ReleasableCoins(blockTime int64) sdk.SysCoins {
+	releasable, hasNeg := s.VestedCoins(blockTime).SafeSub(s.Released)
+	if hasNeg {
+		return sdk.SysCoins{}
+	}
+	return releasable
+}
+
+// NextReleaseTime returns the time when the coins vested after the block time are released next.
+// The linear vesting schedules are released every VestingReleaseInterval, and all of the schedules are
+// released at the end time
+func (s VestingSchedule) NextReleaseTime(blockTime int64) int64 {
+	if s.VestingType == VestingTypeCliff {
+		return s.EndTime
+	}
+	if blockTime < s.StartTime {
+		blockTime = s.StartTime
+	}
+	if next := blockTime + VestingReleaseInterval; next < s.EndTime {
+		return next
+	}
+	return s.EndTime
+}
+
+// LockedCoins returns the coins which have not been released yet
+func (s VestingSchedule) LockedCoins() sdk.SysCoins {
+	return s.Amount.Sub(s.Released)
+}
+
+// String implements the stringer interface.
+func (s VestingSchedule) String() string {
+	return fmt.Sprintf(`VestingSchedule:
+  ID:          %d
+  Sender:      %s
+  Recipient:   %s
+  VestingType: %s
+  Amount:      %s
+  Released:    %s
+  StartTime:   %d
+  EndTime:     %d`, s.ID, s.Sender, s.Recipient, s.VestingType, s.Amount, s.Released, s.StartTime, s.EndTime)
+}
+
+// VestingStatus is the vesting status of a recipient for querying
+type VestingStatus struct {
+	Address   sdk.AccAddress    `json:"address"`
+	Locked    sdk.SysCoins      `json:"locked"`
+	Schedules []VestingSchedule `json:"schedules"`
+}
+
+// String implements the stringer interface.
+func (status VestingStatus) String() string {
+	schedules := make([]string, 0, len(status.Schedules))
+	for _, schedule := range status.Schedules {
+		schedules = append(schedules, schedule.String())
+	}
+	return fmt.Sprintf(`VestingStatus:
+  Address: %s
+  Locked:  %s
+%s`, status.Address, status.Locked, strings.Join(schedules, "\n"))
+}
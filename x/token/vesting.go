@@ -0,0 +1,180 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/token/types"
+)
+
+// CreateVestingSchedule moves the coins of a vested send from the sender to the token module account,
+// and locks them for the recipient until they are vested
+func (k Keeper) CreateVestingSchedule(ctx sdk.Context, schedule *types.VestingSchedule) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, schedule.Sender, types.ModuleName,
+		schedule.Amount); err != nil {
+		return types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+	if err := k.updateLockedCoins(ctx, schedule.Recipient, schedule.Amount, true,
+		types.LockCoinsTypeVesting); err != nil {
+		return err
+	}
+
+	schedule.ID = k.nextVestingScheduleID(ctx)
+	k.SetVestingSchedule(ctx, schedule)
+	k.InsertVestingQueue(ctx, schedule.NextReleaseTime(ctx.BlockTime().Unix()), schedule)
+	return nil
+}
+
+func (k Keeper) nextVestingScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.lockStoreKey)
+	var id uint64
+	if bz := store.Get(types.VestingScheduleSeqKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &id)
+	}
+	id++
+	store.Set(types.VestingScheduleSeqKey, k.cdc.MustMarshalBinaryBare(id))
+	return id
+}
+
+// SetVestingSchedule saves a vesting schedule
+func (k Keeper) SetVestingSchedule(ctx sdk.Context, schedule *types.VestingSchedule) {
+	store := ctx.KVStore(k.lockStoreKey)
+	store.Set(types.GetVestingScheduleKey(schedule.Recipient, schedule.ID), k.cdc.MustMarshalBinaryBare(schedule))
+}
+
+// DeleteVestingSchedule deletes a vesting schedule
+func (k Keeper) DeleteVestingSchedule(ctx sdk.Context, schedule *types.VestingSchedule) {
+	store := ctx.KVStore(k.lockStoreKey)
+	store.Delete(types.GetVestingScheduleKey(schedule.Recipient, schedule.ID))
+}
+
+// InsertVestingQueue queues the vesting schedule to be released at the time
+func (k Keeper) InsertVestingQueue(ctx sdk.Context, releaseTime int64, schedule *types.VestingSchedule) {
+	store := ctx.KVStore(k.lockStoreKey)
+	store.Set(types.GetVestingQueueKey(releaseTime, schedule.Recipient, schedule.ID),
+		types.GetVestingScheduleKey(schedule.Recipient, schedule.ID))
+}
+
+// dequeueMatureVestingSchedules removes the vesting schedules queued to be released by the block time from the
+// queue, and returns them
+func (k Keeper) dequeueMatureVestingSchedules(ctx sdk.Context, blockTime int64) (schedules []*types.VestingSchedule) {
+	store := ctx.KVStore(k.lockStoreKey)
+	iter := store.Iterator(types.VestingQueueKey, sdk.PrefixEndBytes(types.GetVestingQueueTimePrefix(blockTime)))
+	defer iter.Close()
+
+	var keys, scheduleKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		scheduleKeys = append(scheduleKeys, iter.Value())
+	}
+
+	for i, key := range keys {
+		store.Delete(key)
+		bz := store.Get(scheduleKeys[i])
+		if bz == nil {
+			continue
+		}
+		schedule := &types.VestingSchedule{}
+		k.cdc.MustUnmarshalBinaryBare(bz, schedule)
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// MigrateVestingQueue queues the vesting schedules created before they are released through the queue
+func (k Keeper) MigrateVestingQueue(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+	k.IterateVestingSchedules(ctx, func(schedule *types.VestingSchedule) bool {
+		k.InsertVestingQueue(ctx, schedule.NextReleaseTime(blockTime), schedule)
+		return false
+	})
+}
+
+// GetVestingSchedules returns the vesting schedules of a recipient
+func (k Keeper) GetVestingSchedules(ctx sdk.Context, recipient sdk.AccAddress) (schedules []types.VestingSchedule) {
+	store := ctx.KVStore(k.lockStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetVestingSchedulePrefix(recipient))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// IterateVestingSchedules iterates over all the vesting schedules and performs a callback function
+func (k Keeper) IterateVestingSchedules(ctx sdk.Context, cb func(schedule *types.VestingSchedule) (stop bool)) {
+	store := ctx.KVStore(k.lockStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VestingScheduleKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		schedule := &types.VestingSchedule{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// GetLockedVestingCoins gets the coins locked by the vesting schedules of an address
+func (k Keeper) GetLockedVestingCoins(ctx sdk.Context, addr sdk.AccAddress) (coins sdk.SysCoins) {
+	store := ctx.KVStore(k.lockStoreKey)
+	coinsBytes := store.Get(types.GetLockVestingAddress(addr))
+	if coinsBytes == nil {
+		return coins
+	}
+	k.cdc.MustUnmarshalBinaryBare(coinsBytes, &coins)
+	return coins
+}
+
+// IterateLockedVestings iterates over all the locked vesting coins and performs a callback function
+func (k Keeper) IterateLockedVestings(ctx sdk.Context, cb func(acc sdk.AccAddress, coins sdk.SysCoins) (stop bool)) {
+	store := ctx.KVStore(k.lockStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LockedVestingKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := iter.Key()[len(types.LockedVestingKey):]
+
+		var coins sdk.SysCoins
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &coins)
+
+		if cb(acc, coins) {
+			break
+		}
+	}
+}
+
+// ReleaseVestedCoins unlocks the coins vested to the recipients of the vesting schedules queued to be released
+// by now, and deletes the vesting schedules which are fully released. The others are queued again by the next
+// release time, so only the schedules to be released are read
+func (k Keeper) ReleaseVestedCoins(ctx sdk.Context) {
+	logger := ctx.Logger().With("module", "token")
+	blockTime := ctx.BlockTime().Unix()
+	for _, schedule := range k.dequeueMatureVestingSchedules(ctx, blockTime) {
+		releasable := schedule.ReleasableCoins(blockTime)
+		if releasable.IsZero() {
+			k.InsertVestingQueue(ctx, schedule.NextReleaseTime(blockTime), schedule)
+			continue
+		}
+		if err := k.UnlockCoins(ctx, schedule.Recipient, releasable, types.LockCoinsTypeVesting); err != nil {
+			logger.Error(fmt.Sprintf("release vesting schedule(%d) failed: %v", schedule.ID, err))
+			k.InsertVestingQueue(ctx, schedule.NextReleaseTime(blockTime), schedule)
+			continue
+		}
+
+		schedule.Released = schedule.Released.Add2(releasable)
+		if schedule.LockedCoins().IsZero() {
+			k.DeleteVestingSchedule(ctx, schedule)
+		} else {
+			k.SetVestingSchedule(ctx, schedule)
+			k.InsertVestingQueue(ctx, schedule.NextReleaseTime(blockTime), schedule)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeVestingRelease,
+			sdk.NewAttribute(types.AttributeKeyVestingID, fmt.Sprintf("%d", schedule.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseAmount, releasable.String()),
+		))
+	}
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/token/types"
+)
+
+func TestKeeper_VestingSchedule(t *testing.T) {
+	mapp, keeper, _ := getMockDexApp(t, 0)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+
+	genAccs, testAccounts := CreateGenAccounts(2,
+		sdk.SysCoins{
+			sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(1000)),
+		})
+	mock.SetGenesis(mapp.App, types.DecAccountArrToBaseAccountArr(genAccs))
+	sender, recipient := testAccounts[0].baseAccount.Address, testAccounts[1].baseAccount.Address
+
+	amount := sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100))}
+	schedule := &types.VestingSchedule{
+		Sender:      sender,
+		Recipient:   recipient,
+		VestingType: types.VestingTypeLinear,
+		Amount:      amount,
+		Released:    sdk.SysCoins{},
+		StartTime:   1000,
+		EndTime:     1000 + 2*types.VestingReleaseInterval,
+	}
+	require.NoError(t, keeper.CreateVestingSchedule(ctx, schedule))
+	require.EqualValues(t, 1, schedule.ID)
+	require.EqualValues(t, amount, keeper.GetLockedVestingCoins(ctx, recipient))
+	require.EqualValues(t, "900.000000000000000000"+common.NativeToken, keeper.GetCoins(ctx, sender).String())
+
+	// nothing is vested before the start time
+	keeper.ReleaseVestedCoins(ctx.WithBlockTime(time.Unix(1000, 0)))
+	require.EqualValues(t, amount, keeper.GetLockedVestingCoins(ctx, recipient))
+
+	// the vested coins aren't released until the next release time
+	keeper.ReleaseVestedCoins(ctx.WithBlockTime(time.Unix(1000+types.VestingReleaseInterval-1, 0)))
+	require.EqualValues(t, amount, keeper.GetLockedVestingCoins(ctx, recipient))
+
+	// half of the coins are vested
+	keeper.ReleaseVestedCoins(ctx.WithBlockTime(time.Unix(1000+types.VestingReleaseInterval, 0)))
+	require.EqualValues(t, "50.000000000000000000"+common.NativeToken,
+		keeper.GetLockedVestingCoins(ctx, recipient).String())
+	require.EqualValues(t, "1050.000000000000000000"+common.NativeToken, keeper.GetCoins(ctx, recipient).String())
+	schedules := keeper.GetVestingSchedules(ctx, recipient)
+	require.EqualValues(t, 1, len(schedules))
+	require.EqualValues(t, "50.000000000000000000"+common.NativeToken, schedules[0].Released.String())
+
+	// all of the coins are vested, and the schedule is deleted
+	keeper.ReleaseVestedCoins(ctx.WithBlockTime(time.Unix(1000+3*types.VestingReleaseInterval, 0)))
+	require.True(t, keeper.GetLockedVestingCoins(ctx, recipient).IsZero())
+	require.EqualValues(t, "1100.000000000000000000"+common.NativeToken, keeper.GetCoins(ctx, recipient).String())
+	require.EqualValues(t, 0, len(keeper.GetVestingSchedules(ctx, recipient)))
+
+	// not enough coins
+	schedule.Amount = sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(10000))}
+	require.Error(t, keeper.CreateVestingSchedule(ctx, schedule))
+}